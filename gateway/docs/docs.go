@@ -15,54 +15,75 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/budgets": {
+        "/api/attachments": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "budgets"
+                    "attachments"
+                ],
+                "summary": "List attachments of a transaction",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Transaction ID",
+                        "name": "transaction_id",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "List budgets",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/api.BudgetResponse"
+                                "$ref": "#/definitions/api.AttachmentResponse"
                             }
                         }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
                     }
                 }
             },
             "post": {
+                "description": "Stores a receipt with a transaction. Files are limited to\n10 MiB and must be PDF, JPEG, PNG or WebP; the type is\ndetected from the content.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "budgets"
+                    "attachments"
                 ],
-                "summary": "Set budget",
+                "summary": "Upload attachment",
                 "parameters": [
                     {
-                        "description": "Budget payload",
-                        "name": "budget",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api.CreateBudgetRequest"
-                        }
+                        "type": "integer",
+                        "description": "Transaction ID",
+                        "name": "transaction_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Receipt",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/api.BudgetResponse"
+                            "$ref": "#/definitions/api.AttachmentResponse"
                         }
                     },
                     "400": {
@@ -70,45 +91,117 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/api/reports/summary": {
+        "/api/attachments/download": {
             "get": {
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "reports"
+                    "attachments"
                 ],
-                "summary": "Get report summary",
+                "summary": "Download attachment",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "From date (YYYY-MM-DD)",
-                        "name": "from",
+                        "type": "integer",
+                        "description": "Attachment ID",
+                        "name": "id",
                         "in": "query",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "To date (YYYY-MM-DD)",
-                        "name": "to",
-                        "in": "query",
-                        "required": true
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
                     }
+                }
+            }
+        },
+        "/api/budgets": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
                 ],
+                "summary": "List budgets",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "number",
-                                "format": "float64"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.BudgetResponse"
                             }
                         }
+                    }
+                }
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
+                ],
+                "summary": "Set budget",
+                "parameters": [
+                    {
+                        "description": "Budget payload",
+                        "name": "budget",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.CreateBudgetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api.BudgetResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -119,28 +212,73 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/transactions": {
+        "/api/categories": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "categories"
                 ],
-                "summary": "List transactions",
+                "summary": "List categories",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/api.TransactionResponse"
+                                "$ref": "#/definitions/api.CategoryResponse"
                             }
                         }
                     }
                 }
             },
+            "put": {
+                "description": "Moves a category under another parent, or to the top level when parent is empty.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Move category",
+                "parameters": [
+                    {
+                        "description": "Category payload",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.CategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.CategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
             "post": {
+                "description": "Adds a category to the tree, optionally under an existing parent.",
                 "consumes": [
                     "application/json"
                 ],
@@ -148,17 +286,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "categories"
                 ],
-                "summary": "Create transaction",
+                "summary": "Create category",
                 "parameters": [
                     {
-                        "description": "Transaction payload",
-                        "name": "transaction",
+                        "description": "Category payload",
+                        "name": "category",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api.CreateTransactionRequest"
+                            "$ref": "#/definitions/api.CategoryRequest"
                         }
                     }
                 ],
@@ -166,7 +304,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/api.TransactionResponse"
+                            "$ref": "#/definitions/api.CategoryResponse"
                         }
                     },
                     "400": {
@@ -175,6 +313,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
                     "409": {
                         "description": "Conflict",
                         "schema": {
@@ -182,10 +326,44 @@ const docTemplate = `{
                         }
                     }
                 }
+            },
+            "delete": {
+                "description": "Removes a category without subcategories. Its transactions and budgets are kept.",
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Delete category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category name",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
             }
         },
-        "/api/transactions/bulk": {
+        "/api/categories/aliases": {
             "post": {
+                "description": "Makes alias resolve to category, ignoring case, in transactions and budgets.",
                 "consumes": [
                     "application/json"
                 ],
@@ -193,17 +371,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "categories"
                 ],
-                "summary": "Bulk create transactions",
+                "summary": "Add category alias",
                 "parameters": [
                     {
-                        "description": "Bulk transactions",
-                        "name": "request",
+                        "description": "Alias payload",
+                        "name": "alias",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api.BulkCreateTransactionsRequest"
+                            "$ref": "#/definitions/api.CategoryAliasRequest"
                         }
                     }
                 ],
@@ -211,7 +389,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.BulkCreateTransactionsResponse"
+                            "$ref": "#/definitions/api.CategoryResponse"
                         }
                     },
                     "400": {
@@ -220,6 +398,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
                     "409": {
                         "description": "Conflict",
                         "schema": {
@@ -229,111 +413,2704 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/transactions/export.csv": {
-            "get": {
+        "/api/categories/merge": {
+            "post": {
+                "description": "Moves the transactions, budgets, subcategories and aliases of the sources to the target. Budget limits are added up.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
-                    "text/csv"
+                    "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "categories"
+                ],
+                "summary": "Merge categories",
+                "parameters": [
+                    {
+                        "description": "Merge payload",
+                        "name": "merge",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.MergeCategoriesRequest"
+                        }
+                    }
                 ],
-                "summary": "Export transactions to CSV",
                 "responses": {
                     "200": {
-                        "description": "CSV file",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.CategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/ping": {
-            "get": {
+        "/api/categories/rename": {
+            "post": {
+                "description": "Renames a category and refiles its transactions and budgets. The old name becomes an alias.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "system"
+                    "categories"
+                ],
+                "summary": "Rename category",
+                "parameters": [
+                    {
+                        "description": "Rename payload",
+                        "name": "rename",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.RenameCategoryRequest"
+                        }
+                    }
                 ],
-                "summary": "Health check",
                 "responses": {
                     "200": {
-                        "description": "pong",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.CategoryResponse"
                         }
-                    }
-                }
-            }
-        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/categories/suggest": {
+            "get": {
+                "description": "Categories learned from past transactions with similar\ndescriptions, payees and amounts, most likely first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Suggest categories",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction description",
+                        "name": "description",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Transaction amount",
+                        "name": "amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Payee name",
+                        "name": "payee",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of suggestions, 3 by default",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.CategorySuggestionResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/notifications/recipients": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "List notification recipients",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.RecipientResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Opts an email address in to budget alerts and the weekly spending digest. Posting an address again replaces its name and opt-ins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Set notification recipient",
+                "parameters": [
+                    {
+                        "description": "Recipient",
+                        "name": "recipient",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.SetRecipientRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api.RecipientResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Delete notification recipient",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Recipient id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/payees": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payees"
+                ],
+                "summary": "List payees",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.PayeeResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "The name is normalized: whitespace is collapsed, card\nprocessor prefixes and store numbers are dropped.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payees"
+                ],
+                "summary": "Create payee",
+                "parameters": [
+                    {
+                        "description": "Payee",
+                        "name": "payee",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.PayeeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api.PayeeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reconciliations": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reconciliations"
+                ],
+                "summary": "List reconciliations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.ReconciliationResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Opens a reconciliation of an account up to the statement date and lists the transactions to clear.\nAn account has at most one open reconciliation.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reconciliations"
+                ],
+                "summary": "Start a reconciliation against a bank statement",
+                "parameters": [
+                    {
+                        "description": "Statement",
+                        "name": "reconciliation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.StartReconciliationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api.ReconciliationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reconciliations/complete": {
+            "post": {
+                "description": "Locks the cleared transactions against edits. Fails while the difference is not zero.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reconciliations"
+                ],
+                "summary": "Complete a reconciliation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Reconciliation id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.ReconciliationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reconciliations/detail": {
+            "get": {
+                "description": "The difference to the statement and, while it is open, the transactions left to clear.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reconciliations"
+                ],
+                "summary": "Get a reconciliation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Reconciliation id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.ReconciliationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/recurring": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring"
+                ],
+                "summary": "List recurring transactions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.RecurringResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces a recurring transaction. Dates before the next due one are not backfilled.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring"
+                ],
+                "summary": "Update recurring transaction",
+                "parameters": [
+                    {
+                        "description": "Recurring transaction with id",
+                        "name": "recurring",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.RecurringRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.RecurringResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "The ledger posts every date of the schedule from start_date on,\nincluding dates already past, as a regular transaction.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring"
+                ],
+                "summary": "Create recurring transaction",
+                "parameters": [
+                    {
+                        "description": "Recurring transaction",
+                        "name": "recurring",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.RecurringRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api.RecurringResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Stops the schedule. Transactions already posted are kept.",
+                "tags": [
+                    "recurring"
+                ],
+                "summary": "Delete recurring transaction",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Recurring transaction id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reports/anomalies": {
+            "get": {
+                "description": "Transactions whose amount was far from the usual for their category when added.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "List anomalous transactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "From date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "To date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.TransactionResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reports/budgets": {
+            "get": {
+                "description": "Limit, spend and remaining amount of every budget, plus spend in unbudgeted categories.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get budget vs actual report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "From date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "To date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.BudgetReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reports/duplicates": {
+            "get": {
+                "description": "Groups of transactions with the same amount, dates a few days apart and similar descriptions,\ne.g. from overlapping bank exports. Dismissed groups are left out.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "List suspected duplicate transactions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.DuplicateGroupResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reports/forecast": {
+            "get": {
+                "description": "Projected end-of-period spend per category, flagged when it would exceed the budget.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get spending forecast",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Forecast date (YYYY-MM-DD), today by default",
+                        "name": "as_of",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "month",
+                        "description": "week, month, quarter or year",
+                        "name": "period",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForecastResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reports/payees": {
+            "get": {
+                "description": "Payees with the most spend in the period, largest first.\nTransactions without a payee are left out.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get spend by payee",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "From date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "To date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of payees to return, all by default",
+                        "name": "top",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.PayeeSpendResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reports/series": {
+            "get": {
+                "description": "Zero-filled spending per category and time bucket.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get spending series",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "From date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "To date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "month",
+                        "description": "day, week, month, quarter or year",
+                        "name": "granularity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only these categories",
+                        "name": "category",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.SpendingSeriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reports/summary": {
+            "get": {
+                "description": "Spend per category. With rollup=true the response is a\nReportSummaryResponse that also has parent category totals.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get report summary",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "From date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "To date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include totals rolled up to parent categories",
+                        "name": "rollup",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "pending",
+                                "cleared",
+                                "reconciled"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only transactions in these statuses",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "number",
+                                "format": "float64"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reports/tags": {
+            "get": {
+                "description": "Spend per tag. A transaction with several tags counts towards each of them.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get report summary by tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "From date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "To date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "number",
+                                "format": "float64"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/rules": {
+            "get": {
+                "description": "Rules in the order they run, lowest priority first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rules"
+                ],
+                "summary": "List categorization rules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.RuleResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Past transactions only change when the rules are re-applied.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rules"
+                ],
+                "summary": "Update categorization rule",
+                "parameters": [
+                    {
+                        "description": "Rule with id",
+                        "name": "rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.RuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.RuleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Rules run on new and imported transactions before validation.\nEvery condition that is set must match. A field set by a\nlower priority rule is kept; tags of all matches are added.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rules"
+                ],
+                "summary": "Create categorization rule",
+                "parameters": [
+                    {
+                        "description": "Rule",
+                        "name": "rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.RuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api.RuleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "tags": [
+                    "rules"
+                ],
+                "summary": "Delete categorization rule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Rule id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/rules/apply": {
+            "post": {
+                "description": "Runs the rules against every stored transaction and returns\nwhat changes. With dry_run=true nothing is saved.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rules"
+                ],
+                "summary": "Re-apply rules to past transactions",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Preview the changes without saving",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.RuleChangeResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/transactions": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "List transactions",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only transactions with all of these tags",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "pending",
+                                "cleared",
+                                "reconciled",
+                                "voided"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only transactions in one of these statuses",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.TransactionResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Create transaction",
+                "parameters": [
+                    {
+                        "description": "Transaction payload",
+                        "name": "transaction",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.CreateTransactionRequest"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and check the budget without saving",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "reject",
+                            "flag",
+                            "allow"
+                        ],
+                        "type": "string",
+                        "description": "reject, flag or allow a likely duplicate; the ledger's setting by default",
+                        "name": "duplicate_policy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Dry run passed",
+                        "schema": {
+                            "$ref": "#/definitions/api.TransactionResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/transactions/bulk": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Bulk create transactions",
+                "parameters": [
+                    {
+                        "description": "Bulk transactions",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkCreateTransactionsRequest"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Report the would-be outcome without saving",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "reject",
+                            "flag",
+                            "allow"
+                        ],
+                        "type": "string",
+                        "description": "reject, flag or allow likely duplicates; the ledger's setting by default",
+                        "name": "duplicate_policy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.BulkCreateTransactionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/transactions/cleared": {
+            "post": {
+                "description": "Marks transactions as seen on a bank statement, or not. Reconciled transactions cannot change.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reconciliations"
+                ],
+                "summary": "Mark transactions cleared",
+                "parameters": [
+                    {
+                        "description": "Transactions",
+                        "name": "cleared",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.SetClearedRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/transactions/duplicates/dismiss": {
+            "post": {
+                "description": "Marks the transactions as separate, so they are no longer reported as duplicates of each other.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Dismiss suspected duplicates",
+                "parameters": [
+                    {
+                        "description": "Dismiss payload",
+                        "name": "dismiss",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.DismissDuplicatesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/transactions/duplicates/merge": {
+            "post": {
+                "description": "Keeps keep_id and deletes duplicate_ids, moving their tags and attachments to the one kept.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Merge duplicate transactions",
+                "parameters": [
+                    {
+                        "description": "Merge payload",
+                        "name": "merge",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.MergeDuplicatesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/transactions/export.csv": {
+            "get": {
+                "produces": [
+                    "text/csv"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Export transactions to CSV",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only transactions with all of these tags",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "pending",
+                                "cleared",
+                                "reconciled",
+                                "voided"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only transactions in one of these statuses",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "CSV file",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/transactions/refunds": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "List refunds of a transaction",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Transaction ID",
+                        "name": "transaction_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.RefundResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Gives back part or all of a transaction. The refund takes spend off the original's category on the original's date, so budgets and reports for that period go down. Refunds of a transaction never add up to more than it cost.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Refund a transaction",
+                "parameters": [
+                    {
+                        "description": "Refund",
+                        "name": "refund",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.CreateRefundRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api.RefundResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/transactions/status": {
+            "post": {
+                "description": "Moves transactions to pending, cleared or voided. Voided transactions stay listed but count towards no budget or report. Reconciled and voided transactions cannot change.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Change transaction status",
+                "parameters": [
+                    {
+                        "description": "Transactions and their new status",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.SetTransactionStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/transactions/status/history": {
+            "get": {
+                "description": "Every status the transaction had, oldest first, with when it changed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Get transaction status history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Transaction id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.StatusChangeResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/webhooks": {
+            "get": {
+                "description": "Secrets are left out.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "List webhooks",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.WebhookResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Subscribes an HTTP endpoint to ledger events. Every event is POSTed as JSON and signed: X-FinScope-Signature is sha256= and the hex HMAC-SHA256 of \"\u003cX-FinScope-Timestamp\u003e.\u003cbody\u003e\" keyed with the secret. Failed deliveries are retried with backoff. The secret is only returned here.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Create webhook",
+                "parameters": [
+                    {
+                        "description": "Webhook",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.CreateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api.WebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Stops deliveries and drops the delivery log of the webhook.",
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Delete webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/webhooks/deliveries": {
+            "get": {
+                "description": "Every delivery attempt of a webhook, latest first, failed ones included.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "List webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of attempts (default 50, at most 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.WebhookDeliveryResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ping": {
+            "get": {
+                "description": "Ready while the ledger reports itself serving over grpc.health.v1, i.e. it reaches Postgres and Redis.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "system"
+                ],
+                "summary": "Readiness check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.ReadinessResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/api.ReadinessResponse"
+                        }
+                    }
+                }
+            }
+        }
     },
     "definitions": {
+        "api.AnomalyResponse": {
+            "type": "object",
+            "properties": {
+                "median": {
+                    "type": "number"
+                },
+                "samples": {
+                    "type": "integer"
+                },
+                "score": {
+                    "type": "number"
+                }
+            }
+        },
+        "api.AttachmentResponse": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "filename": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "transaction_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.BudgetReportLineResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "limit": {
+                    "type": "number"
+                },
+                "percent_used": {
+                    "type": "number"
+                },
+                "remaining": {
+                    "type": "number"
+                },
+                "spent": {
+                    "type": "number"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.BudgetReportResponse": {
+            "type": "object",
+            "properties": {
+                "budgets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.BudgetReportLineResponse"
+                    }
+                },
+                "unbudgeted": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.CategorySpendResponse"
+                    }
+                }
+            }
+        },
         "api.BudgetResponse": {
             "type": "object",
             "properties": {
                 "category": {
                     "type": "string"
                 },
-                "limit": {
+                "limit": {
+                    "type": "number"
+                }
+            }
+        },
+        "api.BulkCreateTransactionsRequest": {
+            "type": "object",
+            "properties": {
+                "transactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.CreateTransactionRequest"
+                    }
+                },
+                "workers": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.BulkCreateTransactionsResponse": {
+            "type": "object",
+            "properties": {
+                "accepted": {
+                    "type": "integer"
+                },
+                "category_spend": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number",
+                        "format": "float64"
+                    }
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.BulkImportErrorResponse"
+                    }
+                },
+                "rejected": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.BulkImportErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "index": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.CategoryAliasRequest": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "type": "string"
+                },
+                "category": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.CategoryRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "parent": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.CategoryResponse": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.CategorySeriesResponse": {
+            "type": "object",
+            "properties": {
+                "amounts": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "category": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.CategorySpendResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "spent": {
+                    "type": "number"
+                }
+            }
+        },
+        "api.CategorySuggestionResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "confidence": {
+                    "type": "number"
+                }
+            }
+        },
+        "api.CreateBudgetRequest": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "limit": {
+                    "type": "number"
+                }
+            }
+        },
+        "api.CreateRefundRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "category": {
+                    "description": "Category picks the line refunded of a split transaction; it\ndefaults to the original's category otherwise.",
+                    "type": "string"
+                },
+                "date": {
+                    "description": "Date is when the money came back, today by default.",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "transaction_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.CreateTransactionRequest": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "description": "Account is the bank account paid from; empty is the default one.",
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "payee": {
+                    "type": "string"
+                },
+                "payee_id": {
+                    "description": "PayeeID picks an existing payee; otherwise Payee is looked up by\nname and created if needed.",
+                    "type": "integer"
+                },
+                "splits": {
+                    "description": "Splits must add up to Amount; Category can then be left empty.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.SplitDTO"
+                    }
+                },
+                "status": {
+                    "description": "Status is pending, the default, or cleared.",
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "cleared"
+                    ]
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "api.CreateWebhookRequest": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "enum": [
+                            "transaction.created",
+                            "budget.exceeded",
+                            "budget.threshold_crossed",
+                            "budget.updated",
+                            "import.completed"
+                        ]
+                    }
+                },
+                "min_amount": {
+                    "description": "MinAmount leaves out transaction.created for smaller transactions.",
+                    "type": "number"
+                },
+                "secret": {
+                    "description": "Secret signs deliveries; one is generated when empty.",
+                    "type": "string"
+                },
+                "threshold": {
+                    "description": "Threshold is the percentage of a budget whose crossing sends\nbudget.threshold_crossed, 80 by default.",
+                    "type": "number"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.DismissDuplicatesRequest": {
+            "type": "object",
+            "properties": {
+                "transaction_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "api.DuplicateGroupResponse": {
+            "type": "object",
+            "properties": {
+                "transactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.TransactionResponse"
+                    }
+                }
+            }
+        },
+        "api.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.ForecastLineResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "history_projection": {
+                    "type": "number"
+                },
+                "limit": {
+                    "type": "number"
+                },
+                "over_budget": {
+                    "type": "boolean"
+                },
+                "pace_projection": {
+                    "type": "number"
+                },
+                "projected": {
+                    "type": "number"
+                },
+                "recurring_pending": {
+                    "type": "number"
+                },
+                "spent": {
+                    "type": "number"
+                }
+            }
+        },
+        "api.ForecastResponse": {
+            "type": "object",
+            "properties": {
+                "as_of": {
+                    "type": "string"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.ForecastLineResponse"
+                    }
+                },
+                "period": {
+                    "type": "string"
+                },
+                "period_end": {
+                    "type": "string"
+                },
+                "period_start": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.MergeCategoriesRequest": {
+            "type": "object",
+            "properties": {
+                "sources": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "target": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.MergeDuplicatesRequest": {
+            "type": "object",
+            "properties": {
+                "duplicate_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "keep_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.PayeeRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.PayeeResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.PayeeSpendResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "payee": {
+                    "type": "string"
+                },
+                "payee_id": {
+                    "type": "integer"
+                },
+                "spent": {
+                    "type": "number"
+                }
+            }
+        },
+        "api.ReadinessResponse": {
+            "type": "object",
+            "properties": {
+                "ledger": {
+                    "description": "Ledger is the ledger's grpc.health.v1 status, or UNREACHABLE.",
+                    "type": "string",
+                    "enum": [
+                        "SERVING",
+                        "NOT_SERVING",
+                        "UNREACHABLE"
+                    ]
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "ready",
+                        "unavailable"
+                    ]
+                }
+            }
+        },
+        "api.RecipientResponse": {
+            "type": "object",
+            "properties": {
+                "budget_alerts": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "weekly_digest": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "api.ReconciliationResponse": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "type": "string"
+                },
+                "cleared_balance": {
+                    "type": "number"
+                },
+                "cleared_total": {
+                    "type": "number"
+                },
+                "completed_at": {
+                    "type": "string"
+                },
+                "difference": {
+                    "description": "Difference must be zero to complete the reconciliation.",
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "opening_balance": {
+                    "type": "number"
+                },
+                "statement_balance": {
+                    "type": "number"
+                },
+                "statement_date": {
+                    "type": "string"
+                },
+                "transactions": {
+                    "description": "Transactions are the ones left to clear, while it is open.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.TransactionResponse"
+                    }
+                }
+            }
+        },
+        "api.RecurringRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "schedule": {
+                    "type": "string",
+                    "example": "FREQ=MONTHLY;BYMONTHDAY=1"
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.RecurringResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "next_date": {
+                    "type": "string"
+                },
+                "schedule": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.RefundResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
                     "type": "number"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "original_date": {
+                    "description": "OriginalDate is the date of the original transaction, which the\nspend is taken off.",
+                    "type": "string"
+                },
+                "transaction_id": {
+                    "type": "integer"
                 }
             }
         },
-        "api.BulkCreateTransactionsRequest": {
+        "api.RenameCategoryRequest": {
             "type": "object",
             "properties": {
-                "transactions": {
+                "from": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.RuleChangeResponse": {
+            "type": "object",
+            "properties": {
+                "after": {
+                    "$ref": "#/definitions/api.TransactionResponse"
+                },
+                "before": {
+                    "$ref": "#/definitions/api.TransactionResponse"
+                },
+                "category_kept": {
+                    "description": "CategoryKept is set when a rule would have changed the category of\na split transaction, which keeps that of its largest line.",
+                    "type": "boolean"
+                },
+                "rule_ids": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/api.CreateTransactionRequest"
+                        "type": "integer"
                     }
                 },
-                "workers": {
+                "transaction_id": {
                     "type": "integer"
                 }
             }
         },
-        "api.BulkCreateTransactionsResponse": {
+        "api.RuleRequest": {
             "type": "object",
             "properties": {
-                "accepted": {
+                "description_contains": {
+                    "type": "string"
+                },
+                "description_regex": {
+                    "type": "string",
+                    "example": "(?i)^uber"
+                },
+                "id": {
                     "type": "integer"
                 },
-                "errors": {
+                "max_amount": {
+                    "type": "number"
+                },
+                "min_amount": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "payee": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "set_category": {
+                    "type": "string"
+                },
+                "set_description": {
+                    "type": "string"
+                },
+                "set_tags": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/api.BulkImportErrorResponse"
+                        "type": "string"
                     }
+                }
+            }
+        },
+        "api.RuleResponse": {
+            "type": "object",
+            "properties": {
+                "description_contains": {
+                    "type": "string"
                 },
-                "rejected": {
+                "description_regex": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "max_amount": {
+                    "type": "number"
+                },
+                "min_amount": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "payee": {
+                    "type": "string"
+                },
+                "priority": {
                     "type": "integer"
+                },
+                "set_category": {
+                    "type": "string"
+                },
+                "set_description": {
+                    "type": "string"
+                },
+                "set_tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "api.BulkImportErrorResponse": {
+        "api.SetClearedRequest": {
             "type": "object",
             "properties": {
-                "error": {
+                "cleared": {
+                    "type": "boolean"
+                },
+                "transaction_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "api.SetRecipientRequest": {
+            "type": "object",
+            "properties": {
+                "budget_alerts": {
+                    "type": "boolean"
+                },
+                "email": {
                     "type": "string"
                 },
-                "index": {
-                    "type": "integer"
+                "name": {
+                    "type": "string"
+                },
+                "weekly_digest": {
+                    "type": "boolean"
                 }
             }
         },
-        "api.CreateBudgetRequest": {
+        "api.SetTransactionStatusRequest": {
             "type": "object",
             "properties": {
-                "category": {
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "cleared",
+                        "voided"
+                    ]
+                },
+                "transaction_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "api.SpendingSeriesResponse": {
+            "type": "object",
+            "properties": {
+                "buckets": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "granularity": {
                     "type": "string"
                 },
-                "limit": {
-                    "type": "number"
+                "series": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.CategorySeriesResponse"
+                    }
                 }
             }
         },
-        "api.CreateTransactionRequest": {
+        "api.SplitDTO": {
             "type": "object",
             "properties": {
                 "amount": {
@@ -341,40 +3118,186 @@ const docTemplate = `{
                 },
                 "category": {
                     "type": "string"
-                },
-                "date": {
+                }
+            }
+        },
+        "api.StartReconciliationRequest": {
+            "type": "object",
+            "properties": {
+                "account": {
                     "type": "string"
                 },
-                "description": {
+                "opening_balance": {
+                    "description": "OpeningBalance is only used for the first reconciliation of an\naccount; later ones open at the previous statement balance.",
+                    "type": "number"
+                },
+                "statement_balance": {
+                    "type": "number"
+                },
+                "statement_date": {
+                    "description": "StatementDate is YYYY-MM-DD.",
                     "type": "string"
                 }
             }
         },
-        "api.ErrorResponse": {
+        "api.StatusChangeResponse": {
             "type": "object",
             "properties": {
-                "error": {
+                "changed_at": {
+                    "type": "string"
+                },
+                "from": {
+                    "description": "From is empty for the status the transaction was created with.",
                     "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "transaction_id": {
+                    "type": "integer"
                 }
             }
         },
         "api.TransactionResponse": {
             "type": "object",
             "properties": {
+                "account": {
+                    "type": "string"
+                },
                 "amount": {
                     "type": "number"
                 },
+                "anomaly": {
+                    "$ref": "#/definitions/api.AnomalyResponse"
+                },
                 "category": {
                     "type": "string"
                 },
+                "cleared": {
+                    "type": "boolean"
+                },
                 "date": {
                     "type": "string"
                 },
                 "description": {
                     "type": "string"
                 },
+                "duplicate_of": {
+                    "description": "DuplicateOf is the earlier transaction this one looked like when\nit was added.",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "payee": {
+                    "type": "string"
+                },
+                "payee_id": {
+                    "type": "integer"
+                },
+                "reconciliation_id": {
+                    "description": "ReconciliationID locks the transaction when set.",
+                    "type": "integer"
+                },
+                "recurring_id": {
+                    "type": "integer"
+                },
+                "refunded": {
+                    "description": "Refunded is the total refunded so far; Amount is what was paid.",
+                    "type": "number"
+                },
+                "splits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.SplitDTO"
+                    }
+                },
+                "status": {
+                    "description": "Status is pending, cleared, reconciled or voided.",
+                    "type": "string"
+                },
+                "status_changed_at": {
+                    "type": "string"
+                },
+                "suggestion": {
+                    "description": "Suggestion is set when the category was missing and filled in\nfrom the transaction history.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/api.CategorySuggestionResponse"
+                        }
+                    ]
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "api.WebhookDeliveryResponse": {
+            "type": "object",
+            "properties": {
+                "attempt": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "duration_ms": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "status_code": {
+                    "description": "StatusCode is 0 when no response came back; Error says why.",
+                    "type": "integer"
+                },
+                "succeeded": {
+                    "type": "boolean"
+                },
+                "webhook_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.WebhookResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "id": {
                     "type": "integer"
+                },
+                "min_amount": {
+                    "type": "number"
+                },
+                "secret": {
+                    "description": "Secret is only returned when the webhook is created.",
+                    "type": "string"
+                },
+                "threshold": {
+                    "type": "number"
+                },
+                "url": {
+                    "type": "string"
                 }
             }
         }
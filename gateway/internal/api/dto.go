@@ -9,14 +9,63 @@ type CreateTransactionRequest struct {
 	Category    string    `json:"category"`
 	Description string    `json:"description"`
 	Date        time.Time `json:"date"`
+	Tags        []string  `json:"tags,omitempty"`
+	// Splits must add up to Amount; Category can then be left empty.
+	Splits []SplitDTO `json:"splits,omitempty"`
+	// PayeeID picks an existing payee; otherwise Payee is looked up by
+	// name and created if needed.
+	PayeeID int64  `json:"payee_id,omitempty"`
+	Payee   string `json:"payee,omitempty"`
+	// Account is the bank account paid from; empty is the default one.
+	Account string `json:"account,omitempty"`
+	// Status is pending, the default, or cleared.
+	Status string `json:"status,omitempty" enums:"pending,cleared"`
+}
+
+// SplitDTO is one category line of a split transaction.
+type SplitDTO struct {
+	Category string  `json:"category"`
+	Amount   float64 `json:"amount"`
 }
 
 type TransactionResponse struct {
-	ID          int64     `json:"id"`
-	Amount      float64   `json:"amount"`
-	Category    string    `json:"category"`
-	Description string    `json:"description"`
-	Date        time.Time `json:"date"`
+	ID          int64            `json:"id"`
+	Amount      float64          `json:"amount"`
+	Category    string           `json:"category"`
+	Description string           `json:"description"`
+	Date        time.Time        `json:"date"`
+	Tags        []string         `json:"tags"`
+	Anomaly     *AnomalyResponse `json:"anomaly,omitempty"`
+	RecurringID int64            `json:"recurring_id,omitempty"`
+	Splits      []SplitDTO       `json:"splits,omitempty"`
+	PayeeID     int64            `json:"payee_id,omitempty"`
+	Payee       string           `json:"payee,omitempty"`
+	// Suggestion is set when the category was missing and filled in
+	// from the transaction history.
+	Suggestion *CategorySuggestionResponse `json:"suggestion,omitempty"`
+	// DuplicateOf is the earlier transaction this one looked like when
+	// it was added.
+	DuplicateOf int64 `json:"duplicate_of,omitempty"`
+	Account     string `json:"account,omitempty"`
+	Cleared     bool   `json:"cleared"`
+	// ReconciliationID locks the transaction when set.
+	ReconciliationID int64 `json:"reconciliation_id,omitempty"`
+	// Status is pending, cleared, reconciled or voided.
+	Status          string     `json:"status"`
+	StatusChangedAt *time.Time `json:"status_changed_at,omitempty"`
+	// Refunded is the total refunded so far; Amount is what was paid.
+	Refunded float64 `json:"refunded,omitempty"`
+}
+
+type CategorySuggestionResponse struct {
+	Category   string  `json:"category"`
+	Confidence float64 `json:"confidence"`
+}
+
+type AnomalyResponse struct {
+	Score   float64 `json:"score"`
+	Median  float64 `json:"median"`
+	Samples int     `json:"samples"`
 }
 
 type CreateBudgetRequest struct {
@@ -40,9 +89,320 @@ type BulkImportErrorResponse struct {
 }
 
 type BulkCreateTransactionsResponse struct {
-	Accepted int                       `json:"accepted"`
-	Rejected int                       `json:"rejected"`
-	Errors   []BulkImportErrorResponse `json:"errors"`
+	Accepted      int                       `json:"accepted"`
+	Rejected      int                       `json:"rejected"`
+	Errors        []BulkImportErrorResponse `json:"errors"`
+	CategorySpend map[string]float64        `json:"category_spend,omitempty"`
+}
+
+type CategorySeriesResponse struct {
+	Category string    `json:"category"`
+	Amounts  []float64 `json:"amounts"`
+}
+
+type SpendingSeriesResponse struct {
+	Granularity string                   `json:"granularity"`
+	Buckets     []string                 `json:"buckets"`
+	Series      []CategorySeriesResponse `json:"series"`
+}
+
+type BudgetReportLineResponse struct {
+	Category    string  `json:"category"`
+	Limit       float64 `json:"limit"`
+	Spent       float64 `json:"spent"`
+	Remaining   float64 `json:"remaining"`
+	PercentUsed float64 `json:"percent_used"`
+	Status      string  `json:"status"`
+}
+
+type CategorySpendResponse struct {
+	Category string  `json:"category"`
+	Spent    float64 `json:"spent"`
+}
+
+type BudgetReportResponse struct {
+	Budgets    []BudgetReportLineResponse `json:"budgets"`
+	Unbudgeted []CategorySpendResponse    `json:"unbudgeted"`
+}
+
+type ForecastLineResponse struct {
+	Category          string  `json:"category"`
+	Spent             float64 `json:"spent"`
+	PaceProjection    float64 `json:"pace_projection"`
+	HistoryProjection float64 `json:"history_projection,omitempty"`
+	RecurringPending  float64 `json:"recurring_pending"`
+	Projected         float64 `json:"projected"`
+	Limit             float64 `json:"limit,omitempty"`
+	OverBudget        bool    `json:"over_budget"`
+}
+
+type ForecastResponse struct {
+	Period      string                 `json:"period"`
+	PeriodStart string                 `json:"period_start"`
+	PeriodEnd   string                 `json:"period_end"`
+	AsOf        string                 `json:"as_of"`
+	Lines       []ForecastLineResponse `json:"lines"`
+}
+
+type ReportSummaryResponse struct {
+	Totals map[string]float64 `json:"totals"`
+	RollUp map[string]float64 `json:"rollup"`
+}
+
+type CategoryRequest struct {
+	Name   string `json:"name"`
+	Parent string `json:"parent,omitempty"`
+}
+
+type CategoryResponse struct {
+	ID      int64    `json:"id"`
+	Name    string   `json:"name"`
+	Parent  string   `json:"parent,omitempty"`
+	Aliases []string `json:"aliases"`
+}
+
+type CategoryAliasRequest struct {
+	Alias    string `json:"alias"`
+	Category string `json:"category"`
+}
+
+type RenameCategoryRequest struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type MergeCategoriesRequest struct {
+	Sources []string `json:"sources"`
+	Target  string   `json:"target"`
+}
+
+// RecurringRequest creates a recurring transaction, or with an id
+// replaces one. Dates are YYYY-MM-DD.
+type RecurringRequest struct {
+	ID          int64   `json:"id,omitempty"`
+	Amount      float64 `json:"amount"`
+	Category    string  `json:"category"`
+	Description string  `json:"description"`
+	Schedule    string  `json:"schedule" example:"FREQ=MONTHLY;BYMONTHDAY=1"`
+	StartDate   string  `json:"start_date"`
+	EndDate     string  `json:"end_date,omitempty"`
+}
+
+type RecurringResponse struct {
+	ID          int64   `json:"id"`
+	Amount      float64 `json:"amount"`
+	Category    string  `json:"category"`
+	Description string  `json:"description"`
+	Schedule    string  `json:"schedule"`
+	StartDate   string  `json:"start_date"`
+	EndDate     string  `json:"end_date,omitempty"`
+	NextDate    string  `json:"next_date,omitempty"`
+	LastError   string  `json:"last_error,omitempty"`
+}
+
+// RuleRequest creates a rule, or with an id replaces one. At least one
+// condition and one action must be set.
+type RuleRequest struct {
+	ID                  int64    `json:"id,omitempty"`
+	Name                string   `json:"name"`
+	Priority            int32    `json:"priority"`
+	DescriptionContains string   `json:"description_contains,omitempty"`
+	DescriptionRegex    string   `json:"description_regex,omitempty" example:"(?i)^uber"`
+	MinAmount           float64  `json:"min_amount,omitempty"`
+	MaxAmount           float64  `json:"max_amount,omitempty"`
+	Payee               string   `json:"payee,omitempty"`
+	SetCategory         string   `json:"set_category,omitempty"`
+	SetTags             []string `json:"set_tags,omitempty"`
+	SetDescription      string   `json:"set_description,omitempty"`
+}
+
+type RuleResponse struct {
+	ID                  int64    `json:"id"`
+	Name                string   `json:"name"`
+	Priority            int32    `json:"priority"`
+	DescriptionContains string   `json:"description_contains,omitempty"`
+	DescriptionRegex    string   `json:"description_regex,omitempty"`
+	MinAmount           float64  `json:"min_amount,omitempty"`
+	MaxAmount           float64  `json:"max_amount,omitempty"`
+	Payee               string   `json:"payee,omitempty"`
+	SetCategory         string   `json:"set_category,omitempty"`
+	SetTags             []string `json:"set_tags"`
+	SetDescription      string   `json:"set_description,omitempty"`
+}
+
+type RuleChangeResponse struct {
+	TransactionID int64               `json:"transaction_id"`
+	RuleIDs       []int64             `json:"rule_ids"`
+	Before        TransactionResponse `json:"before"`
+	After         TransactionResponse `json:"after"`
+	// CategoryKept is set when a rule would have changed the category of
+	// a split transaction, which keeps that of its largest line.
+	CategoryKept bool `json:"category_kept,omitempty"`
+}
+
+type PayeeRequest struct {
+	Name string `json:"name"`
+}
+
+type PayeeResponse struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type PayeeSpendResponse struct {
+	PayeeID int64   `json:"payee_id"`
+	Payee   string  `json:"payee"`
+	Spent   float64 `json:"spent"`
+	Count   int     `json:"count"`
+}
+
+type StartReconciliationRequest struct {
+	Account string `json:"account"`
+	// StatementDate is YYYY-MM-DD.
+	StatementDate    string  `json:"statement_date"`
+	StatementBalance float64 `json:"statement_balance"`
+	// OpeningBalance is only used for the first reconciliation of an
+	// account; later ones open at the previous statement balance.
+	OpeningBalance float64 `json:"opening_balance"`
+}
+
+type ReconciliationResponse struct {
+	ID               int64   `json:"id"`
+	Account          string  `json:"account"`
+	StatementDate    string  `json:"statement_date"`
+	StatementBalance float64 `json:"statement_balance"`
+	OpeningBalance   float64 `json:"opening_balance"`
+	ClearedTotal     float64 `json:"cleared_total"`
+	ClearedBalance   float64 `json:"cleared_balance"`
+	// Difference must be zero to complete the reconciliation.
+	Difference  float64    `json:"difference"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// Transactions are the ones left to clear, while it is open.
+	Transactions []TransactionResponse `json:"transactions,omitempty"`
+}
+
+type SetClearedRequest struct {
+	TransactionIDs []int64 `json:"transaction_ids"`
+	Cleared        bool    `json:"cleared"`
+}
+
+type SetTransactionStatusRequest struct {
+	TransactionIDs []int64 `json:"transaction_ids"`
+	Status         string  `json:"status" enums:"pending,cleared,voided"`
+}
+
+type StatusChangeResponse struct {
+	TransactionID int64 `json:"transaction_id"`
+	// From is empty for the status the transaction was created with.
+	From      string    `json:"from,omitempty"`
+	To        string    `json:"to"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+type CreateRefundRequest struct {
+	TransactionID int64   `json:"transaction_id"`
+	Amount        float64 `json:"amount"`
+	// Category picks the line refunded of a split transaction; it
+	// defaults to the original's category otherwise.
+	Category    string `json:"category,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Date is when the money came back, today by default.
+	Date time.Time `json:"date"`
+}
+
+type RefundResponse struct {
+	ID            int64     `json:"id"`
+	TransactionID int64     `json:"transaction_id"`
+	Category      string    `json:"category"`
+	Amount        float64   `json:"amount"`
+	Description   string    `json:"description,omitempty"`
+	Date          time.Time `json:"date"`
+	// OriginalDate is the date of the original transaction, which the
+	// spend is taken off.
+	OriginalDate time.Time `json:"original_date"`
+}
+
+type DuplicateGroupResponse struct {
+	Transactions []TransactionResponse `json:"transactions"`
+}
+
+type MergeDuplicatesRequest struct {
+	KeepID       int64   `json:"keep_id"`
+	DuplicateIDs []int64 `json:"duplicate_ids"`
+}
+
+type DismissDuplicatesRequest struct {
+	TransactionIDs []int64 `json:"transaction_ids"`
+}
+
+type AttachmentResponse struct {
+	ID            int64     `json:"id"`
+	TransactionID int64     `json:"transaction_id"`
+	Filename      string    `json:"filename"`
+	ContentType   string    `json:"content_type"`
+	Size          int64     `json:"size"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type CreateWebhookRequest struct {
+	URL string `json:"url"`
+	// Secret signs deliveries; one is generated when empty.
+	Secret string   `json:"secret,omitempty"`
+	Events []string `json:"events" enums:"transaction.created,budget.exceeded,budget.threshold_crossed,budget.updated,import.completed"`
+	// MinAmount leaves out transaction.created for smaller transactions.
+	MinAmount float64 `json:"min_amount,omitempty"`
+	// Threshold is the percentage of a budget whose crossing sends
+	// budget.threshold_crossed, 80 by default.
+	Threshold float64 `json:"threshold,omitempty"`
+}
+
+type WebhookResponse struct {
+	ID  int64  `json:"id"`
+	URL string `json:"url"`
+	// Secret is only returned when the webhook is created.
+	Secret    string    `json:"secret,omitempty"`
+	Events    []string  `json:"events"`
+	MinAmount float64   `json:"min_amount"`
+	Threshold float64   `json:"threshold"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID        int64  `json:"id"`
+	WebhookID int64  `json:"webhook_id"`
+	EventID   string `json:"event_id"`
+	EventType string `json:"event_type"`
+	Attempt   uint32 `json:"attempt"`
+	// StatusCode is 0 when no response came back; Error says why.
+	StatusCode uint32    `json:"status_code"`
+	Error      string    `json:"error,omitempty"`
+	Succeeded  bool      `json:"succeeded"`
+	DurationMS int64     `json:"duration_ms"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// SetRecipientRequest opts a recipient in to emails; leaving a kind out
+// opts them out of it.
+type SetRecipientRequest struct {
+	Name         string `json:"name,omitempty"`
+	Email        string `json:"email"`
+	BudgetAlerts bool   `json:"budget_alerts"`
+	WeeklyDigest bool   `json:"weekly_digest"`
+}
+
+type RecipientResponse struct {
+	ID           int64     `json:"id"`
+	Name         string    `json:"name"`
+	Email        string    `json:"email"`
+	BudgetAlerts bool      `json:"budget_alerts"`
+	WeeklyDigest bool      `json:"weekly_digest"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type ReadinessResponse struct {
+	Status string `json:"status" enums:"ready,unavailable"`
+	// Ledger is the ledger's grpc.health.v1 status, or UNREACHABLE.
+	Ledger string `json:"ledger" enums:"SERVING,NOT_SERVING,UNREACHABLE"`
 }
 
 type ErrorResponse struct {
@@ -17,7 +17,9 @@ func writeGRPCError(w http.ResponseWriter, err error) {
 	switch st.Code() {
 	case codes.InvalidArgument:
 		writeError(w, http.StatusBadRequest, st.Message())
-	case codes.FailedPrecondition, codes.Aborted:
+	case codes.NotFound:
+		writeError(w, http.StatusNotFound, st.Message())
+	case codes.AlreadyExists, codes.FailedPrecondition, codes.Aborted:
 		writeError(w, http.StatusConflict, st.Message())
 	case codes.DeadlineExceeded:
 		writeError(w, http.StatusGatewayTimeout, "request timeout")
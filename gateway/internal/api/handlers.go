@@ -1,14 +1,20 @@
 package api
 
 import (
+	"context"
 	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"mime"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/lyagu5h/finScope/gateway/internal/delivery/client"
@@ -49,6 +55,126 @@ func (h *Handler) RegisterRoutes(mux *http.ServeMux) {
 		h.timeout,
 	),
 	)
+	mux.Handle("/api/reports/series", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.reportsSeriesHandler), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/reports/budgets", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.reportsBudgetsHandler), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/reports/forecast", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.reportsForecastHandler), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/reports/anomalies", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.reportsAnomaliesHandler), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/reports/tags", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.reportsTagsHandler), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/reports/payees", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.reportsPayeesHandler), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/reconciliations", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.reconciliationsHandler), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/reconciliations/detail", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.getReconciliation), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/reconciliations/complete", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.completeReconciliation), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/reports/duplicates", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.reportsDuplicatesHandler), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/rules", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.rulesHandler), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/rules/apply", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.applyRules), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/payees", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.payeesHandler), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/categories", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.categoriesHandler), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/categories/suggest", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.suggestCategory), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/categories/aliases", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.addCategoryAlias), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/categories/rename", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.renameCategory), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/categories/merge", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.mergeCategories), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/recurring", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.recurringHandler), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/attachments", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.attachmentsHandler), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/attachments/download", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.downloadAttachment), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/webhooks", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.webhooksHandler), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/webhooks/deliveries", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.listWebhookDeliveries), h.logger),
+		h.timeout,
+	),
+	)
+	mux.Handle("/api/notifications/recipients", middleware.Timeout(
+		middleware.Logging(http.HandlerFunc(h.recipientsHandler), h.logger),
+		h.timeout,
+	),
+	)
 	mux.Handle("/swagger/",
 		httpSwagger.WrapHandler,
 	)
@@ -73,19 +199,100 @@ func (h *Handler) RegisterRoutes(mux *http.ServeMux) {
 			h.timeout,
 		),
 	)
+	mux.Handle(
+		"/api/transactions/cleared",
+		middleware.Timeout(
+			middleware.Logging(
+				http.HandlerFunc(h.setCleared),
+				h.logger,
+			),
+			h.timeout,
+		),
+	)
+	mux.Handle(
+		"/api/transactions/status",
+		middleware.Timeout(
+			middleware.Logging(
+				http.HandlerFunc(h.setTransactionStatus),
+				h.logger,
+			),
+			h.timeout,
+		),
+	)
+	mux.Handle(
+		"/api/transactions/refunds",
+		middleware.Timeout(
+			middleware.Logging(
+				http.HandlerFunc(h.refundsHandler),
+				h.logger,
+			),
+			h.timeout,
+		),
+	)
+	mux.Handle(
+		"/api/transactions/status/history",
+		middleware.Timeout(
+			middleware.Logging(
+				http.HandlerFunc(h.listStatusChanges),
+				h.logger,
+			),
+			h.timeout,
+		),
+	)
+	mux.Handle(
+		"/api/transactions/duplicates/merge",
+		middleware.Timeout(
+			middleware.Logging(
+				http.HandlerFunc(h.mergeDuplicates),
+				h.logger,
+			),
+			h.timeout,
+		),
+	)
+	mux.Handle(
+		"/api/transactions/duplicates/dismiss",
+		middleware.Timeout(
+			middleware.Logging(
+				http.HandlerFunc(h.dismissDuplicates),
+				h.logger,
+			),
+			h.timeout,
+		),
+	)
 	mux.HandleFunc("/ping", h.ping)
 
 }
 
 // Ping godoc
-// @Summary Health check
+// @Summary Readiness check
+// @Description Ready while the ledger reports itself serving over grpc.health.v1, i.e. it reaches Postgres and Redis.
 // @Tags system
-// @Produce plain
-// @Success 200 {string} string "pong"
+// @Produce json
+// @Success 200 {object} ReadinessResponse
+// @Failure 503 {object} ReadinessResponse
 // @Router /ping [get]
-func (h *Handler) ping(w http.ResponseWriter, _ *http.Request) {
-	w.WriteHeader(http.StatusOK)
-	w.Write([]byte("pong"))
+func (h *Handler) ping(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	res, err := h.ledger.Health().Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		h.logger.Warn("ledger health check failed", slog.String("error", err.Error()))
+		writeJSON(w, http.StatusServiceUnavailable, ReadinessResponse{
+			Status: "unavailable",
+			Ledger: "UNREACHABLE",
+		})
+		return
+	}
+
+	out := ReadinessResponse{Status: "ready", Ledger: res.Status.String()}
+	if res.Status != healthpb.HealthCheckResponse_SERVING {
+		out.Status = "unavailable"
+		writeJSON(w, http.StatusServiceUnavailable, out)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, out)
 }
 
 func (h *Handler) transactionsHandler(w http.ResponseWriter, r *http.Request) {
@@ -110,12 +317,31 @@ func (h *Handler) budgetsHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (h *Handler) categoriesHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.listCategories(w, r)
+	case http.MethodPost:
+		h.createCategory(w, r)
+	case http.MethodPut:
+		h.updateCategory(w, r)
+	case http.MethodDelete:
+		h.deleteCategory(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
 // ReportSummary godoc
 // @Summary Get report summary
+// @Description Spend per category. With rollup=true the response is a
+// @Description ReportSummaryResponse that also has parent category totals.
 // @Tags reports
 // @Produce json
 // @Param from query string true "From date (YYYY-MM-DD)"
 // @Param to query string true "To date (YYYY-MM-DD)"
+// @Param rollup query bool false "Include totals rolled up to parent categories"
+// @Param status query []string false "Only transactions in these statuses" collectionFormat(multi) Enums(pending, cleared, reconciled)
 // @Success 200 {object} map[string]float64
 // @Failure 400 {object} ErrorResponse
 // @Router /api/reports/summary [get]
@@ -132,11 +358,27 @@ func (h *Handler) reportsSummaryHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	rollUp := false
+	if v := r.URL.Query().Get("rollup"); v != "" {
+		var err error
+		if rollUp, err = strconv.ParseBool(v); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid rollup")
+			return
+		}
+	}
+
+	statuses, err := parseStatuses(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	res, err := h.ledger.Ledger().GetReportSummary(
 		r.Context(),
 		&ledgerv1.ReportSummaryRequest{
-			From: from,
-			To:   to,
+			From:     from,
+			To:       to,
+			Statuses: statuses,
 		},
 	)
 	if err != nil {
@@ -144,108 +386,436 @@ func (h *Handler) reportsSummaryHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if rollUp {
+		writeJSON(w, http.StatusOK, ReportSummaryResponse{
+			Totals: res.Totals,
+			RollUp: res.Rollup,
+		})
+		return
+	}
+
 	writeJSON(w, http.StatusOK, res.Totals)
 }
 
-// CreateTransaction godoc
-// @Summary Create transaction
-// @Tags transactions
-// @Accept json
+// ReportSeries godoc
+// @Summary Get spending series
+// @Description Zero-filled spending per category and time bucket.
+// @Tags reports
 // @Produce json
-// @Param transaction body CreateTransactionRequest true "Transaction payload"
-// @Success 201 {object} TransactionResponse
+// @Param from query string true "From date (YYYY-MM-DD)"
+// @Param to query string true "To date (YYYY-MM-DD)"
+// @Param granularity query string false "day, week, month, quarter or year" default(month)
+// @Param category query []string false "Only these categories" collectionFormat(multi)
+// @Success 200 {object} SpendingSeriesResponse
 // @Failure 400 {object} ErrorResponse
-// @Failure 409 {object} ErrorResponse
-// @Router /api/transactions [post]
-func (h *Handler) createTransaction(w http.ResponseWriter, r *http.Request) {
-	var req CreateTransactionRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeError(w, http.StatusBadRequest, "invalid request body")
+// @Router /api/reports/series [get]
+func (h *Handler) reportsSeriesHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	q := r.URL.Query()
+	from := q.Get("from")
+	to := q.Get("to")
+	if from == "" || to == "" {
+		writeError(w, http.StatusBadRequest, "from and to are required")
 		return
 	}
 
-	res, err := h.ledger.Ledger().AddTransaction(
+	res, err := h.ledger.Ledger().GetSpendingSeries(
 		r.Context(),
-		toProtoCreateTransaction(req),
+		&ledgerv1.SpendingSeriesRequest{
+			From:        from,
+			To:          to,
+			Granularity: q.Get("granularity"),
+			Categories:  q["category"],
+		},
 	)
 	if err != nil {
 		writeGRPCError(w, err)
 		return
 	}
 
-	writeJSON(w, http.StatusCreated, toTransactionDTOFromProto(res))
+	writeJSON(w, http.StatusOK, toSpendingSeriesDTO(res))
 }
 
-// ListTransactions godoc
-// @Summary List transactions
-// @Tags transactions
+// ReportBudgets godoc
+// @Summary Get budget vs actual report
+// @Description Limit, spend and remaining amount of every budget, plus spend in unbudgeted categories.
+// @Tags reports
 // @Produce json
-// @Success 200 {array} TransactionResponse
-// @Router /api/transactions [get]
-func (h *Handler) listTransactions(w http.ResponseWriter, r *http.Request) {
-	res, err := h.ledger.Ledger().ListTransactions(
+// @Param from query string true "From date (YYYY-MM-DD)"
+// @Param to query string true "To date (YYYY-MM-DD)"
+// @Success 200 {object} BudgetReportResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /api/reports/budgets [get]
+func (h *Handler) reportsBudgetsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	from := r.URL.Query().Get("from")
+	to := r.URL.Query().Get("to")
+	if from == "" || to == "" {
+		writeError(w, http.StatusBadRequest, "from and to are required")
+		return
+	}
+
+	res, err := h.ledger.Ledger().GetBudgetReport(
 		r.Context(),
-		&emptypb.Empty{},
+		&ledgerv1.BudgetReportRequest{
+			From: from,
+			To:   to,
+		},
 	)
 	if err != nil {
 		writeGRPCError(w, err)
 		return
 	}
 
-	out := make([]TransactionResponse, 0, len(res.Transactions))
-	for _, tx := range res.Transactions {
-		out = append(out, toTransactionDTOFromProto(tx))
-	}
-
-	writeJSON(w, http.StatusOK, out)
+	writeJSON(w, http.StatusOK, toBudgetReportDTO(res))
 }
 
-// SetBudget godoc
-// @Summary Set budget
-// @Tags budgets
-// @Accept json
+// ReportForecast godoc
+// @Summary Get spending forecast
+// @Description Projected end-of-period spend per category, flagged when it would exceed the budget.
+// @Tags reports
 // @Produce json
-// @Param budget body CreateBudgetRequest true "Budget payload"
-// @Success 201 {object} BudgetResponse
+// @Param as_of query string false "Forecast date (YYYY-MM-DD), today by default"
+// @Param period query string false "week, month, quarter or year" default(month)
+// @Success 200 {object} ForecastResponse
 // @Failure 400 {object} ErrorResponse
-// @Router /api/budgets [post]
-func (h *Handler) setBudget(w http.ResponseWriter, r *http.Request) {
-	var req CreateBudgetRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeError(w, http.StatusBadRequest, "invalid request body")
+// @Router /api/reports/forecast [get]
+func (h *Handler) reportsForecastHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
 		return
 	}
 
-	res, err := h.ledger.Ledger().SetBudget(
+	res, err := h.ledger.Ledger().GetForecast(
 		r.Context(),
-		toProtoCreateBudget(req),
+		&ledgerv1.ForecastRequest{
+			AsOf:   r.URL.Query().Get("as_of"),
+			Period: r.URL.Query().Get("period"),
+		},
 	)
 	if err != nil {
 		writeGRPCError(w, err)
 		return
 	}
 
-	writeJSON(w, http.StatusCreated, toBudgetDTOFromProto(res))
+	writeJSON(w, http.StatusOK, toForecastDTO(res))
 }
 
-// ListBudgets godoc
-// @Summary List budgets
-// @Tags budgets
+// ReportAnomalies godoc
+// @Summary List anomalous transactions
+// @Description Transactions whose amount was far from the usual for their category when added.
+// @Tags reports
 // @Produce json
-// @Success 200 {array} BudgetResponse
-// @Router /api/budgets [get]
-func (h *Handler) listBudgets(w http.ResponseWriter, r *http.Request) {
-	res, err := h.ledger.Ledger().ListBudgets(
+// @Param from query string true "From date (YYYY-MM-DD)"
+// @Param to query string true "To date (YYYY-MM-DD)"
+// @Success 200 {array} TransactionResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /api/reports/anomalies [get]
+func (h *Handler) reportsAnomaliesHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	from := r.URL.Query().Get("from")
+	to := r.URL.Query().Get("to")
+	if from == "" || to == "" {
+		writeError(w, http.StatusBadRequest, "from and to are required")
+		return
+	}
+
+	res, err := h.ledger.Ledger().GetAnomalies(
 		r.Context(),
-		&emptypb.Empty{},
+		&ledgerv1.AnomaliesRequest{
+			From: from,
+			To:   to,
+		},
 	)
 	if err != nil {
 		writeGRPCError(w, err)
 		return
 	}
 
-	out := make([]BudgetResponse, 0, len(res.Budgets))
-	for _, b := range res.Budgets {
+	out := make([]TransactionResponse, 0, len(res.Transactions))
+	for _, tx := range res.Transactions {
+		out = append(out, toTransactionDTOFromProto(tx))
+	}
+
+	writeJSON(w, http.StatusOK, out)
+}
+
+// ReportDuplicates godoc
+// @Summary List suspected duplicate transactions
+// @Description Groups of transactions with the same amount, dates a few days apart and similar descriptions,
+// @Description e.g. from overlapping bank exports. Dismissed groups are left out.
+// @Tags reports
+// @Produce json
+// @Success 200 {array} DuplicateGroupResponse
+// @Router /api/reports/duplicates [get]
+func (h *Handler) reportsDuplicatesHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	res, err := h.ledger.Ledger().GetDuplicateGroups(r.Context(), &emptypb.Empty{})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	out := make([]DuplicateGroupResponse, 0, len(res.Groups))
+	for _, g := range res.Groups {
+		group := DuplicateGroupResponse{
+			Transactions: make([]TransactionResponse, 0, len(g.Transactions)),
+		}
+		for _, tx := range g.Transactions {
+			group.Transactions = append(group.Transactions, toTransactionDTOFromProto(tx))
+		}
+		out = append(out, group)
+	}
+
+	writeJSON(w, http.StatusOK, out)
+}
+
+// ReportTags godoc
+// @Summary Get report summary by tag
+// @Description Spend per tag. A transaction with several tags counts towards each of them.
+// @Tags reports
+// @Produce json
+// @Param from query string true "From date (YYYY-MM-DD)"
+// @Param to query string true "To date (YYYY-MM-DD)"
+// @Success 200 {object} map[string]float64
+// @Failure 400 {object} ErrorResponse
+// @Router /api/reports/tags [get]
+func (h *Handler) reportsTagsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	from := r.URL.Query().Get("from")
+	to := r.URL.Query().Get("to")
+	if from == "" || to == "" {
+		writeError(w, http.StatusBadRequest, "from and to are required")
+		return
+	}
+
+	res, err := h.ledger.Ledger().GetTagSummary(
+		r.Context(),
+		&ledgerv1.ReportSummaryRequest{
+			From: from,
+			To:   to,
+		},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res.Totals)
+}
+
+// ReportPayees godoc
+// @Summary Get spend by payee
+// @Description Payees with the most spend in the period, largest first.
+// @Description Transactions without a payee are left out.
+// @Tags reports
+// @Produce json
+// @Param from query string true "From date (YYYY-MM-DD)"
+// @Param to query string true "To date (YYYY-MM-DD)"
+// @Param top query int false "Number of payees to return, all by default"
+// @Success 200 {array} PayeeSpendResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /api/reports/payees [get]
+func (h *Handler) reportsPayeesHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	from := r.URL.Query().Get("from")
+	to := r.URL.Query().Get("to")
+	if from == "" || to == "" {
+		writeError(w, http.StatusBadRequest, "from and to are required")
+		return
+	}
+
+	var top uint64
+	if v := r.URL.Query().Get("top"); v != "" {
+		var err error
+		if top, err = strconv.ParseUint(v, 10, 32); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid top")
+			return
+		}
+	}
+
+	res, err := h.ledger.Ledger().GetPayeeReport(
+		r.Context(),
+		&ledgerv1.PayeeReportRequest{
+			From: from,
+			To:   to,
+			Top:  uint32(top),
+		},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	out := make([]PayeeSpendResponse, 0, len(res.Payees))
+	for _, p := range res.Payees {
+		out = append(out, PayeeSpendResponse{
+			PayeeID: p.PayeeId,
+			Payee:   p.Payee,
+			Spent:   p.Spent,
+			Count:   int(p.Count),
+		})
+	}
+
+	writeJSON(w, http.StatusOK, out)
+}
+
+// CreateTransaction godoc
+// @Summary Create transaction
+// @Tags transactions
+// @Accept json
+// @Produce json
+// @Param transaction body CreateTransactionRequest true "Transaction payload"
+// @Param dry_run query bool false "Validate and check the budget without saving"
+// @Param duplicate_policy query string false "reject, flag or allow a likely duplicate; the ledger's setting by default" Enums(reject, flag, allow)
+// @Success 201 {object} TransactionResponse
+// @Success 200 {object} TransactionResponse "Dry run passed"
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/transactions [post]
+func (h *Handler) createTransaction(w http.ResponseWriter, r *http.Request) {
+	dryRun, err := parseDryRun(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid dry_run")
+		return
+	}
+
+	policy, err := parseDuplicatePolicy(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var req CreateTransactionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	protoReq := toProtoCreateTransaction(req)
+	protoReq.DryRun = dryRun
+	protoReq.DuplicatePolicy = policy
+
+	res, err := h.ledger.Ledger().AddTransaction(r.Context(), protoReq)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	status := http.StatusCreated
+	if dryRun {
+		status = http.StatusOK
+	}
+
+	writeJSON(w, status, toTransactionDTOFromProto(res))
+}
+
+// ListTransactions godoc
+// @Summary List transactions
+// @Tags transactions
+// @Produce json
+// @Param tag query []string false "Only transactions with all of these tags" collectionFormat(multi)
+// @Param status query []string false "Only transactions in one of these statuses" collectionFormat(multi) Enums(pending, cleared, reconciled, voided)
+// @Success 200 {array} TransactionResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /api/transactions [get]
+func (h *Handler) listTransactions(w http.ResponseWriter, r *http.Request) {
+	statuses, err := parseStatuses(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	res, err := h.ledger.Ledger().ListTransactions(
+		r.Context(),
+		&ledgerv1.ListTransactionsRequest{
+			Tags:     r.URL.Query()["tag"],
+			Statuses: statuses,
+		},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	out := make([]TransactionResponse, 0, len(res.Transactions))
+	for _, tx := range res.Transactions {
+		out = append(out, toTransactionDTOFromProto(tx))
+	}
+
+	writeJSON(w, http.StatusOK, out)
+}
+
+// SetBudget godoc
+// @Summary Set budget
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Param budget body CreateBudgetRequest true "Budget payload"
+// @Success 201 {object} BudgetResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /api/budgets [post]
+func (h *Handler) setBudget(w http.ResponseWriter, r *http.Request) {
+	var req CreateBudgetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.ledger.Ledger().SetBudget(
+		r.Context(),
+		toProtoCreateBudget(req),
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toBudgetDTOFromProto(res))
+}
+
+// ListBudgets godoc
+// @Summary List budgets
+// @Tags budgets
+// @Produce json
+// @Success 200 {array} BudgetResponse
+// @Router /api/budgets [get]
+func (h *Handler) listBudgets(w http.ResponseWriter, r *http.Request) {
+	res, err := h.ledger.Ledger().ListBudgets(
+		r.Context(),
+		&emptypb.Empty{},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	out := make([]BudgetResponse, 0, len(res.Budgets))
+	for _, b := range res.Budgets {
 		out = append(out, toBudgetDTOFromProto(b))
 	}
 
@@ -258,6 +828,8 @@ func (h *Handler) listBudgets(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param request body BulkCreateTransactionsRequest true "Bulk transactions"
+// @Param dry_run query bool false "Report the would-be outcome without saving"
+// @Param duplicate_policy query string false "reject, flag or allow likely duplicates; the ledger's setting by default" Enums(reject, flag, allow)
 // @Success 200 {object} BulkCreateTransactionsResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 409 {object} ErrorResponse
@@ -268,6 +840,18 @@ func (h *Handler) bulkTransactions(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	dryRun, err := parseDryRun(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid dry_run")
+		return
+	}
+
+	policy, err := parseDuplicatePolicy(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	var req BulkCreateTransactionsRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		writeError(w, http.StatusBadRequest, "invalid request body")
@@ -279,10 +863,11 @@ func (h *Handler) bulkTransactions(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := h.ledger.Ledger().BulkAddTransactions(
-		r.Context(),
-		toProtoBulkCreateTransactions(req),
-	)
+	protoReq := toProtoBulkCreateTransactions(req)
+	protoReq.DryRun = dryRun
+	protoReq.DuplicatePolicy = policy
+
+	res, err := h.ledger.Ledger().BulkAddTransactions(r.Context(), protoReq)
 	if err != nil {
 		writeGRPCError(w, err)
 		return
@@ -295,7 +880,10 @@ func (h *Handler) bulkTransactions(w http.ResponseWriter, r *http.Request) {
 // @Summary Export transactions to CSV
 // @Tags transactions
 // @Produce text/csv
+// @Param tag query []string false "Only transactions with all of these tags" collectionFormat(multi)
+// @Param status query []string false "Only transactions in one of these statuses" collectionFormat(multi) Enums(pending, cleared, reconciled, voided)
 // @Success 200 {string} string "CSV file"
+// @Failure 400 {object} ErrorResponse
 // @Router /api/transactions/export.csv [get]
 func (h *Handler) exportTransactionsCSV(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
@@ -304,7 +892,16 @@ func (h *Handler) exportTransactionsCSV(w http.ResponseWriter, r *http.Request)
 	}
 	ctx := r.Context()
 
-	resp, err := h.ledger.Ledger().ListTransactions(ctx, &emptypb.Empty{})
+	statuses, err := parseStatuses(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := h.ledger.Ledger().ListTransactions(ctx, &ledgerv1.ListTransactionsRequest{
+		Tags:     r.URL.Query()["tag"],
+		Statuses: statuses,
+	})
 	if err != nil {
 		writeGRPCError(w, err)
 		return
@@ -322,6 +919,12 @@ func (h *Handler) exportTransactionsCSV(w http.ResponseWriter, r *http.Request)
 		"category",
 		"amount",
 		"description",
+		"tags",
+		"splits",
+		"payee",
+		"account",
+		"cleared",
+		"status",
 	})
 
 	for _, tx := range resp.Transactions {
@@ -331,6 +934,1559 @@ func (h *Handler) exportTransactionsCSV(w http.ResponseWriter, r *http.Request)
 			tx.Category,
 			fmt.Sprintf("%.2f", tx.Amount),
 			tx.Description,
+			strings.Join(tx.Tags, ";"),
+			formatSplits(tx.Splits),
+			tx.Payee,
+			tx.Account,
+			strconv.FormatBool(tx.Cleared),
+			tx.Status,
 		})
 	}
 }
+
+// ListCategories godoc
+// @Summary List categories
+// @Tags categories
+// @Produce json
+// @Success 200 {array} CategoryResponse
+// @Router /api/categories [get]
+func (h *Handler) listCategories(w http.ResponseWriter, r *http.Request) {
+	res, err := h.ledger.Ledger().ListCategories(
+		r.Context(),
+		&emptypb.Empty{},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	out := make([]CategoryResponse, 0, len(res.Categories))
+	for _, c := range res.Categories {
+		out = append(out, toCategoryDTO(c))
+	}
+
+	writeJSON(w, http.StatusOK, out)
+}
+
+// CreateCategory godoc
+// @Summary Create category
+// @Description Adds a category to the tree, optionally under an existing parent.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param category body CategoryRequest true "Category payload"
+// @Success 201 {object} CategoryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/categories [post]
+func (h *Handler) createCategory(w http.ResponseWriter, r *http.Request) {
+	var req CategoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.ledger.Ledger().CreateCategory(
+		r.Context(),
+		&ledgerv1.CreateCategoryRequest{
+			Name:   req.Name,
+			Parent: req.Parent,
+		},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toCategoryDTO(res))
+}
+
+// UpdateCategory godoc
+// @Summary Move category
+// @Description Moves a category under another parent, or to the top level when parent is empty.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param category body CategoryRequest true "Category payload"
+// @Success 200 {object} CategoryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/categories [put]
+func (h *Handler) updateCategory(w http.ResponseWriter, r *http.Request) {
+	var req CategoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.ledger.Ledger().UpdateCategory(
+		r.Context(),
+		&ledgerv1.UpdateCategoryRequest{
+			Name:   req.Name,
+			Parent: req.Parent,
+		},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toCategoryDTO(res))
+}
+
+// DeleteCategory godoc
+// @Summary Delete category
+// @Description Removes a category without subcategories. Its transactions and budgets are kept.
+// @Tags categories
+// @Param name query string true "Category name"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/categories [delete]
+func (h *Handler) deleteCategory(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("name")
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	_, err := h.ledger.Ledger().DeleteCategory(
+		r.Context(),
+		&ledgerv1.DeleteCategoryRequest{Name: name},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AddCategoryAlias godoc
+// @Summary Add category alias
+// @Description Makes alias resolve to category, ignoring case, in transactions and budgets.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param alias body CategoryAliasRequest true "Alias payload"
+// @Success 200 {object} CategoryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/categories/aliases [post]
+func (h *Handler) addCategoryAlias(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req CategoryAliasRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.ledger.Ledger().AddCategoryAlias(
+		r.Context(),
+		&ledgerv1.AddCategoryAliasRequest{
+			Alias:    req.Alias,
+			Category: req.Category,
+		},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toCategoryDTO(res))
+}
+
+// RenameCategory godoc
+// @Summary Rename category
+// @Description Renames a category and refiles its transactions and budgets. The old name becomes an alias.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param rename body RenameCategoryRequest true "Rename payload"
+// @Success 200 {object} CategoryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/categories/rename [post]
+func (h *Handler) renameCategory(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req RenameCategoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.ledger.Ledger().RenameCategory(
+		r.Context(),
+		&ledgerv1.RenameCategoryRequest{
+			From: req.From,
+			To:   req.To,
+		},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toCategoryDTO(res))
+}
+
+// MergeCategories godoc
+// @Summary Merge categories
+// @Description Moves the transactions, budgets, subcategories and aliases of the sources to the target. Budget limits are added up.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param merge body MergeCategoriesRequest true "Merge payload"
+// @Success 200 {object} CategoryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/categories/merge [post]
+func (h *Handler) mergeCategories(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req MergeCategoriesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if len(req.Sources) == 0 {
+		writeError(w, http.StatusBadRequest, "sources are required")
+		return
+	}
+
+	res, err := h.ledger.Ledger().MergeCategories(
+		r.Context(),
+		&ledgerv1.MergeCategoriesRequest{
+			Sources: req.Sources,
+			Target:  req.Target,
+		},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toCategoryDTO(res))
+}
+
+func (h *Handler) recurringHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.listRecurring(w, r)
+	case http.MethodPost:
+		h.createRecurring(w, r)
+	case http.MethodPut:
+		h.updateRecurring(w, r)
+	case http.MethodDelete:
+		h.deleteRecurring(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// ListRecurring godoc
+// @Summary List recurring transactions
+// @Tags recurring
+// @Produce json
+// @Success 200 {array} RecurringResponse
+// @Router /api/recurring [get]
+func (h *Handler) listRecurring(w http.ResponseWriter, r *http.Request) {
+	res, err := h.ledger.Ledger().ListRecurring(
+		r.Context(),
+		&emptypb.Empty{},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	out := make([]RecurringResponse, 0, len(res.Recurring))
+	for _, rt := range res.Recurring {
+		out = append(out, toRecurringDTO(rt))
+	}
+
+	writeJSON(w, http.StatusOK, out)
+}
+
+// CreateRecurring godoc
+// @Summary Create recurring transaction
+// @Description The ledger posts every date of the schedule from start_date on,
+// @Description including dates already past, as a regular transaction.
+// @Tags recurring
+// @Accept json
+// @Produce json
+// @Param recurring body RecurringRequest true "Recurring transaction"
+// @Success 201 {object} RecurringResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /api/recurring [post]
+func (h *Handler) createRecurring(w http.ResponseWriter, r *http.Request) {
+	var req RecurringRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.ledger.Ledger().CreateRecurring(
+		r.Context(),
+		&ledgerv1.CreateRecurringRequest{
+			Amount:      req.Amount,
+			Category:    req.Category,
+			Description: req.Description,
+			Schedule:    req.Schedule,
+			StartDate:   req.StartDate,
+			EndDate:     req.EndDate,
+		},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toRecurringDTO(res))
+}
+
+// UpdateRecurring godoc
+// @Summary Update recurring transaction
+// @Description Replaces a recurring transaction. Dates before the next due one are not backfilled.
+// @Tags recurring
+// @Accept json
+// @Produce json
+// @Param recurring body RecurringRequest true "Recurring transaction with id"
+// @Success 200 {object} RecurringResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/recurring [put]
+func (h *Handler) updateRecurring(w http.ResponseWriter, r *http.Request) {
+	var req RecurringRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.ID <= 0 {
+		writeError(w, http.StatusBadRequest, "id is required")
+		return
+	}
+
+	res, err := h.ledger.Ledger().UpdateRecurring(
+		r.Context(),
+		&ledgerv1.UpdateRecurringRequest{
+			Id:          req.ID,
+			Amount:      req.Amount,
+			Category:    req.Category,
+			Description: req.Description,
+			Schedule:    req.Schedule,
+			StartDate:   req.StartDate,
+			EndDate:     req.EndDate,
+		},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toRecurringDTO(res))
+}
+
+// DeleteRecurring godoc
+// @Summary Delete recurring transaction
+// @Description Stops the schedule. Transactions already posted are kept.
+// @Tags recurring
+// @Param id query int true "Recurring transaction id"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/recurring [delete]
+func (h *Handler) deleteRecurring(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err != nil || id <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	_, err = h.ledger.Ledger().DeleteRecurring(
+		r.Context(),
+		&ledgerv1.DeleteRecurringRequest{Id: id},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SuggestCategory godoc
+// @Summary Suggest categories
+// @Description Categories learned from past transactions with similar
+// @Description descriptions, payees and amounts, most likely first.
+// @Tags categories
+// @Produce json
+// @Param description query string true "Transaction description"
+// @Param amount query number false "Transaction amount"
+// @Param payee query string false "Payee name"
+// @Param limit query int false "Number of suggestions, 3 by default"
+// @Success 200 {array} CategorySuggestionResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /api/categories/suggest [get]
+func (h *Handler) suggestCategory(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	q := r.URL.Query()
+	description := q.Get("description")
+	if description == "" {
+		writeError(w, http.StatusBadRequest, "description is required")
+		return
+	}
+
+	var amount float64
+	if v := q.Get("amount"); v != "" {
+		var err error
+		if amount, err = strconv.ParseFloat(v, 64); err != nil || amount < 0 {
+			writeError(w, http.StatusBadRequest, "invalid amount")
+			return
+		}
+	}
+
+	var limit uint64
+	if v := q.Get("limit"); v != "" {
+		var err error
+		if limit, err = strconv.ParseUint(v, 10, 32); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid limit")
+			return
+		}
+	}
+
+	res, err := h.ledger.Ledger().SuggestCategory(
+		r.Context(),
+		&ledgerv1.SuggestCategoryRequest{
+			Description: description,
+			Amount:      amount,
+			Payee:       q.Get("payee"),
+			Limit:       uint32(limit),
+		},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	out := make([]CategorySuggestionResponse, 0, len(res.Suggestions))
+	for _, s := range res.Suggestions {
+		out = append(out, CategorySuggestionResponse{
+			Category:   s.Category,
+			Confidence: s.Confidence,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (h *Handler) rulesHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.listRules(w, r)
+	case http.MethodPost:
+		h.createRule(w, r)
+	case http.MethodPut:
+		h.updateRule(w, r)
+	case http.MethodDelete:
+		h.deleteRule(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// ListRules godoc
+// @Summary List categorization rules
+// @Description Rules in the order they run, lowest priority first.
+// @Tags rules
+// @Produce json
+// @Success 200 {array} RuleResponse
+// @Router /api/rules [get]
+func (h *Handler) listRules(w http.ResponseWriter, r *http.Request) {
+	res, err := h.ledger.Ledger().ListRules(
+		r.Context(),
+		&emptypb.Empty{},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	out := make([]RuleResponse, 0, len(res.Rules))
+	for _, rule := range res.Rules {
+		out = append(out, toRuleDTO(rule))
+	}
+
+	writeJSON(w, http.StatusOK, out)
+}
+
+// CreateRule godoc
+// @Summary Create categorization rule
+// @Description Rules run on new and imported transactions before validation.
+// @Description Every condition that is set must match. A field set by a
+// @Description lower priority rule is kept; tags of all matches are added.
+// @Tags rules
+// @Accept json
+// @Produce json
+// @Param rule body RuleRequest true "Rule"
+// @Success 201 {object} RuleResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /api/rules [post]
+func (h *Handler) createRule(w http.ResponseWriter, r *http.Request) {
+	var req RuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.ledger.Ledger().CreateRule(r.Context(), toProtoRule(req))
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toRuleDTO(res))
+}
+
+// UpdateRule godoc
+// @Summary Update categorization rule
+// @Description Past transactions only change when the rules are re-applied.
+// @Tags rules
+// @Accept json
+// @Produce json
+// @Param rule body RuleRequest true "Rule with id"
+// @Success 200 {object} RuleResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/rules [put]
+func (h *Handler) updateRule(w http.ResponseWriter, r *http.Request) {
+	var req RuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.ID <= 0 {
+		writeError(w, http.StatusBadRequest, "id is required")
+		return
+	}
+
+	res, err := h.ledger.Ledger().UpdateRule(r.Context(), toProtoRule(req))
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toRuleDTO(res))
+}
+
+// DeleteRule godoc
+// @Summary Delete categorization rule
+// @Tags rules
+// @Param id query int true "Rule id"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/rules [delete]
+func (h *Handler) deleteRule(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err != nil || id <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	_, err = h.ledger.Ledger().DeleteRule(
+		r.Context(),
+		&ledgerv1.DeleteRuleRequest{Id: id},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ApplyRules godoc
+// @Summary Re-apply rules to past transactions
+// @Description Runs the rules against every stored transaction and returns
+// @Description what changes. With dry_run=true nothing is saved.
+// @Tags rules
+// @Produce json
+// @Param dry_run query bool false "Preview the changes without saving"
+// @Success 200 {array} RuleChangeResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /api/rules/apply [post]
+func (h *Handler) applyRules(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	dryRun, err := parseDryRun(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid dry_run")
+		return
+	}
+
+	res, err := h.ledger.Ledger().ApplyRules(
+		r.Context(),
+		&ledgerv1.ApplyRulesRequest{DryRun: dryRun},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	out := make([]RuleChangeResponse, 0, len(res.Changes))
+	for _, c := range res.Changes {
+		out = append(out, RuleChangeResponse{
+			TransactionID: c.TransactionId,
+			RuleIDs:       c.RuleIds,
+			Before:        toTransactionDTOFromProto(c.Before),
+			After:         toTransactionDTOFromProto(c.After),
+			CategoryKept:  c.CategoryKept,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (h *Handler) payeesHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.listPayees(w, r)
+	case http.MethodPost:
+		h.createPayee(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// ListPayees godoc
+// @Summary List payees
+// @Tags payees
+// @Produce json
+// @Success 200 {array} PayeeResponse
+// @Router /api/payees [get]
+func (h *Handler) listPayees(w http.ResponseWriter, r *http.Request) {
+	res, err := h.ledger.Ledger().ListPayees(
+		r.Context(),
+		&emptypb.Empty{},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	out := make([]PayeeResponse, 0, len(res.Payees))
+	for _, p := range res.Payees {
+		out = append(out, toPayeeDTO(p))
+	}
+
+	writeJSON(w, http.StatusOK, out)
+}
+
+// CreatePayee godoc
+// @Summary Create payee
+// @Description The name is normalized: whitespace is collapsed, card
+// @Description processor prefixes and store numbers are dropped.
+// @Tags payees
+// @Accept json
+// @Produce json
+// @Param payee body PayeeRequest true "Payee"
+// @Success 201 {object} PayeeResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/payees [post]
+func (h *Handler) createPayee(w http.ResponseWriter, r *http.Request) {
+	var req PayeeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.ledger.Ledger().CreatePayee(
+		r.Context(),
+		&ledgerv1.CreatePayeeRequest{Name: req.Name},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toPayeeDTO(res))
+}
+
+// maxAttachmentSize matches the limit of the ledger.
+const maxAttachmentSize = 10 << 20
+
+// attachmentTypes are the receipt formats the ledger accepts, as detected
+// from the file content.
+var attachmentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/webp":      true,
+}
+
+func (h *Handler) attachmentsHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.listAttachments(w, r)
+	case http.MethodPost:
+		h.uploadAttachment(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// ListAttachments godoc
+// @Summary List attachments of a transaction
+// @Tags attachments
+// @Produce json
+// @Param transaction_id query int true "Transaction ID"
+// @Success 200 {array} AttachmentResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /api/attachments [get]
+func (h *Handler) listAttachments(w http.ResponseWriter, r *http.Request) {
+	txID, err := strconv.ParseInt(r.URL.Query().Get("transaction_id"), 10, 64)
+	if err != nil || txID <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid transaction_id")
+		return
+	}
+
+	res, err := h.ledger.Ledger().ListAttachments(
+		r.Context(),
+		&ledgerv1.ListAttachmentsRequest{TransactionId: txID},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	out := make([]AttachmentResponse, 0, len(res.Attachments))
+	for _, a := range res.Attachments {
+		out = append(out, toAttachmentDTO(a))
+	}
+
+	writeJSON(w, http.StatusOK, out)
+}
+
+// UploadAttachment godoc
+// @Summary Upload attachment
+// @Description Stores a receipt with a transaction. Files are limited to
+// @Description 10 MiB and must be PDF, JPEG, PNG or WebP; the type is
+// @Description detected from the content.
+// @Tags attachments
+// @Accept multipart/form-data
+// @Produce json
+// @Param transaction_id formData int true "Transaction ID"
+// @Param file formData file true "Receipt"
+// @Success 201 {object} AttachmentResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 413 {object} ErrorResponse
+// @Failure 415 {object} ErrorResponse
+// @Router /api/attachments [post]
+func (h *Handler) uploadAttachment(w http.ResponseWriter, r *http.Request) {
+	// Leave room for the form fields and multipart framing.
+	r.Body = http.MaxBytesReader(w, r.Body, maxAttachmentSize+1<<20)
+	if err := r.ParseMultipartForm(1 << 20); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, "attachment too large")
+			return
+		}
+		writeError(w, http.StatusBadRequest, "invalid multipart form")
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	txID, err := strconv.ParseInt(r.FormValue("transaction_id"), 10, 64)
+	if err != nil || txID <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid transaction_id")
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "file is required")
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxAttachmentSize+1))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid file")
+		return
+	}
+	if len(data) > maxAttachmentSize {
+		writeError(w, http.StatusRequestEntityTooLarge, "attachment too large")
+		return
+	}
+	if len(data) == 0 {
+		writeError(w, http.StatusBadRequest, "file is empty")
+		return
+	}
+
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(data))
+	if !attachmentTypes[contentType] {
+		writeError(w, http.StatusUnsupportedMediaType, "unsupported attachment type "+contentType)
+		return
+	}
+
+	res, err := h.ledger.Ledger().UploadAttachment(
+		r.Context(),
+		&ledgerv1.UploadAttachmentRequest{
+			TransactionId: txID,
+			Filename:      header.Filename,
+			ContentType:   contentType,
+			Data:          data,
+		},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toAttachmentDTO(res))
+}
+
+// DownloadAttachment godoc
+// @Summary Download attachment
+// @Tags attachments
+// @Produce application/octet-stream
+// @Param id query int true "Attachment ID"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/attachments/download [get]
+func (h *Handler) downloadAttachment(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err != nil || id <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	res, err := h.ledger.Ledger().GetAttachment(
+		r.Context(),
+		&ledgerv1.GetAttachmentRequest{Id: id},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	a := res.Attachment
+	w.Header().Set("Content-Type", a.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(res.Data)))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{
+		"filename": a.Filename,
+	}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	w.Write(res.Data)
+}
+
+// MergeDuplicates godoc
+// @Summary Merge duplicate transactions
+// @Description Keeps keep_id and deletes duplicate_ids, moving their tags and attachments to the one kept.
+// @Tags transactions
+// @Accept json
+// @Param merge body MergeDuplicatesRequest true "Merge payload"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/transactions/duplicates/merge [post]
+func (h *Handler) mergeDuplicates(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req MergeDuplicatesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.KeepID <= 0 || len(req.DuplicateIDs) == 0 {
+		writeError(w, http.StatusBadRequest, "keep_id and duplicate_ids are required")
+		return
+	}
+
+	_, err := h.ledger.Ledger().MergeDuplicates(
+		r.Context(),
+		&ledgerv1.MergeDuplicatesRequest{
+			KeepId:       req.KeepID,
+			DuplicateIds: req.DuplicateIDs,
+		},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DismissDuplicates godoc
+// @Summary Dismiss suspected duplicates
+// @Description Marks the transactions as separate, so they are no longer reported as duplicates of each other.
+// @Tags transactions
+// @Accept json
+// @Param dismiss body DismissDuplicatesRequest true "Dismiss payload"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/transactions/duplicates/dismiss [post]
+func (h *Handler) dismissDuplicates(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req DismissDuplicatesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if len(req.TransactionIDs) < 2 {
+		writeError(w, http.StatusBadRequest, "at least two transaction_ids are required")
+		return
+	}
+
+	_, err := h.ledger.Ledger().DismissDuplicates(
+		r.Context(),
+		&ledgerv1.DismissDuplicatesRequest{TransactionIds: req.TransactionIDs},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) reconciliationsHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.listReconciliations(w, r)
+	case http.MethodPost:
+		h.startReconciliation(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// ListReconciliations godoc
+// @Summary List reconciliations
+// @Tags reconciliations
+// @Produce json
+// @Success 200 {array} ReconciliationResponse
+// @Router /api/reconciliations [get]
+func (h *Handler) listReconciliations(w http.ResponseWriter, r *http.Request) {
+	res, err := h.ledger.Ledger().ListReconciliations(r.Context(), &emptypb.Empty{})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	out := make([]ReconciliationResponse, 0, len(res.Reconciliations))
+	for _, rec := range res.Reconciliations {
+		out = append(out, toReconciliationDTO(rec))
+	}
+
+	writeJSON(w, http.StatusOK, out)
+}
+
+// StartReconciliation godoc
+// @Summary Start a reconciliation against a bank statement
+// @Description Opens a reconciliation of an account up to the statement date and lists the transactions to clear.
+// @Description An account has at most one open reconciliation.
+// @Tags reconciliations
+// @Accept json
+// @Produce json
+// @Param reconciliation body StartReconciliationRequest true "Statement"
+// @Success 201 {object} ReconciliationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/reconciliations [post]
+func (h *Handler) startReconciliation(w http.ResponseWriter, r *http.Request) {
+	var req StartReconciliationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if _, err := time.Parse("2006-01-02", req.StatementDate); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid statement_date")
+		return
+	}
+
+	res, err := h.ledger.Ledger().StartReconciliation(
+		r.Context(),
+		&ledgerv1.StartReconciliationRequest{
+			Account:          req.Account,
+			StatementDate:    req.StatementDate,
+			StatementBalance: req.StatementBalance,
+			OpeningBalance:   req.OpeningBalance,
+		},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toReconciliationDTO(res))
+}
+
+// GetReconciliation godoc
+// @Summary Get a reconciliation
+// @Description The difference to the statement and, while it is open, the transactions left to clear.
+// @Tags reconciliations
+// @Produce json
+// @Param id query int true "Reconciliation id"
+// @Success 200 {object} ReconciliationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/reconciliations/detail [get]
+func (h *Handler) getReconciliation(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err != nil || id <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	res, err := h.ledger.Ledger().GetReconciliation(
+		r.Context(),
+		&ledgerv1.ReconciliationRequest{Id: id},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toReconciliationDTO(res))
+}
+
+// CompleteReconciliation godoc
+// @Summary Complete a reconciliation
+// @Description Locks the cleared transactions against edits. Fails while the difference is not zero.
+// @Tags reconciliations
+// @Produce json
+// @Param id query int true "Reconciliation id"
+// @Success 200 {object} ReconciliationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/reconciliations/complete [post]
+func (h *Handler) completeReconciliation(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err != nil || id <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	res, err := h.ledger.Ledger().CompleteReconciliation(
+		r.Context(),
+		&ledgerv1.ReconciliationRequest{Id: id},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toReconciliationDTO(res))
+}
+
+// SetCleared godoc
+// @Summary Mark transactions cleared
+// @Description Marks transactions as seen on a bank statement, or not. Reconciled transactions cannot change.
+// @Tags reconciliations
+// @Accept json
+// @Param cleared body SetClearedRequest true "Transactions"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/transactions/cleared [post]
+func (h *Handler) setCleared(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req SetClearedRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if len(req.TransactionIDs) == 0 {
+		writeError(w, http.StatusBadRequest, "transaction_ids are required")
+		return
+	}
+
+	_, err := h.ledger.Ledger().SetCleared(
+		r.Context(),
+		&ledgerv1.SetClearedRequest{
+			TransactionIds: req.TransactionIDs,
+			Cleared:        req.Cleared,
+		},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SetTransactionStatus godoc
+// @Summary Change transaction status
+// @Description Moves transactions to pending, cleared or voided. Voided transactions stay listed but count towards no budget or report. Reconciled and voided transactions cannot change.
+// @Tags transactions
+// @Accept json
+// @Param status body SetTransactionStatusRequest true "Transactions and their new status"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/transactions/status [post]
+func (h *Handler) setTransactionStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req SetTransactionStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if len(req.TransactionIDs) == 0 {
+		writeError(w, http.StatusBadRequest, "transaction_ids are required")
+		return
+	}
+	if req.Status == "" {
+		writeError(w, http.StatusBadRequest, "status is required")
+		return
+	}
+
+	_, err := h.ledger.Ledger().SetTransactionStatus(
+		r.Context(),
+		&ledgerv1.SetTransactionStatusRequest{
+			TransactionIds: req.TransactionIDs,
+			Status:         req.Status,
+		},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListStatusChanges godoc
+// @Summary Get transaction status history
+// @Description Every status the transaction had, oldest first, with when it changed.
+// @Tags transactions
+// @Produce json
+// @Param id query int true "Transaction id"
+// @Success 200 {array} StatusChangeResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/transactions/status/history [get]
+func (h *Handler) listStatusChanges(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err != nil || id <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	res, err := h.ledger.Ledger().ListStatusChanges(
+		r.Context(),
+		&ledgerv1.StatusChangesRequest{TransactionId: id},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	out := make([]StatusChangeResponse, 0, len(res.Changes))
+	for _, c := range res.Changes {
+		out = append(out, toStatusChangeDTO(c))
+	}
+
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (h *Handler) refundsHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.listRefunds(w, r)
+	case http.MethodPost:
+		h.createRefund(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// CreateRefund godoc
+// @Summary Refund a transaction
+// @Description Gives back part or all of a transaction. The refund takes spend off the original's category on the original's date, so budgets and reports for that period go down. Refunds of a transaction never add up to more than it cost.
+// @Tags transactions
+// @Accept json
+// @Produce json
+// @Param refund body CreateRefundRequest true "Refund"
+// @Success 201 {object} RefundResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/transactions/refunds [post]
+func (h *Handler) createRefund(w http.ResponseWriter, r *http.Request) {
+	var req CreateRefundRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.TransactionID <= 0 {
+		writeError(w, http.StatusBadRequest, "transaction_id is required")
+		return
+	}
+	if req.Amount <= 0 {
+		writeError(w, http.StatusBadRequest, "amount should be > 0")
+		return
+	}
+
+	res, err := h.ledger.Ledger().CreateRefund(r.Context(), toProtoCreateRefund(req))
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toRefundDTO(res))
+}
+
+// ListRefunds godoc
+// @Summary List refunds of a transaction
+// @Tags transactions
+// @Produce json
+// @Param transaction_id query int true "Transaction ID"
+// @Success 200 {array} RefundResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /api/transactions/refunds [get]
+func (h *Handler) listRefunds(w http.ResponseWriter, r *http.Request) {
+	txID, err := strconv.ParseInt(r.URL.Query().Get("transaction_id"), 10, 64)
+	if err != nil || txID <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid transaction_id")
+		return
+	}
+
+	res, err := h.ledger.Ledger().ListRefunds(
+		r.Context(),
+		&ledgerv1.ListRefundsRequest{TransactionId: txID},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	out := make([]RefundResponse, 0, len(res.Refunds))
+	for _, rf := range res.Refunds {
+		out = append(out, toRefundDTO(rf))
+	}
+
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (h *Handler) webhooksHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.listWebhooks(w, r)
+	case http.MethodPost:
+		h.createWebhook(w, r)
+	case http.MethodDelete:
+		h.deleteWebhook(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// ListWebhooks godoc
+// @Summary List webhooks
+// @Description Secrets are left out.
+// @Tags webhooks
+// @Produce json
+// @Success 200 {array} WebhookResponse
+// @Router /api/webhooks [get]
+func (h *Handler) listWebhooks(w http.ResponseWriter, r *http.Request) {
+	res, err := h.ledger.Ledger().ListWebhooks(r.Context(), &emptypb.Empty{})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	out := make([]WebhookResponse, 0, len(res.Webhooks))
+	for _, wh := range res.Webhooks {
+		out = append(out, toWebhookDTO(wh))
+	}
+
+	writeJSON(w, http.StatusOK, out)
+}
+
+// CreateWebhook godoc
+// @Summary Create webhook
+// @Description Subscribes an HTTP endpoint to ledger events. Every event is POSTed as JSON and signed: X-FinScope-Signature is sha256= and the hex HMAC-SHA256 of "<X-FinScope-Timestamp>.<body>" keyed with the secret. Failed deliveries are retried with backoff. The secret is only returned here.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param webhook body CreateWebhookRequest true "Webhook"
+// @Success 201 {object} WebhookResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /api/webhooks [post]
+func (h *Handler) createWebhook(w http.ResponseWriter, r *http.Request) {
+	var req CreateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.URL == "" {
+		writeError(w, http.StatusBadRequest, "url is required")
+		return
+	}
+	if len(req.Events) == 0 {
+		writeError(w, http.StatusBadRequest, "events are required")
+		return
+	}
+
+	res, err := h.ledger.Ledger().CreateWebhook(
+		r.Context(),
+		&ledgerv1.CreateWebhookRequest{
+			Url:       req.URL,
+			Secret:    req.Secret,
+			Events:    req.Events,
+			MinAmount: req.MinAmount,
+			Threshold: req.Threshold,
+		},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toWebhookDTO(res))
+}
+
+// DeleteWebhook godoc
+// @Summary Delete webhook
+// @Description Stops deliveries and drops the delivery log of the webhook.
+// @Tags webhooks
+// @Param id query int true "Webhook id"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/webhooks [delete]
+func (h *Handler) deleteWebhook(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err != nil || id <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	_, err = h.ledger.Ledger().DeleteWebhook(
+		r.Context(),
+		&ledgerv1.DeleteWebhookRequest{Id: id},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListWebhookDeliveries godoc
+// @Summary List webhook deliveries
+// @Description Every delivery attempt of a webhook, latest first, failed ones included.
+// @Tags webhooks
+// @Produce json
+// @Param id query int true "Webhook id"
+// @Param limit query int false "Number of attempts (default 50, at most 500)"
+// @Success 200 {array} WebhookDeliveryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/webhooks/deliveries [get]
+func (h *Handler) listWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	q := r.URL.Query()
+	id, err := strconv.ParseInt(q.Get("id"), 10, 64)
+	if err != nil || id <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var limit uint64
+	if v := q.Get("limit"); v != "" {
+		if limit, err = strconv.ParseUint(v, 10, 32); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid limit")
+			return
+		}
+	}
+
+	res, err := h.ledger.Ledger().ListWebhookDeliveries(
+		r.Context(),
+		&ledgerv1.WebhookDeliveriesRequest{WebhookId: id, Limit: uint32(limit)},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	out := make([]WebhookDeliveryResponse, 0, len(res.Deliveries))
+	for _, d := range res.Deliveries {
+		out = append(out, toWebhookDeliveryDTO(d))
+	}
+
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (h *Handler) recipientsHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.listRecipients(w, r)
+	case http.MethodPost:
+		h.setRecipient(w, r)
+	case http.MethodDelete:
+		h.deleteRecipient(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// ListRecipients godoc
+// @Summary List notification recipients
+// @Tags notifications
+// @Produce json
+// @Success 200 {array} RecipientResponse
+// @Router /api/notifications/recipients [get]
+func (h *Handler) listRecipients(w http.ResponseWriter, r *http.Request) {
+	res, err := h.ledger.Ledger().ListNotificationRecipients(r.Context(), &emptypb.Empty{})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	out := make([]RecipientResponse, 0, len(res.Recipients))
+	for _, rcpt := range res.Recipients {
+		out = append(out, toRecipientDTO(rcpt))
+	}
+
+	writeJSON(w, http.StatusOK, out)
+}
+
+// SetRecipient godoc
+// @Summary Set notification recipient
+// @Description Opts an email address in to budget alerts and the weekly spending digest. Posting an address again replaces its name and opt-ins.
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param recipient body SetRecipientRequest true "Recipient"
+// @Success 201 {object} RecipientResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /api/notifications/recipients [post]
+func (h *Handler) setRecipient(w http.ResponseWriter, r *http.Request) {
+	var req SetRecipientRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Email == "" {
+		writeError(w, http.StatusBadRequest, "email is required")
+		return
+	}
+
+	res, err := h.ledger.Ledger().SetNotificationRecipient(
+		r.Context(),
+		&ledgerv1.SetNotificationRecipientRequest{
+			Name:         req.Name,
+			Email:        req.Email,
+			BudgetAlerts: req.BudgetAlerts,
+			WeeklyDigest: req.WeeklyDigest,
+		},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toRecipientDTO(res))
+}
+
+// DeleteRecipient godoc
+// @Summary Delete notification recipient
+// @Tags notifications
+// @Param id query int true "Recipient id"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/notifications/recipients [delete]
+func (h *Handler) deleteRecipient(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err != nil || id <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	_, err = h.ledger.Ledger().DeleteNotificationRecipient(
+		r.Context(),
+		&ledgerv1.DeleteNotificationRecipientRequest{Id: id},
+	)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
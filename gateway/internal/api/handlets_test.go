@@ -1,10 +1,24 @@
 package api
 
 import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"mime/multipart"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/lyagu5h/finScope/gateway/internal/delivery/client"
+	ledgerv1 "github.com/lyagu5h/finScope/gateway/internal/delivery/protos/ledger/v1"
 )
 
 func TestBudgetsHandler_MethodNotAllowed(t *testing.T) {
@@ -122,3 +136,411 @@ func TestBulkTransactions_EmptyList(t *testing.T) {
 		t.Fatalf("expected 400, got %d", rec.Code)
 	}
 }
+
+func TestCreateTransaction_InvalidDryRun(t *testing.T) {
+	h := &Handler{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/transactions", h.transactionsHandler)
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/api/transactions?dry_run=maybe",
+		strings.NewReader(`{"amount":10,"category":"food"}`),
+	)
+	rec := httptest.NewRecorder()
+
+	mux.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", rec.Code)
+	}
+}
+
+func TestReportsSeries_MissingParams(t *testing.T) {
+	h := &Handler{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/reports/series", h.reportsSeriesHandler)
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/reports/series?granularity=week",
+		nil,
+	)
+	rec := httptest.NewRecorder()
+
+	mux.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", rec.Code)
+	}
+}
+
+func TestDeleteCategory_MissingName(t *testing.T) {
+	h := &Handler{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/categories", h.categoriesHandler)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/categories", nil)
+	rec := httptest.NewRecorder()
+
+	mux.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", rec.Code)
+	}
+}
+
+func TestReportsSummaryHandler_InvalidRollUp(t *testing.T) {
+	h := &Handler{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/reports/summary", h.reportsSummaryHandler)
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/reports/summary?from=2024-01-01&to=2024-01-31&rollup=maybe",
+		nil,
+	)
+	rec := httptest.NewRecorder()
+
+	mux.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", rec.Code)
+	}
+}
+
+func TestMergeCategories_MissingSources(t *testing.T) {
+	h := &Handler{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/categories/merge", h.mergeCategories)
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/api/categories/merge",
+		strings.NewReader(`{"target":"Food"}`),
+	)
+	rec := httptest.NewRecorder()
+
+	mux.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", rec.Code)
+	}
+}
+
+func TestRecurringHandler_InvalidID(t *testing.T) {
+	h := &Handler{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/recurring", h.recurringHandler)
+
+	for _, req := range []*http.Request{
+		httptest.NewRequest(http.MethodDelete, "/api/recurring?id=abc", nil),
+		httptest.NewRequest(http.MethodPut, "/api/recurring", strings.NewReader(`{"amount":10}`)),
+	} {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("%s: expected 400, got %d", req.Method, rec.Code)
+		}
+	}
+}
+
+func TestFormatSplits(t *testing.T) {
+	got := formatSplits([]*ledgerv1.Split{
+		{Category: "groceries", Amount: 30},
+		{Category: "household", Amount: 12.5},
+	})
+
+	if got != "groceries:30.00;household:12.50" {
+		t.Fatalf("unexpected splits column: %q", got)
+	}
+}
+
+func TestUploadAttachment_UnsupportedType(t *testing.T) {
+	h := &Handler{}
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	_ = form.WriteField("transaction_id", "1")
+	file, _ := form.CreateFormFile("file", "receipt.pdf")
+	_, _ = file.Write([]byte("#!/bin/sh\necho not a receipt\n"))
+	_ = form.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/attachments", &body)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	rec := httptest.NewRecorder()
+
+	h.attachmentsHandler(rec, req)
+
+	if rec.Code != http.StatusUnsupportedMediaType {
+		t.Fatalf("expected status 415, got %d", rec.Code)
+	}
+}
+
+func TestListAttachments_MissingTransactionID(t *testing.T) {
+	h := &Handler{}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/attachments", nil)
+	rec := httptest.NewRecorder()
+
+	h.attachmentsHandler(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rec.Code)
+	}
+}
+
+func TestReportsPayees_InvalidTop(t *testing.T) {
+	h := &Handler{}
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/reports/payees?from=2026-10-01&to=2026-10-31&top=-1",
+		nil,
+	)
+	rec := httptest.NewRecorder()
+
+	h.reportsPayeesHandler(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rec.Code)
+	}
+}
+
+func TestApplyRules_InvalidDryRun(t *testing.T) {
+	h := &Handler{}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/rules/apply?dry_run=maybe", nil)
+	rec := httptest.NewRecorder()
+
+	h.applyRules(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rec.Code)
+	}
+}
+
+func TestSuggestCategory_MissingDescription(t *testing.T) {
+	h := &Handler{}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/categories/suggest?amount=4.5", nil)
+	rec := httptest.NewRecorder()
+
+	h.suggestCategory(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rec.Code)
+	}
+}
+
+func TestCreateTransaction_InvalidDuplicatePolicy(t *testing.T) {
+	h := &Handler{}
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/api/transactions?duplicate_policy=ignore",
+		strings.NewReader(`{"amount":10,"category":"food"}`),
+	)
+	rec := httptest.NewRecorder()
+
+	h.createTransaction(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rec.Code)
+	}
+}
+
+func TestMergeDuplicates_MissingIDs(t *testing.T) {
+	h := &Handler{}
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/api/transactions/duplicates/merge",
+		strings.NewReader(`{"keep_id":1}`),
+	)
+	rec := httptest.NewRecorder()
+
+	h.mergeDuplicates(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rec.Code)
+	}
+}
+
+func TestStartReconciliation_InvalidStatementDate(t *testing.T) {
+	h := &Handler{}
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/api/reconciliations",
+		strings.NewReader(`{"account":"checking","statement_date":"31/10/2026","statement_balance":100}`),
+	)
+	rec := httptest.NewRecorder()
+
+	h.reconciliationsHandler(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rec.Code)
+	}
+}
+
+func TestCompleteReconciliation_InvalidID(t *testing.T) {
+	h := &Handler{}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/reconciliations/complete?id=abc", nil)
+	rec := httptest.NewRecorder()
+
+	h.completeReconciliation(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rec.Code)
+	}
+}
+
+func TestListTransactions_InvalidStatus(t *testing.T) {
+	h := &Handler{}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/transactions?status=cleared&status=settled", nil)
+	rec := httptest.NewRecorder()
+
+	h.listTransactions(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rec.Code)
+	}
+}
+
+func TestSetTransactionStatus_MissingStatus(t *testing.T) {
+	h := &Handler{}
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/api/transactions/status",
+		strings.NewReader(`{"transaction_ids":[1,2]}`),
+	)
+	rec := httptest.NewRecorder()
+
+	h.setTransactionStatus(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rec.Code)
+	}
+}
+
+func TestCreateRefund_InvalidAmount(t *testing.T) {
+	h := &Handler{}
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/api/transactions/refunds",
+		strings.NewReader(`{"transaction_id":1,"amount":0}`),
+	)
+	rec := httptest.NewRecorder()
+
+	h.refundsHandler(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rec.Code)
+	}
+}
+
+func TestListRefunds_InvalidTransactionID(t *testing.T) {
+	h := &Handler{}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/transactions/refunds?transaction_id=abc", nil)
+	rec := httptest.NewRecorder()
+
+	h.refundsHandler(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rec.Code)
+	}
+}
+
+func TestCreateWebhook_MissingEvents(t *testing.T) {
+	h := &Handler{}
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/api/webhooks",
+		strings.NewReader(`{"url":"http://localhost:8123/hook"}`),
+	)
+	rec := httptest.NewRecorder()
+
+	h.webhooksHandler(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rec.Code)
+	}
+}
+
+func TestListWebhookDeliveries_InvalidID(t *testing.T) {
+	h := &Handler{}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/webhooks/deliveries?id=abc", nil)
+	rec := httptest.NewRecorder()
+
+	h.listWebhookDeliveries(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rec.Code)
+	}
+}
+
+func TestSetRecipient_MissingEmail(t *testing.T) {
+	h := &Handler{}
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/api/notifications/recipients",
+		strings.NewReader(`{"name":"Alex","budget_alerts":true}`),
+	)
+	rec := httptest.NewRecorder()
+
+	h.recipientsHandler(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rec.Code)
+	}
+}
+
+func TestPing_ReflectsLedgerHealth(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	hs := health.NewServer()
+	srv := grpc.NewServer()
+	healthpb.RegisterHealthServer(srv, hs)
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	ledger, err := client.New(lis.Addr().String())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	h := NewHandler(ledger, slog.New(slog.NewTextHandler(io.Discard, nil)), time.Second)
+
+	tests := []struct {
+		status healthpb.HealthCheckResponse_ServingStatus
+		code   int
+		want   ReadinessResponse
+	}{
+		{healthpb.HealthCheckResponse_SERVING, http.StatusOK, ReadinessResponse{Status: "ready", Ledger: "SERVING"}},
+		{healthpb.HealthCheckResponse_NOT_SERVING, http.StatusServiceUnavailable, ReadinessResponse{Status: "unavailable", Ledger: "NOT_SERVING"}},
+	}
+
+	for _, tt := range tests {
+		hs.SetServingStatus("", tt.status)
+
+		rec := httptest.NewRecorder()
+		h.ping(rec, httptest.NewRequest(http.MethodGet, "/ping", nil))
+
+		var got ReadinessResponse
+		_ = json.Unmarshal(rec.Body.Bytes(), &got)
+		if rec.Code != tt.code || got != tt.want {
+			t.Fatalf("ledger %s: expected %d %+v, got %d %+v", tt.status, tt.code, tt.want, rec.Code, got)
+		}
+	}
+}
@@ -2,7 +2,12 @@ package api
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+
+	ledgerv1 "github.com/lyagu5h/finScope/gateway/internal/delivery/protos/ledger/v1"
 )
 
 func writeJSON(w http.ResponseWriter, status int, v any) {
@@ -16,3 +21,51 @@ func writeError(w http.ResponseWriter, status int, msg string) {
 		"error": msg,
 	})
 }
+
+// parseDryRun reads the optional ?dry_run= query flag.
+func parseDryRun(r *http.Request) (bool, error) {
+	v := r.URL.Query().Get("dry_run")
+	if v == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(v)
+}
+
+// parseDuplicatePolicy reads the optional ?duplicate_policy= query
+// parameter: reject, flag or allow.
+func parseDuplicatePolicy(r *http.Request) (string, error) {
+	v := strings.ToLower(r.URL.Query().Get("duplicate_policy"))
+	switch v {
+	case "", "reject", "flag", "allow":
+		return v, nil
+	default:
+		return "", fmt.Errorf("invalid duplicate_policy %q", v)
+	}
+}
+
+// parseStatuses reads the optional, repeatable ?status= query parameter:
+// pending, cleared, reconciled or voided.
+func parseStatuses(r *http.Request) ([]string, error) {
+	values := r.URL.Query()["status"]
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		v = strings.ToLower(v)
+		switch v {
+		case "pending", "cleared", "reconciled", "voided":
+			out = append(out, v)
+		default:
+			return nil, fmt.Errorf("invalid status %q", v)
+		}
+	}
+	return out, nil
+}
+
+// formatSplits renders split lines for the CSV export as
+// "category:amount" pairs joined with ";".
+func formatSplits(splits []*ledgerv1.Split) string {
+	parts := make([]string, 0, len(splits))
+	for _, s := range splits {
+		parts = append(parts, fmt.Sprintf("%s:%.2f", s.Category, s.Amount))
+	}
+	return strings.Join(parts, ";")
+}
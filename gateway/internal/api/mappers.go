@@ -16,17 +16,72 @@ func toProtoCreateTransaction(req CreateTransactionRequest) *ledgerv1.CreateTran
 		Category:    req.Category,
 		Description: req.Description,
 		Date:        ts,
+		Tags:        req.Tags,
+		Splits:      toProtoSplits(req.Splits),
+		PayeeId:     req.PayeeID,
+		Payee:       req.Payee,
+		Account:     req.Account,
+		Status:      req.Status,
 	}
 }
 
+func toProtoSplits(splits []SplitDTO) []*ledgerv1.Split {
+	if len(splits) == 0 {
+		return nil
+	}
+	out := make([]*ledgerv1.Split, 0, len(splits))
+	for _, s := range splits {
+		out = append(out, &ledgerv1.Split{Category: s.Category, Amount: s.Amount})
+	}
+	return out
+}
+
 func toTransactionDTOFromProto(tx *ledgerv1.Transaction) TransactionResponse {
-	return TransactionResponse{
+	out := TransactionResponse{
 		ID:          tx.Id,
 		Amount:      tx.Amount,
 		Category:    tx.Category,
 		Description: tx.Description,
 		Date:        tx.Date.AsTime(),
+		Tags:        tx.Tags,
+		RecurringID: tx.RecurringId,
+		PayeeID:     tx.PayeeId,
+		Payee:       tx.Payee,
+		DuplicateOf: tx.DuplicateOf,
+		Account:     tx.Account,
+		Cleared:     tx.Cleared,
+		Status:      tx.Status,
+		Refunded:    tx.Refunded,
+
+		ReconciliationID: tx.ReconciliationId,
+	}
+	if tx.StatusChangedAt != nil {
+		changed := tx.StatusChangedAt.AsTime()
+		out.StatusChangedAt = &changed
+	}
+	for _, s := range tx.Splits {
+		out.Splits = append(out.Splits, SplitDTO{Category: s.Category, Amount: s.Amount})
+	}
+	if out.Tags == nil {
+		out.Tags = []string{}
+	}
+
+	if tx.Suggestion != nil {
+		out.Suggestion = &CategorySuggestionResponse{
+			Category:   tx.Suggestion.Category,
+			Confidence: tx.Suggestion.Confidence,
+		}
+	}
+
+	if tx.Anomaly != nil {
+		out.Anomaly = &AnomalyResponse{
+			Score:   tx.Anomaly.Score,
+			Median:  tx.Anomaly.Median,
+			Samples: int(tx.Anomaly.Samples),
+		}
 	}
+
+	return out
 }
 
 func toProtoCreateBudget(req CreateBudgetRequest) *ledgerv1.CreateBudgetRequest {
@@ -71,8 +126,258 @@ func toBulkResponseDTO(res *ledgerv1.BulkCreateTransactionsResponse) BulkCreateT
 	}
 
 	return BulkCreateTransactionsResponse{
-		Accepted: int(res.Accepted),
-		Rejected: int(res.Rejected),
-		Errors:   errors,
+		Accepted:      int(res.Accepted),
+		Rejected:      int(res.Rejected),
+		Errors:        errors,
+		CategorySpend: res.CategorySpend,
+	}
+}
+
+func toSpendingSeriesDTO(res *ledgerv1.SpendingSeriesResponse) SpendingSeriesResponse {
+	series := make([]CategorySeriesResponse, 0, len(res.Series))
+	for _, s := range res.Series {
+		series = append(series, CategorySeriesResponse{
+			Category: s.Category,
+			Amounts:  s.Amounts,
+		})
+	}
+
+	buckets := res.Buckets
+	if buckets == nil {
+		buckets = []string{}
+	}
+
+	return SpendingSeriesResponse{
+		Granularity: res.Granularity,
+		Buckets:     buckets,
+		Series:      series,
+	}
+}
+
+func toBudgetReportDTO(res *ledgerv1.BudgetReportResponse) BudgetReportResponse {
+	lines := make([]BudgetReportLineResponse, 0, len(res.Budgets))
+	for _, l := range res.Budgets {
+		lines = append(lines, BudgetReportLineResponse{
+			Category:    l.Category,
+			Limit:       l.Limit,
+			Spent:       l.Spent,
+			Remaining:   l.Remaining,
+			PercentUsed: l.PercentUsed,
+			Status:      l.Status,
+		})
+	}
+
+	unbudgeted := make([]CategorySpendResponse, 0, len(res.Unbudgeted))
+	for _, c := range res.Unbudgeted {
+		unbudgeted = append(unbudgeted, CategorySpendResponse{
+			Category: c.Category,
+			Spent:    c.Spent,
+		})
+	}
+
+	return BudgetReportResponse{
+		Budgets:    lines,
+		Unbudgeted: unbudgeted,
+	}
+}
+
+func toForecastDTO(res *ledgerv1.ForecastResponse) ForecastResponse {
+	lines := make([]ForecastLineResponse, 0, len(res.Lines))
+	for _, l := range res.Lines {
+		lines = append(lines, ForecastLineResponse{
+			Category:          l.Category,
+			Spent:             l.Spent,
+			PaceProjection:    l.PaceProjection,
+			HistoryProjection: l.HistoryProjection,
+			RecurringPending:  l.RecurringPending,
+			Projected:         l.Projected,
+			Limit:             l.Limit,
+			OverBudget:        l.OverBudget,
+		})
+	}
+
+	return ForecastResponse{
+		Period:      res.Period,
+		PeriodStart: res.PeriodStart,
+		PeriodEnd:   res.PeriodEnd,
+		AsOf:        res.AsOf,
+		Lines:       lines,
+	}
+}
+
+func toCategoryDTO(c *ledgerv1.Category) CategoryResponse {
+	return CategoryResponse{
+		ID:      c.Id,
+		Name:    c.Name,
+		Parent:  c.Parent,
+		Aliases: c.Aliases,
+	}
+}
+
+func toRecurringDTO(r *ledgerv1.RecurringTransaction) RecurringResponse {
+	return RecurringResponse{
+		ID:          r.Id,
+		Amount:      r.Amount,
+		Category:    r.Category,
+		Description: r.Description,
+		Schedule:    r.Schedule,
+		StartDate:   r.StartDate,
+		EndDate:     r.EndDate,
+		NextDate:    r.NextDate,
+		LastError:   r.LastError,
+	}
+}
+
+func toAttachmentDTO(a *ledgerv1.Attachment) AttachmentResponse {
+	return AttachmentResponse{
+		ID:            a.Id,
+		TransactionID: a.TransactionId,
+		Filename:      a.Filename,
+		ContentType:   a.ContentType,
+		Size:          a.Size,
+		CreatedAt:     a.CreatedAt.AsTime(),
+	}
+}
+
+func toPayeeDTO(p *ledgerv1.Payee) PayeeResponse {
+	return PayeeResponse{
+		ID:   p.Id,
+		Name: p.Name,
+	}
+}
+
+func toProtoRule(req RuleRequest) *ledgerv1.Rule {
+	return &ledgerv1.Rule{
+		Id:                  req.ID,
+		Name:                req.Name,
+		Priority:            req.Priority,
+		DescriptionContains: req.DescriptionContains,
+		DescriptionRegex:    req.DescriptionRegex,
+		MinAmount:           req.MinAmount,
+		MaxAmount:           req.MaxAmount,
+		Payee:               req.Payee,
+		SetCategory:         req.SetCategory,
+		SetTags:             req.SetTags,
+		SetDescription:      req.SetDescription,
+	}
+}
+
+func toRuleDTO(r *ledgerv1.Rule) RuleResponse {
+	out := RuleResponse{
+		ID:                  r.Id,
+		Name:                r.Name,
+		Priority:            r.Priority,
+		DescriptionContains: r.DescriptionContains,
+		DescriptionRegex:    r.DescriptionRegex,
+		MinAmount:           r.MinAmount,
+		MaxAmount:           r.MaxAmount,
+		Payee:               r.Payee,
+		SetCategory:         r.SetCategory,
+		SetTags:             r.SetTags,
+		SetDescription:      r.SetDescription,
 	}
-}
\ No newline at end of file
+	if out.SetTags == nil {
+		out.SetTags = []string{}
+	}
+	return out
+}
+
+func toReconciliationDTO(r *ledgerv1.Reconciliation) ReconciliationResponse {
+	out := ReconciliationResponse{
+		ID:               r.Id,
+		Account:          r.Account,
+		StatementDate:    r.StatementDate,
+		StatementBalance: r.StatementBalance,
+		OpeningBalance:   r.OpeningBalance,
+		ClearedTotal:     r.ClearedTotal,
+		ClearedBalance:   r.ClearedBalance,
+		Difference:       r.Difference,
+	}
+	if r.CompletedAt != nil {
+		completed := r.CompletedAt.AsTime()
+		out.CompletedAt = &completed
+	}
+	for _, tx := range r.Transactions {
+		out.Transactions = append(out.Transactions, toTransactionDTOFromProto(tx))
+	}
+	return out
+}
+
+func toProtoCreateRefund(req CreateRefundRequest) *ledgerv1.CreateRefundRequest {
+	var ts *timestamppb.Timestamp
+	if !req.Date.IsZero() {
+		ts = timestamppb.New(req.Date)
+	}
+
+	return &ledgerv1.CreateRefundRequest{
+		TransactionId: req.TransactionID,
+		Amount:        req.Amount,
+		Category:      req.Category,
+		Description:   req.Description,
+		Date:          ts,
+	}
+}
+
+func toRefundDTO(r *ledgerv1.Refund) RefundResponse {
+	return RefundResponse{
+		ID:            r.Id,
+		TransactionID: r.TransactionId,
+		Category:      r.Category,
+		Amount:        r.Amount,
+		Description:   r.Description,
+		Date:          r.Date.AsTime(),
+		OriginalDate:  r.OriginalDate.AsTime(),
+	}
+}
+
+func toStatusChangeDTO(c *ledgerv1.StatusChange) StatusChangeResponse {
+	return StatusChangeResponse{
+		TransactionID: c.TransactionId,
+		From:          c.FromStatus,
+		To:            c.ToStatus,
+		ChangedAt:     c.ChangedAt.AsTime(),
+	}
+}
+
+func toWebhookDTO(w *ledgerv1.Webhook) WebhookResponse {
+	events := w.Events
+	if events == nil {
+		events = []string{}
+	}
+
+	return WebhookResponse{
+		ID:        w.Id,
+		URL:       w.Url,
+		Secret:    w.Secret,
+		Events:    events,
+		MinAmount: w.MinAmount,
+		Threshold: w.Threshold,
+		CreatedAt: w.CreatedAt.AsTime(),
+	}
+}
+
+func toWebhookDeliveryDTO(d *ledgerv1.WebhookDelivery) WebhookDeliveryResponse {
+	return WebhookDeliveryResponse{
+		ID:         d.Id,
+		WebhookID:  d.WebhookId,
+		EventID:    d.EventId,
+		EventType:  d.EventType,
+		Attempt:    d.Attempt,
+		StatusCode: d.StatusCode,
+		Error:      d.Error,
+		Succeeded:  d.Succeeded,
+		DurationMS: d.DurationMs,
+		CreatedAt:  d.CreatedAt.AsTime(),
+	}
+}
+
+func toRecipientDTO(r *ledgerv1.NotificationRecipient) RecipientResponse {
+	return RecipientResponse{
+		ID:           r.Id,
+		Name:         r.Name,
+		Email:        r.Email,
+		BudgetAlerts: r.BudgetAlerts,
+		WeeklyDigest: r.WeeklyDigest,
+		CreatedAt:    r.CreatedAt.AsTime(),
+	}
+}
@@ -4,10 +4,14 @@ import (
 	ledgerv1 "github.com/lyagu5h/finScope/gateway/internal/delivery/protos/ledger/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
+const maxMessageSize = 11 << 20
+
 type Client struct {
 	client ledgerv1.LedgerServiceClient
+	health healthpb.HealthClient
 }
 
 func New(addr string) (*Client, error) {
@@ -15,6 +19,11 @@ func New(addr string) (*Client, error) {
 	c, err := grpc.NewClient(
 		addr,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		// Attachments travel in a single message of up to 10 MiB.
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(maxMessageSize),
+			grpc.MaxCallSendMsgSize(maxMessageSize),
+		),
 	)
 	if err != nil {
 		return nil, err
@@ -22,9 +31,15 @@ func New(addr string) (*Client, error) {
 
 	return &Client{
 		client: ledgerv1.NewLedgerServiceClient(c),
+		health: healthpb.NewHealthClient(c),
 	}, nil
 }
 
 func (c *Client) Ledger() ledgerv1.LedgerServiceClient {
 	return c.client
-}
\ No newline at end of file
+}
+
+// Health is the ledger's grpc.health.v1 service.
+func (c *Client) Health() healthpb.HealthClient {
+	return c.health
+}
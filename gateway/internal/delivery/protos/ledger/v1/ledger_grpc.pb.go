@@ -20,12 +20,59 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LedgerService_AddTransaction_FullMethodName      = "/ledger.v1.LedgerService/AddTransaction"
-	LedgerService_ListTransactions_FullMethodName    = "/ledger.v1.LedgerService/ListTransactions"
-	LedgerService_SetBudget_FullMethodName           = "/ledger.v1.LedgerService/SetBudget"
-	LedgerService_ListBudgets_FullMethodName         = "/ledger.v1.LedgerService/ListBudgets"
-	LedgerService_GetReportSummary_FullMethodName    = "/ledger.v1.LedgerService/GetReportSummary"
-	LedgerService_BulkAddTransactions_FullMethodName = "/ledger.v1.LedgerService/BulkAddTransactions"
+	LedgerService_AddTransaction_FullMethodName              = "/ledger.v1.LedgerService/AddTransaction"
+	LedgerService_ListTransactions_FullMethodName            = "/ledger.v1.LedgerService/ListTransactions"
+	LedgerService_SetBudget_FullMethodName                   = "/ledger.v1.LedgerService/SetBudget"
+	LedgerService_ListBudgets_FullMethodName                 = "/ledger.v1.LedgerService/ListBudgets"
+	LedgerService_GetReportSummary_FullMethodName            = "/ledger.v1.LedgerService/GetReportSummary"
+	LedgerService_BulkAddTransactions_FullMethodName         = "/ledger.v1.LedgerService/BulkAddTransactions"
+	LedgerService_GetSpendingSeries_FullMethodName           = "/ledger.v1.LedgerService/GetSpendingSeries"
+	LedgerService_GetBudgetReport_FullMethodName             = "/ledger.v1.LedgerService/GetBudgetReport"
+	LedgerService_GetForecast_FullMethodName                 = "/ledger.v1.LedgerService/GetForecast"
+	LedgerService_GetAnomalies_FullMethodName                = "/ledger.v1.LedgerService/GetAnomalies"
+	LedgerService_GetTagSummary_FullMethodName               = "/ledger.v1.LedgerService/GetTagSummary"
+	LedgerService_GetPayeeReport_FullMethodName              = "/ledger.v1.LedgerService/GetPayeeReport"
+	LedgerService_StartReconciliation_FullMethodName         = "/ledger.v1.LedgerService/StartReconciliation"
+	LedgerService_ListReconciliations_FullMethodName         = "/ledger.v1.LedgerService/ListReconciliations"
+	LedgerService_GetReconciliation_FullMethodName           = "/ledger.v1.LedgerService/GetReconciliation"
+	LedgerService_SetCleared_FullMethodName                  = "/ledger.v1.LedgerService/SetCleared"
+	LedgerService_SetTransactionStatus_FullMethodName        = "/ledger.v1.LedgerService/SetTransactionStatus"
+	LedgerService_ListStatusChanges_FullMethodName           = "/ledger.v1.LedgerService/ListStatusChanges"
+	LedgerService_CreateRefund_FullMethodName                = "/ledger.v1.LedgerService/CreateRefund"
+	LedgerService_ListRefunds_FullMethodName                 = "/ledger.v1.LedgerService/ListRefunds"
+	LedgerService_CompleteReconciliation_FullMethodName      = "/ledger.v1.LedgerService/CompleteReconciliation"
+	LedgerService_GetDuplicateGroups_FullMethodName          = "/ledger.v1.LedgerService/GetDuplicateGroups"
+	LedgerService_MergeDuplicates_FullMethodName             = "/ledger.v1.LedgerService/MergeDuplicates"
+	LedgerService_DismissDuplicates_FullMethodName           = "/ledger.v1.LedgerService/DismissDuplicates"
+	LedgerService_SuggestCategory_FullMethodName             = "/ledger.v1.LedgerService/SuggestCategory"
+	LedgerService_CreateCategory_FullMethodName              = "/ledger.v1.LedgerService/CreateCategory"
+	LedgerService_ListCategories_FullMethodName              = "/ledger.v1.LedgerService/ListCategories"
+	LedgerService_UpdateCategory_FullMethodName              = "/ledger.v1.LedgerService/UpdateCategory"
+	LedgerService_DeleteCategory_FullMethodName              = "/ledger.v1.LedgerService/DeleteCategory"
+	LedgerService_AddCategoryAlias_FullMethodName            = "/ledger.v1.LedgerService/AddCategoryAlias"
+	LedgerService_RenameCategory_FullMethodName              = "/ledger.v1.LedgerService/RenameCategory"
+	LedgerService_MergeCategories_FullMethodName             = "/ledger.v1.LedgerService/MergeCategories"
+	LedgerService_CreateRecurring_FullMethodName             = "/ledger.v1.LedgerService/CreateRecurring"
+	LedgerService_ListRecurring_FullMethodName               = "/ledger.v1.LedgerService/ListRecurring"
+	LedgerService_UpdateRecurring_FullMethodName             = "/ledger.v1.LedgerService/UpdateRecurring"
+	LedgerService_DeleteRecurring_FullMethodName             = "/ledger.v1.LedgerService/DeleteRecurring"
+	LedgerService_CreateRule_FullMethodName                  = "/ledger.v1.LedgerService/CreateRule"
+	LedgerService_ListRules_FullMethodName                   = "/ledger.v1.LedgerService/ListRules"
+	LedgerService_UpdateRule_FullMethodName                  = "/ledger.v1.LedgerService/UpdateRule"
+	LedgerService_DeleteRule_FullMethodName                  = "/ledger.v1.LedgerService/DeleteRule"
+	LedgerService_ApplyRules_FullMethodName                  = "/ledger.v1.LedgerService/ApplyRules"
+	LedgerService_CreatePayee_FullMethodName                 = "/ledger.v1.LedgerService/CreatePayee"
+	LedgerService_ListPayees_FullMethodName                  = "/ledger.v1.LedgerService/ListPayees"
+	LedgerService_UploadAttachment_FullMethodName            = "/ledger.v1.LedgerService/UploadAttachment"
+	LedgerService_ListAttachments_FullMethodName             = "/ledger.v1.LedgerService/ListAttachments"
+	LedgerService_GetAttachment_FullMethodName               = "/ledger.v1.LedgerService/GetAttachment"
+	LedgerService_CreateWebhook_FullMethodName               = "/ledger.v1.LedgerService/CreateWebhook"
+	LedgerService_ListWebhooks_FullMethodName                = "/ledger.v1.LedgerService/ListWebhooks"
+	LedgerService_DeleteWebhook_FullMethodName               = "/ledger.v1.LedgerService/DeleteWebhook"
+	LedgerService_ListWebhookDeliveries_FullMethodName       = "/ledger.v1.LedgerService/ListWebhookDeliveries"
+	LedgerService_SetNotificationRecipient_FullMethodName    = "/ledger.v1.LedgerService/SetNotificationRecipient"
+	LedgerService_ListNotificationRecipients_FullMethodName  = "/ledger.v1.LedgerService/ListNotificationRecipients"
+	LedgerService_DeleteNotificationRecipient_FullMethodName = "/ledger.v1.LedgerService/DeleteNotificationRecipient"
 )
 
 // LedgerServiceClient is the client API for LedgerService service.
@@ -33,11 +80,82 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type LedgerServiceClient interface {
 	AddTransaction(ctx context.Context, in *CreateTransactionRequest, opts ...grpc.CallOption) (*Transaction, error)
-	ListTransactions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
 	SetBudget(ctx context.Context, in *CreateBudgetRequest, opts ...grpc.CallOption) (*Budget, error)
 	ListBudgets(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListBudgetsResponse, error)
 	GetReportSummary(ctx context.Context, in *ReportSummaryRequest, opts ...grpc.CallOption) (*ReportSummaryResponse, error)
 	BulkAddTransactions(ctx context.Context, in *BulkCreateTransactionsRequest, opts ...grpc.CallOption) (*BulkCreateTransactionsResponse, error)
+	GetSpendingSeries(ctx context.Context, in *SpendingSeriesRequest, opts ...grpc.CallOption) (*SpendingSeriesResponse, error)
+	GetBudgetReport(ctx context.Context, in *BudgetReportRequest, opts ...grpc.CallOption) (*BudgetReportResponse, error)
+	GetForecast(ctx context.Context, in *ForecastRequest, opts ...grpc.CallOption) (*ForecastResponse, error)
+	GetAnomalies(ctx context.Context, in *AnomaliesRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	GetTagSummary(ctx context.Context, in *ReportSummaryRequest, opts ...grpc.CallOption) (*ReportSummaryResponse, error)
+	GetPayeeReport(ctx context.Context, in *PayeeReportRequest, opts ...grpc.CallOption) (*PayeeReportResponse, error)
+	// StartReconciliation opens a reconciliation of an account against a
+	// bank statement.
+	StartReconciliation(ctx context.Context, in *StartReconciliationRequest, opts ...grpc.CallOption) (*Reconciliation, error)
+	ListReconciliations(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListReconciliationsResponse, error)
+	GetReconciliation(ctx context.Context, in *ReconciliationRequest, opts ...grpc.CallOption) (*Reconciliation, error)
+	// SetCleared marks transactions as seen on a statement, or not.
+	SetCleared(ctx context.Context, in *SetClearedRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SetTransactionStatus moves transactions to pending, cleared or
+	// voided. Reconciled and voided transactions cannot be changed.
+	SetTransactionStatus(ctx context.Context, in *SetTransactionStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListStatusChanges returns the status history of a transaction.
+	ListStatusChanges(ctx context.Context, in *StatusChangesRequest, opts ...grpc.CallOption) (*StatusChangesResponse, error)
+	// CreateRefund gives back part or all of a transaction, taking the
+	// spend off the original's category and period.
+	CreateRefund(ctx context.Context, in *CreateRefundRequest, opts ...grpc.CallOption) (*Refund, error)
+	ListRefunds(ctx context.Context, in *ListRefundsRequest, opts ...grpc.CallOption) (*ListRefundsResponse, error)
+	// CompleteReconciliation locks the cleared transactions once they
+	// match the statement balance.
+	CompleteReconciliation(ctx context.Context, in *ReconciliationRequest, opts ...grpc.CallOption) (*Reconciliation, error)
+	// GetDuplicateGroups lists the transactions that look like the same one
+	// recorded several times.
+	GetDuplicateGroups(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DuplicateGroupsResponse, error)
+	// MergeDuplicates deletes duplicate_ids, moving their tags and
+	// attachments to keep_id.
+	MergeDuplicates(ctx context.Context, in *MergeDuplicatesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DismissDuplicates marks transactions as not duplicates of each other.
+	DismissDuplicates(ctx context.Context, in *DismissDuplicatesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SuggestCategory proposes categories learned from past transactions.
+	SuggestCategory(ctx context.Context, in *SuggestCategoryRequest, opts ...grpc.CallOption) (*SuggestCategoryResponse, error)
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*Category, error)
+	ListCategories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*Category, error)
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddCategoryAlias(ctx context.Context, in *AddCategoryAliasRequest, opts ...grpc.CallOption) (*Category, error)
+	// RenameCategory and MergeCategories rewrite the category of existing
+	// transactions and budgets.
+	RenameCategory(ctx context.Context, in *RenameCategoryRequest, opts ...grpc.CallOption) (*Category, error)
+	MergeCategories(ctx context.Context, in *MergeCategoriesRequest, opts ...grpc.CallOption) (*Category, error)
+	CreateRecurring(ctx context.Context, in *CreateRecurringRequest, opts ...grpc.CallOption) (*RecurringTransaction, error)
+	ListRecurring(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListRecurringResponse, error)
+	UpdateRecurring(ctx context.Context, in *UpdateRecurringRequest, opts ...grpc.CallOption) (*RecurringTransaction, error)
+	DeleteRecurring(ctx context.Context, in *DeleteRecurringRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateRule(ctx context.Context, in *Rule, opts ...grpc.CallOption) (*Rule, error)
+	ListRules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListRulesResponse, error)
+	UpdateRule(ctx context.Context, in *Rule, opts ...grpc.CallOption) (*Rule, error)
+	DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ApplyRules re-applies the rules to past transactions.
+	ApplyRules(ctx context.Context, in *ApplyRulesRequest, opts ...grpc.CallOption) (*ApplyRulesResponse, error)
+	CreatePayee(ctx context.Context, in *CreatePayeeRequest, opts ...grpc.CallOption) (*Payee, error)
+	ListPayees(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListPayeesResponse, error)
+	UploadAttachment(ctx context.Context, in *UploadAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error)
+	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	GetAttachment(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (*AttachmentContent, error)
+	// CreateWebhook subscribes an HTTP endpoint to ledger events.
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	ListWebhooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListWebhookDeliveries returns the latest delivery attempts of a
+	// webhook, latest first.
+	ListWebhookDeliveries(ctx context.Context, in *WebhookDeliveriesRequest, opts ...grpc.CallOption) (*WebhookDeliveriesResponse, error)
+	// SetNotificationRecipient creates a recipient, or replaces the name
+	// and opt-ins of the one with the same email.
+	SetNotificationRecipient(ctx context.Context, in *SetNotificationRecipientRequest, opts ...grpc.CallOption) (*NotificationRecipient, error)
+	ListNotificationRecipients(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListNotificationRecipientsResponse, error)
+	DeleteNotificationRecipient(ctx context.Context, in *DeleteNotificationRecipientRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type ledgerServiceClient struct {
@@ -58,7 +176,7 @@ func (c *ledgerServiceClient) AddTransaction(ctx context.Context, in *CreateTran
 	return out, nil
 }
 
-func (c *ledgerServiceClient) ListTransactions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+func (c *ledgerServiceClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListTransactionsResponse)
 	err := c.cc.Invoke(ctx, LedgerService_ListTransactions_FullMethodName, in, out, cOpts...)
@@ -108,169 +226,1697 @@ func (c *ledgerServiceClient) BulkAddTransactions(ctx context.Context, in *BulkC
 	return out, nil
 }
 
+func (c *ledgerServiceClient) GetSpendingSeries(ctx context.Context, in *SpendingSeriesRequest, opts ...grpc.CallOption) (*SpendingSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpendingSeriesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetSpendingSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetBudgetReport(ctx context.Context, in *BudgetReportRequest, opts ...grpc.CallOption) (*BudgetReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BudgetReportResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetBudgetReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetForecast(ctx context.Context, in *ForecastRequest, opts ...grpc.CallOption) (*ForecastResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForecastResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetForecast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetAnomalies(ctx context.Context, in *AnomaliesRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTransactionsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetAnomalies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetTagSummary(ctx context.Context, in *ReportSummaryRequest, opts ...grpc.CallOption) (*ReportSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportSummaryResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetTagSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetPayeeReport(ctx context.Context, in *PayeeReportRequest, opts ...grpc.CallOption) (*PayeeReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PayeeReportResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetPayeeReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) StartReconciliation(ctx context.Context, in *StartReconciliationRequest, opts ...grpc.CallOption) (*Reconciliation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reconciliation)
+	err := c.cc.Invoke(ctx, LedgerService_StartReconciliation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListReconciliations(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListReconciliationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReconciliationsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListReconciliations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetReconciliation(ctx context.Context, in *ReconciliationRequest, opts ...grpc.CallOption) (*Reconciliation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reconciliation)
+	err := c.cc.Invoke(ctx, LedgerService_GetReconciliation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) SetCleared(ctx context.Context, in *SetClearedRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LedgerService_SetCleared_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) SetTransactionStatus(ctx context.Context, in *SetTransactionStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LedgerService_SetTransactionStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListStatusChanges(ctx context.Context, in *StatusChangesRequest, opts ...grpc.CallOption) (*StatusChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusChangesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListStatusChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateRefund(ctx context.Context, in *CreateRefundRequest, opts ...grpc.CallOption) (*Refund, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Refund)
+	err := c.cc.Invoke(ctx, LedgerService_CreateRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListRefunds(ctx context.Context, in *ListRefundsRequest, opts ...grpc.CallOption) (*ListRefundsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRefundsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListRefunds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CompleteReconciliation(ctx context.Context, in *ReconciliationRequest, opts ...grpc.CallOption) (*Reconciliation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reconciliation)
+	err := c.cc.Invoke(ctx, LedgerService_CompleteReconciliation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetDuplicateGroups(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DuplicateGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DuplicateGroupsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetDuplicateGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) MergeDuplicates(ctx context.Context, in *MergeDuplicatesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LedgerService_MergeDuplicates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) DismissDuplicates(ctx context.Context, in *DismissDuplicatesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LedgerService_DismissDuplicates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) SuggestCategory(ctx context.Context, in *SuggestCategoryRequest, opts ...grpc.CallOption) (*SuggestCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestCategoryResponse)
+	err := c.cc.Invoke(ctx, LedgerService_SuggestCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*Category, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Category)
+	err := c.cc.Invoke(ctx, LedgerService_CreateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListCategories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*Category, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Category)
+	err := c.cc.Invoke(ctx, LedgerService_UpdateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LedgerService_DeleteCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) AddCategoryAlias(ctx context.Context, in *AddCategoryAliasRequest, opts ...grpc.CallOption) (*Category, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Category)
+	err := c.cc.Invoke(ctx, LedgerService_AddCategoryAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) RenameCategory(ctx context.Context, in *RenameCategoryRequest, opts ...grpc.CallOption) (*Category, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Category)
+	err := c.cc.Invoke(ctx, LedgerService_RenameCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) MergeCategories(ctx context.Context, in *MergeCategoriesRequest, opts ...grpc.CallOption) (*Category, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Category)
+	err := c.cc.Invoke(ctx, LedgerService_MergeCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateRecurring(ctx context.Context, in *CreateRecurringRequest, opts ...grpc.CallOption) (*RecurringTransaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringTransaction)
+	err := c.cc.Invoke(ctx, LedgerService_CreateRecurring_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListRecurring(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListRecurringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecurringResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListRecurring_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) UpdateRecurring(ctx context.Context, in *UpdateRecurringRequest, opts ...grpc.CallOption) (*RecurringTransaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringTransaction)
+	err := c.cc.Invoke(ctx, LedgerService_UpdateRecurring_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) DeleteRecurring(ctx context.Context, in *DeleteRecurringRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LedgerService_DeleteRecurring_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateRule(ctx context.Context, in *Rule, opts ...grpc.CallOption) (*Rule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Rule)
+	err := c.cc.Invoke(ctx, LedgerService_CreateRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListRules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRulesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) UpdateRule(ctx context.Context, in *Rule, opts ...grpc.CallOption) (*Rule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Rule)
+	err := c.cc.Invoke(ctx, LedgerService_UpdateRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LedgerService_DeleteRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ApplyRules(ctx context.Context, in *ApplyRulesRequest, opts ...grpc.CallOption) (*ApplyRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyRulesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ApplyRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreatePayee(ctx context.Context, in *CreatePayeeRequest, opts ...grpc.CallOption) (*Payee, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payee)
+	err := c.cc.Invoke(ctx, LedgerService_CreatePayee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListPayees(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListPayeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPayeesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListPayees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) UploadAttachment(ctx context.Context, in *UploadAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attachment)
+	err := c.cc.Invoke(ctx, LedgerService_UploadAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetAttachment(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (*AttachmentContent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachmentContent)
+	err := c.cc.Invoke(ctx, LedgerService_GetAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, LedgerService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListWebhooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LedgerService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListWebhookDeliveries(ctx context.Context, in *WebhookDeliveriesRequest, opts ...grpc.CallOption) (*WebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) SetNotificationRecipient(ctx context.Context, in *SetNotificationRecipientRequest, opts ...grpc.CallOption) (*NotificationRecipient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationRecipient)
+	err := c.cc.Invoke(ctx, LedgerService_SetNotificationRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListNotificationRecipients(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListNotificationRecipientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotificationRecipientsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListNotificationRecipients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) DeleteNotificationRecipient(ctx context.Context, in *DeleteNotificationRecipientRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LedgerService_DeleteNotificationRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LedgerServiceServer is the server API for LedgerService service.
 // All implementations must embed UnimplementedLedgerServiceServer
 // for forward compatibility.
 type LedgerServiceServer interface {
 	AddTransaction(context.Context, *CreateTransactionRequest) (*Transaction, error)
-	ListTransactions(context.Context, *emptypb.Empty) (*ListTransactionsResponse, error)
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
 	SetBudget(context.Context, *CreateBudgetRequest) (*Budget, error)
 	ListBudgets(context.Context, *emptypb.Empty) (*ListBudgetsResponse, error)
 	GetReportSummary(context.Context, *ReportSummaryRequest) (*ReportSummaryResponse, error)
 	BulkAddTransactions(context.Context, *BulkCreateTransactionsRequest) (*BulkCreateTransactionsResponse, error)
+	GetSpendingSeries(context.Context, *SpendingSeriesRequest) (*SpendingSeriesResponse, error)
+	GetBudgetReport(context.Context, *BudgetReportRequest) (*BudgetReportResponse, error)
+	GetForecast(context.Context, *ForecastRequest) (*ForecastResponse, error)
+	GetAnomalies(context.Context, *AnomaliesRequest) (*ListTransactionsResponse, error)
+	GetTagSummary(context.Context, *ReportSummaryRequest) (*ReportSummaryResponse, error)
+	GetPayeeReport(context.Context, *PayeeReportRequest) (*PayeeReportResponse, error)
+	// StartReconciliation opens a reconciliation of an account against a
+	// bank statement.
+	StartReconciliation(context.Context, *StartReconciliationRequest) (*Reconciliation, error)
+	ListReconciliations(context.Context, *emptypb.Empty) (*ListReconciliationsResponse, error)
+	GetReconciliation(context.Context, *ReconciliationRequest) (*Reconciliation, error)
+	// SetCleared marks transactions as seen on a statement, or not.
+	SetCleared(context.Context, *SetClearedRequest) (*emptypb.Empty, error)
+	// SetTransactionStatus moves transactions to pending, cleared or
+	// voided. Reconciled and voided transactions cannot be changed.
+	SetTransactionStatus(context.Context, *SetTransactionStatusRequest) (*emptypb.Empty, error)
+	// ListStatusChanges returns the status history of a transaction.
+	ListStatusChanges(context.Context, *StatusChangesRequest) (*StatusChangesResponse, error)
+	// CreateRefund gives back part or all of a transaction, taking the
+	// spend off the original's category and period.
+	CreateRefund(context.Context, *CreateRefundRequest) (*Refund, error)
+	ListRefunds(context.Context, *ListRefundsRequest) (*ListRefundsResponse, error)
+	// CompleteReconciliation locks the cleared transactions once they
+	// match the statement balance.
+	CompleteReconciliation(context.Context, *ReconciliationRequest) (*Reconciliation, error)
+	// GetDuplicateGroups lists the transactions that look like the same one
+	// recorded several times.
+	GetDuplicateGroups(context.Context, *emptypb.Empty) (*DuplicateGroupsResponse, error)
+	// MergeDuplicates deletes duplicate_ids, moving their tags and
+	// attachments to keep_id.
+	MergeDuplicates(context.Context, *MergeDuplicatesRequest) (*emptypb.Empty, error)
+	// DismissDuplicates marks transactions as not duplicates of each other.
+	DismissDuplicates(context.Context, *DismissDuplicatesRequest) (*emptypb.Empty, error)
+	// SuggestCategory proposes categories learned from past transactions.
+	SuggestCategory(context.Context, *SuggestCategoryRequest) (*SuggestCategoryResponse, error)
+	CreateCategory(context.Context, *CreateCategoryRequest) (*Category, error)
+	ListCategories(context.Context, *emptypb.Empty) (*ListCategoriesResponse, error)
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*Category, error)
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error)
+	AddCategoryAlias(context.Context, *AddCategoryAliasRequest) (*Category, error)
+	// RenameCategory and MergeCategories rewrite the category of existing
+	// transactions and budgets.
+	RenameCategory(context.Context, *RenameCategoryRequest) (*Category, error)
+	MergeCategories(context.Context, *MergeCategoriesRequest) (*Category, error)
+	CreateRecurring(context.Context, *CreateRecurringRequest) (*RecurringTransaction, error)
+	ListRecurring(context.Context, *emptypb.Empty) (*ListRecurringResponse, error)
+	UpdateRecurring(context.Context, *UpdateRecurringRequest) (*RecurringTransaction, error)
+	DeleteRecurring(context.Context, *DeleteRecurringRequest) (*emptypb.Empty, error)
+	CreateRule(context.Context, *Rule) (*Rule, error)
+	ListRules(context.Context, *emptypb.Empty) (*ListRulesResponse, error)
+	UpdateRule(context.Context, *Rule) (*Rule, error)
+	DeleteRule(context.Context, *DeleteRuleRequest) (*emptypb.Empty, error)
+	// ApplyRules re-applies the rules to past transactions.
+	ApplyRules(context.Context, *ApplyRulesRequest) (*ApplyRulesResponse, error)
+	CreatePayee(context.Context, *CreatePayeeRequest) (*Payee, error)
+	ListPayees(context.Context, *emptypb.Empty) (*ListPayeesResponse, error)
+	UploadAttachment(context.Context, *UploadAttachmentRequest) (*Attachment, error)
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	GetAttachment(context.Context, *GetAttachmentRequest) (*AttachmentContent, error)
+	// CreateWebhook subscribes an HTTP endpoint to ledger events.
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*Webhook, error)
+	ListWebhooks(context.Context, *emptypb.Empty) (*ListWebhooksResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*emptypb.Empty, error)
+	// ListWebhookDeliveries returns the latest delivery attempts of a
+	// webhook, latest first.
+	ListWebhookDeliveries(context.Context, *WebhookDeliveriesRequest) (*WebhookDeliveriesResponse, error)
+	// SetNotificationRecipient creates a recipient, or replaces the name
+	// and opt-ins of the one with the same email.
+	SetNotificationRecipient(context.Context, *SetNotificationRecipientRequest) (*NotificationRecipient, error)
+	ListNotificationRecipients(context.Context, *emptypb.Empty) (*ListNotificationRecipientsResponse, error)
+	DeleteNotificationRecipient(context.Context, *DeleteNotificationRecipientRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedLedgerServiceServer()
 }
 
-// UnimplementedLedgerServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedLedgerServiceServer struct{}
+// UnimplementedLedgerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLedgerServiceServer struct{}
+
+func (UnimplementedLedgerServiceServer) AddTransaction(context.Context, *CreateTransactionRequest) (*Transaction, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddTransaction not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTransactions not implemented")
+}
+func (UnimplementedLedgerServiceServer) SetBudget(context.Context, *CreateBudgetRequest) (*Budget, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetBudget not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListBudgets(context.Context, *emptypb.Empty) (*ListBudgetsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBudgets not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetReportSummary(context.Context, *ReportSummaryRequest) (*ReportSummaryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReportSummary not implemented")
+}
+func (UnimplementedLedgerServiceServer) BulkAddTransactions(context.Context, *BulkCreateTransactionsRequest) (*BulkCreateTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkAddTransactions not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetSpendingSeries(context.Context, *SpendingSeriesRequest) (*SpendingSeriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSpendingSeries not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetBudgetReport(context.Context, *BudgetReportRequest) (*BudgetReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBudgetReport not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetForecast(context.Context, *ForecastRequest) (*ForecastResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetForecast not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetAnomalies(context.Context, *AnomaliesRequest) (*ListTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAnomalies not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetTagSummary(context.Context, *ReportSummaryRequest) (*ReportSummaryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTagSummary not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetPayeeReport(context.Context, *PayeeReportRequest) (*PayeeReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPayeeReport not implemented")
+}
+func (UnimplementedLedgerServiceServer) StartReconciliation(context.Context, *StartReconciliationRequest) (*Reconciliation, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartReconciliation not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListReconciliations(context.Context, *emptypb.Empty) (*ListReconciliationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReconciliations not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetReconciliation(context.Context, *ReconciliationRequest) (*Reconciliation, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReconciliation not implemented")
+}
+func (UnimplementedLedgerServiceServer) SetCleared(context.Context, *SetClearedRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetCleared not implemented")
+}
+func (UnimplementedLedgerServiceServer) SetTransactionStatus(context.Context, *SetTransactionStatusRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTransactionStatus not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListStatusChanges(context.Context, *StatusChangesRequest) (*StatusChangesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListStatusChanges not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateRefund(context.Context, *CreateRefundRequest) (*Refund, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRefund not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListRefunds(context.Context, *ListRefundsRequest) (*ListRefundsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRefunds not implemented")
+}
+func (UnimplementedLedgerServiceServer) CompleteReconciliation(context.Context, *ReconciliationRequest) (*Reconciliation, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteReconciliation not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetDuplicateGroups(context.Context, *emptypb.Empty) (*DuplicateGroupsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDuplicateGroups not implemented")
+}
+func (UnimplementedLedgerServiceServer) MergeDuplicates(context.Context, *MergeDuplicatesRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeDuplicates not implemented")
+}
+func (UnimplementedLedgerServiceServer) DismissDuplicates(context.Context, *DismissDuplicatesRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DismissDuplicates not implemented")
+}
+func (UnimplementedLedgerServiceServer) SuggestCategory(context.Context, *SuggestCategoryRequest) (*SuggestCategoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuggestCategory not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*Category, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListCategories(context.Context, *emptypb.Empty) (*ListCategoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedLedgerServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*Category, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedLedgerServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedLedgerServiceServer) AddCategoryAlias(context.Context, *AddCategoryAliasRequest) (*Category, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddCategoryAlias not implemented")
+}
+func (UnimplementedLedgerServiceServer) RenameCategory(context.Context, *RenameCategoryRequest) (*Category, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenameCategory not implemented")
+}
+func (UnimplementedLedgerServiceServer) MergeCategories(context.Context, *MergeCategoriesRequest) (*Category, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeCategories not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateRecurring(context.Context, *CreateRecurringRequest) (*RecurringTransaction, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRecurring not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListRecurring(context.Context, *emptypb.Empty) (*ListRecurringResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRecurring not implemented")
+}
+func (UnimplementedLedgerServiceServer) UpdateRecurring(context.Context, *UpdateRecurringRequest) (*RecurringTransaction, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRecurring not implemented")
+}
+func (UnimplementedLedgerServiceServer) DeleteRecurring(context.Context, *DeleteRecurringRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRecurring not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateRule(context.Context, *Rule) (*Rule, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRule not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListRules(context.Context, *emptypb.Empty) (*ListRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRules not implemented")
+}
+func (UnimplementedLedgerServiceServer) UpdateRule(context.Context, *Rule) (*Rule, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRule not implemented")
+}
+func (UnimplementedLedgerServiceServer) DeleteRule(context.Context, *DeleteRuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRule not implemented")
+}
+func (UnimplementedLedgerServiceServer) ApplyRules(context.Context, *ApplyRulesRequest) (*ApplyRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApplyRules not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreatePayee(context.Context, *CreatePayeeRequest) (*Payee, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePayee not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListPayees(context.Context, *emptypb.Empty) (*ListPayeesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPayees not implemented")
+}
+func (UnimplementedLedgerServiceServer) UploadAttachment(context.Context, *UploadAttachmentRequest) (*Attachment, error) {
+	return nil, status.Error(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetAttachment(context.Context, *GetAttachmentRequest) (*AttachmentContent, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAttachment not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*Webhook, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListWebhooks(context.Context, *emptypb.Empty) (*ListWebhooksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedLedgerServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListWebhookDeliveries(context.Context, *WebhookDeliveriesRequest) (*WebhookDeliveriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedLedgerServiceServer) SetNotificationRecipient(context.Context, *SetNotificationRecipientRequest) (*NotificationRecipient, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetNotificationRecipient not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListNotificationRecipients(context.Context, *emptypb.Empty) (*ListNotificationRecipientsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNotificationRecipients not implemented")
+}
+func (UnimplementedLedgerServiceServer) DeleteNotificationRecipient(context.Context, *DeleteNotificationRecipientRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteNotificationRecipient not implemented")
+}
+func (UnimplementedLedgerServiceServer) mustEmbedUnimplementedLedgerServiceServer() {}
+func (UnimplementedLedgerServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeLedgerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LedgerServiceServer will
+// result in compilation errors.
+type UnsafeLedgerServiceServer interface {
+	mustEmbedUnimplementedLedgerServiceServer()
+}
+
+func RegisterLedgerServiceServer(s grpc.ServiceRegistrar, srv LedgerServiceServer) {
+	// If the following call panics, it indicates UnimplementedLedgerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LedgerService_ServiceDesc, srv)
+}
+
+func _LedgerService_AddTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).AddTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_AddTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).AddTransaction(ctx, req.(*CreateTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_SetBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).SetBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_SetBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).SetBudget(ctx, req.(*CreateBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListBudgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListBudgets(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetReportSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetReportSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetReportSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetReportSummary(ctx, req.(*ReportSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_BulkAddTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkCreateTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).BulkAddTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_BulkAddTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).BulkAddTransactions(ctx, req.(*BulkCreateTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetSpendingSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpendingSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetSpendingSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetSpendingSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetSpendingSeries(ctx, req.(*SpendingSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetBudgetReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BudgetReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetBudgetReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetBudgetReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetBudgetReport(ctx, req.(*BudgetReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetForecast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetForecast(ctx, req.(*ForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetAnomalies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnomaliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetAnomalies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetAnomalies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetAnomalies(ctx, req.(*AnomaliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetTagSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetTagSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetTagSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetTagSummary(ctx, req.(*ReportSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetPayeeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayeeReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetPayeeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetPayeeReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetPayeeReport(ctx, req.(*PayeeReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_StartReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartReconciliationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).StartReconciliation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_StartReconciliation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).StartReconciliation(ctx, req.(*StartReconciliationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListReconciliations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListReconciliations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListReconciliations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListReconciliations(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconciliationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetReconciliation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetReconciliation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetReconciliation(ctx, req.(*ReconciliationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_SetCleared_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetClearedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).SetCleared(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_SetCleared_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).SetCleared(ctx, req.(*SetClearedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_SetTransactionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTransactionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).SetTransactionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_SetTransactionStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).SetTransactionStatus(ctx, req.(*SetTransactionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListStatusChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListStatusChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListStatusChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListStatusChanges(ctx, req.(*StatusChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CreateRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateRefund(ctx, req.(*CreateRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListRefunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRefundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListRefunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListRefunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListRefunds(ctx, req.(*ListRefundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CompleteReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconciliationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CompleteReconciliation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CompleteReconciliation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CompleteReconciliation(ctx, req.(*ReconciliationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetDuplicateGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetDuplicateGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetDuplicateGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetDuplicateGroups(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_MergeDuplicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeDuplicatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).MergeDuplicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_MergeDuplicates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).MergeDuplicates(ctx, req.(*MergeDuplicatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_DismissDuplicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissDuplicatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).DismissDuplicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_DismissDuplicates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).DismissDuplicates(ctx, req.(*DismissDuplicatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_SuggestCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).SuggestCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_SuggestCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).SuggestCategory(ctx, req.(*SuggestCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListCategories(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_AddCategoryAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCategoryAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).AddCategoryAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_AddCategoryAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).AddCategoryAlias(ctx, req.(*AddCategoryAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_RenameCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).RenameCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_RenameCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).RenameCategory(ctx, req.(*RenameCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_MergeCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).MergeCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_MergeCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).MergeCategories(ctx, req.(*MergeCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CreateRecurring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecurringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateRecurring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateRecurring_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateRecurring(ctx, req.(*CreateRecurringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListRecurring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListRecurring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListRecurring_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListRecurring(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_UpdateRecurring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecurringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).UpdateRecurring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_UpdateRecurring_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).UpdateRecurring(ctx, req.(*UpdateRecurringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_DeleteRecurring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecurringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).DeleteRecurring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_DeleteRecurring_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).DeleteRecurring(ctx, req.(*DeleteRecurringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CreateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Rule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateRule(ctx, req.(*Rule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListRules(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedLedgerServiceServer) AddTransaction(context.Context, *CreateTransactionRequest) (*Transaction, error) {
-	return nil, status.Error(codes.Unimplemented, "method AddTransaction not implemented")
+func _LedgerService_UpdateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Rule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).UpdateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_UpdateRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).UpdateRule(ctx, req.(*Rule))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLedgerServiceServer) ListTransactions(context.Context, *emptypb.Empty) (*ListTransactionsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListTransactions not implemented")
+
+func _LedgerService_DeleteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).DeleteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_DeleteRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).DeleteRule(ctx, req.(*DeleteRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLedgerServiceServer) SetBudget(context.Context, *CreateBudgetRequest) (*Budget, error) {
-	return nil, status.Error(codes.Unimplemented, "method SetBudget not implemented")
+
+func _LedgerService_ApplyRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ApplyRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ApplyRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ApplyRules(ctx, req.(*ApplyRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLedgerServiceServer) ListBudgets(context.Context, *emptypb.Empty) (*ListBudgetsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListBudgets not implemented")
+
+func _LedgerService_CreatePayee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePayeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreatePayee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreatePayee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreatePayee(ctx, req.(*CreatePayeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLedgerServiceServer) GetReportSummary(context.Context, *ReportSummaryRequest) (*ReportSummaryResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetReportSummary not implemented")
+
+func _LedgerService_ListPayees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListPayees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListPayees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListPayees(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLedgerServiceServer) BulkAddTransactions(context.Context, *BulkCreateTransactionsRequest) (*BulkCreateTransactionsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method BulkAddTransactions not implemented")
+
+func _LedgerService_UploadAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).UploadAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_UploadAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).UploadAttachment(ctx, req.(*UploadAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLedgerServiceServer) mustEmbedUnimplementedLedgerServiceServer() {}
-func (UnimplementedLedgerServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeLedgerServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to LedgerServiceServer will
-// result in compilation errors.
-type UnsafeLedgerServiceServer interface {
-	mustEmbedUnimplementedLedgerServiceServer()
+func _LedgerService_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListAttachments(ctx, req.(*ListAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterLedgerServiceServer(s grpc.ServiceRegistrar, srv LedgerServiceServer) {
-	// If the following call panics, it indicates UnimplementedLedgerServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _LedgerService_GetAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&LedgerService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetAttachment(ctx, req.(*GetAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _LedgerService_AddTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTransactionRequest)
+func _LedgerService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).AddTransaction(ctx, in)
+		return srv.(LedgerServiceServer).CreateWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_AddTransaction_FullMethodName,
+		FullMethod: LedgerService_CreateWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).AddTransaction(ctx, req.(*CreateTransactionRequest))
+		return srv.(LedgerServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LedgerService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _LedgerService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).ListTransactions(ctx, in)
+		return srv.(LedgerServiceServer).ListWebhooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_ListTransactions_FullMethodName,
+		FullMethod: LedgerService_ListWebhooks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).ListTransactions(ctx, req.(*emptypb.Empty))
+		return srv.(LedgerServiceServer).ListWebhooks(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LedgerService_SetBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBudgetRequest)
+func _LedgerService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).SetBudget(ctx, in)
+		return srv.(LedgerServiceServer).DeleteWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_SetBudget_FullMethodName,
+		FullMethod: LedgerService_DeleteWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).SetBudget(ctx, req.(*CreateBudgetRequest))
+		return srv.(LedgerServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LedgerService_ListBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _LedgerService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookDeliveriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).ListBudgets(ctx, in)
+		return srv.(LedgerServiceServer).ListWebhookDeliveries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_ListBudgets_FullMethodName,
+		FullMethod: LedgerService_ListWebhookDeliveries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).ListBudgets(ctx, req.(*emptypb.Empty))
+		return srv.(LedgerServiceServer).ListWebhookDeliveries(ctx, req.(*WebhookDeliveriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LedgerService_GetReportSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReportSummaryRequest)
+func _LedgerService_SetNotificationRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNotificationRecipientRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).GetReportSummary(ctx, in)
+		return srv.(LedgerServiceServer).SetNotificationRecipient(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_GetReportSummary_FullMethodName,
+		FullMethod: LedgerService_SetNotificationRecipient_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).GetReportSummary(ctx, req.(*ReportSummaryRequest))
+		return srv.(LedgerServiceServer).SetNotificationRecipient(ctx, req.(*SetNotificationRecipientRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LedgerService_BulkAddTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BulkCreateTransactionsRequest)
+func _LedgerService_ListNotificationRecipients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).BulkAddTransactions(ctx, in)
+		return srv.(LedgerServiceServer).ListNotificationRecipients(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_BulkAddTransactions_FullMethodName,
+		FullMethod: LedgerService_ListNotificationRecipients_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).BulkAddTransactions(ctx, req.(*BulkCreateTransactionsRequest))
+		return srv.(LedgerServiceServer).ListNotificationRecipients(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_DeleteNotificationRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNotificationRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).DeleteNotificationRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_DeleteNotificationRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).DeleteNotificationRecipient(ctx, req.(*DeleteNotificationRecipientRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -306,6 +1952,194 @@ var LedgerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BulkAddTransactions",
 			Handler:    _LedgerService_BulkAddTransactions_Handler,
 		},
+		{
+			MethodName: "GetSpendingSeries",
+			Handler:    _LedgerService_GetSpendingSeries_Handler,
+		},
+		{
+			MethodName: "GetBudgetReport",
+			Handler:    _LedgerService_GetBudgetReport_Handler,
+		},
+		{
+			MethodName: "GetForecast",
+			Handler:    _LedgerService_GetForecast_Handler,
+		},
+		{
+			MethodName: "GetAnomalies",
+			Handler:    _LedgerService_GetAnomalies_Handler,
+		},
+		{
+			MethodName: "GetTagSummary",
+			Handler:    _LedgerService_GetTagSummary_Handler,
+		},
+		{
+			MethodName: "GetPayeeReport",
+			Handler:    _LedgerService_GetPayeeReport_Handler,
+		},
+		{
+			MethodName: "StartReconciliation",
+			Handler:    _LedgerService_StartReconciliation_Handler,
+		},
+		{
+			MethodName: "ListReconciliations",
+			Handler:    _LedgerService_ListReconciliations_Handler,
+		},
+		{
+			MethodName: "GetReconciliation",
+			Handler:    _LedgerService_GetReconciliation_Handler,
+		},
+		{
+			MethodName: "SetCleared",
+			Handler:    _LedgerService_SetCleared_Handler,
+		},
+		{
+			MethodName: "SetTransactionStatus",
+			Handler:    _LedgerService_SetTransactionStatus_Handler,
+		},
+		{
+			MethodName: "ListStatusChanges",
+			Handler:    _LedgerService_ListStatusChanges_Handler,
+		},
+		{
+			MethodName: "CreateRefund",
+			Handler:    _LedgerService_CreateRefund_Handler,
+		},
+		{
+			MethodName: "ListRefunds",
+			Handler:    _LedgerService_ListRefunds_Handler,
+		},
+		{
+			MethodName: "CompleteReconciliation",
+			Handler:    _LedgerService_CompleteReconciliation_Handler,
+		},
+		{
+			MethodName: "GetDuplicateGroups",
+			Handler:    _LedgerService_GetDuplicateGroups_Handler,
+		},
+		{
+			MethodName: "MergeDuplicates",
+			Handler:    _LedgerService_MergeDuplicates_Handler,
+		},
+		{
+			MethodName: "DismissDuplicates",
+			Handler:    _LedgerService_DismissDuplicates_Handler,
+		},
+		{
+			MethodName: "SuggestCategory",
+			Handler:    _LedgerService_SuggestCategory_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _LedgerService_CreateCategory_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _LedgerService_ListCategories_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _LedgerService_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _LedgerService_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "AddCategoryAlias",
+			Handler:    _LedgerService_AddCategoryAlias_Handler,
+		},
+		{
+			MethodName: "RenameCategory",
+			Handler:    _LedgerService_RenameCategory_Handler,
+		},
+		{
+			MethodName: "MergeCategories",
+			Handler:    _LedgerService_MergeCategories_Handler,
+		},
+		{
+			MethodName: "CreateRecurring",
+			Handler:    _LedgerService_CreateRecurring_Handler,
+		},
+		{
+			MethodName: "ListRecurring",
+			Handler:    _LedgerService_ListRecurring_Handler,
+		},
+		{
+			MethodName: "UpdateRecurring",
+			Handler:    _LedgerService_UpdateRecurring_Handler,
+		},
+		{
+			MethodName: "DeleteRecurring",
+			Handler:    _LedgerService_DeleteRecurring_Handler,
+		},
+		{
+			MethodName: "CreateRule",
+			Handler:    _LedgerService_CreateRule_Handler,
+		},
+		{
+			MethodName: "ListRules",
+			Handler:    _LedgerService_ListRules_Handler,
+		},
+		{
+			MethodName: "UpdateRule",
+			Handler:    _LedgerService_UpdateRule_Handler,
+		},
+		{
+			MethodName: "DeleteRule",
+			Handler:    _LedgerService_DeleteRule_Handler,
+		},
+		{
+			MethodName: "ApplyRules",
+			Handler:    _LedgerService_ApplyRules_Handler,
+		},
+		{
+			MethodName: "CreatePayee",
+			Handler:    _LedgerService_CreatePayee_Handler,
+		},
+		{
+			MethodName: "ListPayees",
+			Handler:    _LedgerService_ListPayees_Handler,
+		},
+		{
+			MethodName: "UploadAttachment",
+			Handler:    _LedgerService_UploadAttachment_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _LedgerService_ListAttachments_Handler,
+		},
+		{
+			MethodName: "GetAttachment",
+			Handler:    _LedgerService_GetAttachment_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _LedgerService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _LedgerService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _LedgerService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _LedgerService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "SetNotificationRecipient",
+			Handler:    _LedgerService_SetNotificationRecipient_Handler,
+		},
+		{
+			MethodName: "ListNotificationRecipients",
+			Handler:    _LedgerService_ListNotificationRecipients_Handler,
+		},
+		{
+			MethodName: "DeleteNotificationRecipient",
+			Handler:    _LedgerService_DeleteNotificationRecipient_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/delivery/protos/ledger/v1/ledger.proto",
@@ -0,0 +1,69 @@
+// Command aggregates rebuilds or checks the daily per-category totals the
+// ledger serves reports from.
+//
+//	aggregates -check     report days whose totals differ from expenses
+//	aggregates -rebuild   recompute every total from expenses
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"log/slog"
+	"os"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/lyagu5h/finScope/ledger/internal/db"
+	"github.com/lyagu5h/finScope/ledger/internal/repository/pg"
+)
+
+func main() {
+	rebuild := flag.Bool("rebuild", false, "recompute daily totals from expenses")
+	check := flag.Bool("check", false, "compare daily totals with expenses")
+	flag.Parse()
+
+	if !*rebuild && !*check {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo}))
+
+	dbConn, err := db.InitDB(ctx, logger)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer dbConn.Close()
+
+	aggregates := pg.New(dbConn).AggregateRepository
+
+	if *rebuild {
+		if err := aggregates.Rebuild(ctx); err != nil {
+			log.Fatal(err)
+		}
+		logger.Info("daily totals rebuilt")
+	}
+
+	if *check {
+		mismatches, err := aggregates.Check(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for _, m := range mismatches {
+			logger.Warn(
+				"daily total mismatch",
+				slog.String("day", m.Day.Format("2006-01-02")),
+				slog.String("category", m.Category),
+				slog.Float64("stored", m.Stored),
+				slog.Float64("actual", m.Actual),
+			)
+		}
+
+		if len(mismatches) > 0 {
+			os.Exit(1)
+		}
+		logger.Info("daily totals consistent")
+	}
+}
@@ -0,0 +1,52 @@
+// Command healthcheck asks a ledger for its grpc.health.v1 status and
+// exits non-zero unless it is serving, for container health checks.
+//
+//	healthcheck [-addr localhost:50051] [-service name] [-timeout 3s]
+//
+// The default address uses LEDGER_GRPC_PORT like the ledger does.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func main() {
+	port := os.Getenv("LEDGER_GRPC_PORT")
+	if port == "" {
+		port = "50051"
+	}
+
+	addr := flag.String("addr", "localhost:"+port, "ledger gRPC address")
+	service := flag.String("service", "", "service to check; empty checks the ledger as a whole")
+	timeout := flag.Duration("timeout", 3*time.Second, "how long to wait for an answer")
+	flag.Parse()
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	res, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: *service})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	fmt.Println(res.Status)
+	if res.Status != healthpb.HealthCheckResponse_SERVING {
+		os.Exit(1)
+	}
+}
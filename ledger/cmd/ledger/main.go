@@ -6,32 +6,56 @@ import (
 	"log/slog"
 	"net"
 	"os"
+	"time"
 
 	"github.com/lyagu5h/finScope/ledger/internal/app"
 	ledgerv1 "github.com/lyagu5h/finScope/ledger/internal/delivery/protos/ledger/v1"
 	"github.com/lyagu5h/finScope/ledger/internal/delivery/server"
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+	"github.com/lyagu5h/finScope/ledger/internal/scheduler"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
 
 func main() {
-	ctx := context.Background()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 	handlerLog := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo})
 	logger := slog.New(handlerLog)
 	
-	svc, closeFn, err := app.NewLedgerService(ctx, logger)
+	healthServer := health.NewServer()
+	svc, closeFn, err := app.NewLedgerService(ctx, logger, healthServer)
 	if err != nil {
 		log.Fatal(err)
 	}
 	defer closeFn()
 
-	grpcServer := grpc.NewServer()
+	interval := time.Minute
+	if v := os.Getenv("LEDGER_SCHEDULER_INTERVAL"); v != "" {
+		if interval, err = time.ParseDuration(v); err != nil || interval <= 0 {
+			log.Fatalf("invalid LEDGER_SCHEDULER_INTERVAL %q", v)
+		}
+	}
+	go scheduler.Run(ctx, svc, interval, logger)
+
+	// Attachments travel in a single message, so leave room for the
+	// largest one.
+	grpcServer := grpc.NewServer(
+		grpc.MaxRecvMsgSize(domain.MaxAttachmentSize + 1<<20),
+		grpc.MaxSendMsgSize(domain.MaxAttachmentSize + 1<<20),
+	)
 	ledgerGrpcServer := server.New(svc)
 
 	ledgerv1.RegisterLedgerServiceServer(
 		grpcServer,
 		ledgerGrpcServer,
 	)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	// Lets grpcurl and the like list and call services without the proto.
+	reflection.Register(grpcServer)
 
 	port := os.Getenv("LEDGER_GRPC_PORT")
 	if port == "" {
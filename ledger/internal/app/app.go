@@ -2,21 +2,35 @@ package app
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/lyagu5h/finScope/ledger/internal/blob"
 	"github.com/lyagu5h/finScope/ledger/internal/cache"
 	"github.com/lyagu5h/finScope/ledger/internal/db"
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+	"github.com/lyagu5h/finScope/ledger/internal/notify"
+	"github.com/lyagu5h/finScope/ledger/internal/outbox"
 	"github.com/lyagu5h/finScope/ledger/internal/service"
+	"github.com/lyagu5h/finScope/ledger/internal/webhook"
 
 	"github.com/lyagu5h/finScope/ledger/internal/repository/cached"
 	"github.com/lyagu5h/finScope/ledger/internal/repository/pg"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/health"
 )
 
 type CloseFn = func() error
+// NewLedgerService wires the ledger and keeps healthServer up to date
+// with the health of its dependencies until ctx is done.
 func NewLedgerService(
-	ctx context.Context, logger *slog.Logger,
+	ctx context.Context, logger *slog.Logger, healthServer *health.Server,
 ) (service.LedgerService, func() error, error) {
 	dbConn, err := db.InitDB(ctx, logger)
 
@@ -29,11 +43,69 @@ func NewLedgerService(
 
 	budgetRepo := repo.BudgetRepository
 	txRepo := repo.TransactionRepository
+	categoryRepo := repo.CategoryRepository
+
+	blobs, err := blob.InitStore(logger)
+	if err != nil {
+		dbConn.Close()
+		return nil, nil, err
+	}
+
+	dupes, err := duplicateDetector()
+	if err != nil {
+		dbConn.Close()
+		return nil, nil, err
+	}
+
+	policy, err := budgetPolicy()
+	if err != nil {
+		dbConn.Close()
+		return nil, nil, err
+	}
+
+	hooks, err := webhookConfig()
+	if err != nil {
+		dbConn.Close()
+		return nil, nil, err
+	}
+	dispatcher := webhook.NewDispatcher(repo.WebhookRepository, hooks, logger)
+	go dispatcher.Run(ctx)
+	// events stand in for the stream consumer groups without Redis.
+	events := domain.EventPublishers{dispatcher}
+
+	smtpCfg, notifyCfg, err := notifyConfig()
+	if err != nil {
+		dbConn.Close()
+		return nil, nil, err
+	}
+	var notifier *notify.Notifier
+	if smtpCfg.Addr == "" {
+		logger.Info("email notifications disabled: LEDGER_SMTP_ADDR is not set")
+	} else {
+		notifier = notify.NewNotifier(repo.NotificationRepository, notify.NewSMTP(smtpCfg), notifyCfg, logger)
+		events = append(events, notifier)
+	}
 
 	closeFn := func() error {
 		return dbConn.Close()
 	}
 
+	interval, err := healthInterval()
+	if err != nil {
+		dbConn.Close()
+		return nil, nil, err
+	}
+
+	relayCfg, stream, err := outboxConfig()
+	if err != nil {
+		dbConn.Close()
+		return nil, nil, err
+	}
+	// Without Redis the outbox goes straight to the publishers.
+	var sink outbox.Sink = outbox.PublisherSink{Publisher: events, Log: logger}
+
+	var reports *cache.ReportCache
+
 	redisClient, err := cache.InitCache(ctx, logger)
 	if err != nil {
 		logger.Warn("redis disabled", slog.String("error", err.Error()))
@@ -43,17 +115,261 @@ func NewLedgerService(
 			budgetRepo,
 			15*time.Second,
 		)
+		categoryRepo = cached.NewCategoryRepository(redisClient, categoryRepo)
+		// Writes evict the reports they affect, so the TTL only bounds
+		// how long unused reports stay around.
+		reports = cache.NewReportCache(redisClient, 24*time.Hour)
+
+		sink = outbox.NewStream(redisClient, stream, 100_000)
+
+		// A delivery can take over a minute, so webhook consumers take
+		// one event at a time and leave it to them for twice that long.
+		hooksCfg := outbox.DefaultConsumerConfig()
+		hooksCfg.Batch = 1
+		hooksCfg.MinIdle = max(hooksCfg.MinIdle, 2*hooks.MaxDispatchTime())
+
+		// The groups are created before the relay runs, so they see
+		// every event it publishes.
+		err = consume(ctx, redisClient, stream, "ledger-webhooks", hooks.Workers, hooksCfg, dispatcher.Dispatch, logger)
+		if err == nil {
+			err = consume(ctx, redisClient, stream, "ledger-cache", 1, outbox.DefaultConsumerConfig(), evictCaches(redisClient, reports), logger)
+		}
+		if err == nil && notifier != nil {
+			// An alert goes to each recipient in turn; leave it time for
+			// a few of them to time out.
+			alertsCfg := outbox.DefaultConsumerConfig()
+			alertsCfg.Batch = 1
+			alertsCfg.MinIdle = max(alertsCfg.MinIdle, 10*notifyCfg.Timeout)
+			err = consume(ctx, redisClient, stream, "ledger-notify", 1, alertsCfg, notifier.Handle, logger)
+		}
+		if err != nil {
+			dbConn.Close()
+			return nil, nil, fmt.Errorf("create stream consumer groups: %w", err)
+		}
 	}
+	go outbox.NewRelay(repo.OutboxRepository, sink, relayCfg, logger).Run(ctx)
 
 	
 	ledgerService := service.New(
 		budgetRepo,
 		txRepo,
+		categoryRepo,
+		repo.RecurringRepository,
+		repo.PayeeRepository,
+		repo.RuleRepository,
+		repo.AttachmentRepository,
+		repo.DuplicateRepository,
+		repo.ReconciliationRepository,
+		repo.RefundRepository,
+		repo.WebhookRepository,
+		repo.NotificationRepository,
+		dupes,
+		policy,
+		repo.OutboxRepository,
+		blobs,
 		logger,
-		redisClient,
+		reports,
 	)
 
+	if notifier != nil {
+		go notifier.RunDigest(ctx, ledgerService)
+	}
+
+	// The first check is done before the ledger serves anything.
+	checker := newHealthChecker(healthServer, dbConn, redisClient, logger)
+	checker.Check(ctx)
+	go checker.Run(ctx, interval)
+
 	return ledgerService, closeFn, nil
 }
 
+// duplicateDetector reads the duplicate policy and date window from
+// LEDGER_DUPLICATE_POLICY and LEDGER_DUPLICATE_WINDOW_DAYS.
+func duplicateDetector() (domain.DuplicateDetector, error) {
+	d := domain.DefaultDuplicateDetector()
 
+	if v := os.Getenv("LEDGER_DUPLICATE_POLICY"); v != "" {
+		policy, err := domain.ParseDuplicatePolicy(v)
+		if err != nil {
+			return d, fmt.Errorf("invalid LEDGER_DUPLICATE_POLICY %q", v)
+		}
+		d.Policy = policy
+	}
+
+	if v := os.Getenv("LEDGER_DUPLICATE_WINDOW_DAYS"); v != "" {
+		days, err := strconv.Atoi(v)
+		if err != nil || days < 0 {
+			return d, fmt.Errorf("invalid LEDGER_DUPLICATE_WINDOW_DAYS %q", v)
+		}
+		d.WindowDays = days
+	}
+
+	return d, nil
+}
+
+// budgetPolicy reads from LEDGER_BUDGET_COUNT_PENDING whether pending
+// transactions count towards budgets.
+func budgetPolicy() (domain.BudgetPolicy, error) {
+	p := domain.DefaultBudgetPolicy()
+
+	if v := os.Getenv("LEDGER_BUDGET_COUNT_PENDING"); v != "" {
+		count, err := strconv.ParseBool(v)
+		if err != nil {
+			return p, fmt.Errorf("invalid LEDGER_BUDGET_COUNT_PENDING %q", v)
+		}
+		p.CountPending = count
+	}
+
+	return p, nil
+}
+
+// webhookConfig reads how webhook deliveries are retried from
+// LEDGER_WEBHOOK_MAX_ATTEMPTS, LEDGER_WEBHOOK_BACKOFF and
+// LEDGER_WEBHOOK_TIMEOUT.
+func webhookConfig() (webhook.Config, error) {
+	cfg := webhook.DefaultConfig()
+
+	if v := os.Getenv("LEDGER_WEBHOOK_MAX_ATTEMPTS"); v != "" {
+		attempts, err := strconv.Atoi(v)
+		if err != nil || attempts <= 0 {
+			return cfg, fmt.Errorf("invalid LEDGER_WEBHOOK_MAX_ATTEMPTS %q", v)
+		}
+		cfg.MaxAttempts = attempts
+	}
+
+	for name, d := range map[string]*time.Duration{
+		"LEDGER_WEBHOOK_BACKOFF": &cfg.Backoff,
+		"LEDGER_WEBHOOK_TIMEOUT": &cfg.Timeout,
+	} {
+		if v := os.Getenv(name); v != "" {
+			parsed, err := time.ParseDuration(v)
+			if err != nil || parsed <= 0 {
+				return cfg, fmt.Errorf("invalid %s %q", name, v)
+			}
+			*d = parsed
+		}
+	}
+
+	return cfg, nil
+}
+
+// outboxConfig reads how often the outbox is relayed from
+// LEDGER_OUTBOX_INTERVAL and the stream it goes to from
+// LEDGER_EVENT_STREAM.
+func outboxConfig() (outbox.Config, string, error) {
+	cfg := outbox.DefaultConfig()
+
+	if v := os.Getenv("LEDGER_OUTBOX_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil || interval <= 0 {
+			return cfg, "", fmt.Errorf("invalid LEDGER_OUTBOX_INTERVAL %q", v)
+		}
+		cfg.Interval = interval
+	}
+
+	stream := os.Getenv("LEDGER_EVENT_STREAM")
+	if stream == "" {
+		stream = outbox.DefaultStream
+	}
+
+	return cfg, stream, nil
+}
+
+// notifyConfig reads the SMTP server from LEDGER_SMTP_ADDR,
+// LEDGER_SMTP_USERNAME, LEDGER_SMTP_PASSWORD and LEDGER_SMTP_FROM, the
+// alert threshold from LEDGER_ALERT_THRESHOLD and when the weekly digest
+// goes out from LEDGER_DIGEST_DAY and LEDGER_DIGEST_HOUR.
+func notifyConfig() (notify.SMTPConfig, notify.Config, error) {
+	smtpCfg := notify.SMTPConfig{
+		Addr:     os.Getenv("LEDGER_SMTP_ADDR"),
+		Username: os.Getenv("LEDGER_SMTP_USERNAME"),
+		Password: os.Getenv("LEDGER_SMTP_PASSWORD"),
+		From:     os.Getenv("LEDGER_SMTP_FROM"),
+	}
+	cfg := notify.DefaultConfig()
+
+	if smtpCfg.Addr != "" && smtpCfg.From == "" {
+		return smtpCfg, cfg, errors.New("LEDGER_SMTP_FROM is required with LEDGER_SMTP_ADDR")
+	}
+
+	if v := os.Getenv("LEDGER_ALERT_THRESHOLD"); v != "" {
+		threshold, err := strconv.ParseFloat(v, 64)
+		if err != nil || threshold <= 0 || threshold > 100 {
+			return smtpCfg, cfg, fmt.Errorf("invalid LEDGER_ALERT_THRESHOLD %q", v)
+		}
+		cfg.Threshold = threshold
+	}
+
+	if v := os.Getenv("LEDGER_DIGEST_DAY"); v != "" {
+		day := -1
+		for d := time.Sunday; d <= time.Saturday; d++ {
+			if strings.EqualFold(d.String(), v) {
+				day = int(d)
+			}
+		}
+		if day < 0 {
+			return smtpCfg, cfg, fmt.Errorf("invalid LEDGER_DIGEST_DAY %q", v)
+		}
+		cfg.DigestDay = time.Weekday(day)
+	}
+
+	if v := os.Getenv("LEDGER_DIGEST_HOUR"); v != "" {
+		hour, err := strconv.Atoi(v)
+		if err != nil || hour < 0 || hour > 23 {
+			return smtpCfg, cfg, fmt.Errorf("invalid LEDGER_DIGEST_HOUR %q", v)
+		}
+		cfg.DigestHour = hour
+	}
+
+	return smtpCfg, cfg, nil
+}
+
+// consume creates group and starts n consumers of it, named after the
+// host so several ledgers can share the group.
+func consume(
+	ctx context.Context,
+	rdb *redis.Client,
+	stream, group string,
+	n int,
+	cfg outbox.ConsumerConfig,
+	handle outbox.Handler,
+	logger *slog.Logger,
+) error {
+	host, _ := os.Hostname()
+	consumers := make([]*outbox.Consumer, n)
+	for i := range consumers {
+		consumers[i] = outbox.NewConsumer(rdb, stream, group, fmt.Sprintf("%s-%d", host, i), cfg, logger)
+	}
+	if err := consumers[0].EnsureGroup(ctx); err != nil {
+		return err
+	}
+
+	for _, c := range consumers {
+		go func() {
+			if err := c.Run(ctx, handle); err != nil {
+				logger.Error("stream consumer failed", slog.String("group", group), slog.String("error", err.Error()))
+			}
+		}()
+	}
+
+	return nil
+}
+
+// evictCaches evicts what an event changed, in case the ledger stopped
+// after writing but before evicting.
+func evictCaches(rdb *redis.Client, reports *cache.ReportCache) outbox.Handler {
+	return func(ctx context.Context, e domain.Event) error {
+		switch data := e.Data.(type) {
+		case domain.TransactionEvent:
+			date, err := time.Parse("2006-01-02", data.Date)
+			if err != nil {
+				return nil
+			}
+			return reports.Invalidate(ctx, date)
+		case domain.BudgetLimitEvent:
+			return cached.EvictBudgets(ctx, rdb)
+		default:
+			return nil
+		}
+	}
+}
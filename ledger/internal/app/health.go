@@ -0,0 +1,123 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	ledgerv1 "github.com/lyagu5h/finScope/ledger/internal/delivery/protos/ledger/v1"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Besides the ledger as a whole, under "" and its service name, the
+// health server reports on each dependency under its own name.
+const (
+	healthPostgres = "postgres"
+	healthRedis    = "redis"
+)
+
+const healthCheckTimeout = 2 * time.Second
+
+// healthInterval reads how often dependencies are checked from
+// LEDGER_HEALTH_INTERVAL.
+func healthInterval() (time.Duration, error) {
+	interval := 10 * time.Second
+	if v := os.Getenv("LEDGER_HEALTH_INTERVAL"); v != "" {
+		parsed, err := time.ParseDuration(v)
+		if err != nil || parsed <= 0 {
+			return interval, fmt.Errorf("invalid LEDGER_HEALTH_INTERVAL %q", v)
+		}
+		interval = parsed
+	}
+	return interval, nil
+}
+
+// healthChecker pings Postgres and, unless the ledger runs without it,
+// Redis, and reports the outcome to a health server. The ledger is
+// serving only while every dependency answers.
+type healthChecker struct {
+	hs   *health.Server
+	db   *sql.DB
+	rdb  *redis.Client
+	log  *slog.Logger
+	last map[string]healthpb.HealthCheckResponse_ServingStatus
+}
+
+func newHealthChecker(hs *health.Server, db *sql.DB, rdb *redis.Client, logger *slog.Logger) *healthChecker {
+	return &healthChecker{
+		hs:   hs,
+		db:   db,
+		rdb:  rdb,
+		log:  logger,
+		last: make(map[string]healthpb.HealthCheckResponse_ServingStatus),
+	}
+}
+
+// Run checks every interval until ctx is done, then reports everything
+// as not serving.
+func (c *healthChecker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.hs.Shutdown()
+			return
+		case <-ticker.C:
+			c.Check(ctx)
+		}
+	}
+}
+
+func (c *healthChecker) Check(ctx context.Context) {
+	checks := map[string]func(context.Context) error{
+		healthPostgres: c.db.PingContext,
+	}
+	if c.rdb != nil {
+		checks[healthRedis] = func(ctx context.Context) error {
+			return c.rdb.Ping(ctx).Err()
+		}
+	}
+
+	overall := healthpb.HealthCheckResponse_SERVING
+	for name, ping := range checks {
+		pingCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+		err := ping(pingCtx)
+		cancel()
+
+		if c.report(name, err) != healthpb.HealthCheckResponse_SERVING {
+			overall = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+	}
+
+	c.hs.SetServingStatus("", overall)
+	c.hs.SetServingStatus(ledgerv1.LedgerService_ServiceDesc.ServiceName, overall)
+}
+
+// report sets the status of a dependency from the error its check
+// returned, and logs when the status changed.
+func (c *healthChecker) report(name string, err error) healthpb.HealthCheckResponse_ServingStatus {
+	status := healthpb.HealthCheckResponse_SERVING
+	if err != nil {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	c.hs.SetServingStatus(name, status)
+
+	if prev, ok := c.last[name]; ok && prev == status {
+		return status
+	}
+	c.last[name] = status
+
+	if err != nil {
+		c.log.Warn("dependency unhealthy", slog.String("dependency", name), slog.String("error", err.Error()))
+	} else {
+		c.log.Info("dependency healthy", slog.String("dependency", name))
+	}
+	return status
+}
@@ -0,0 +1,75 @@
+// Package blob stores file contents, such as receipts, outside Postgres.
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+// Store keeps blobs under slash-separated keys.
+type Store interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// Get returns ErrNotFound when there is no blob under key.
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// InitStore picks the store from LEDGER_BLOB_STORE: "fs", the default,
+// keeps blobs under LEDGER_BLOB_DIR and "s3" in an S3-compatible bucket.
+func InitStore(logger *slog.Logger) (Store, error) {
+	switch kind := getEnv("LEDGER_BLOB_STORE", "fs"); kind {
+	case "fs":
+		dir := getEnv("LEDGER_BLOB_DIR", "data/attachments")
+		logger.Info("blob store ready", slog.String("kind", kind), slog.String("dir", dir))
+		return NewFS(dir), nil
+
+	case "s3":
+		cfg := S3Config{
+			Endpoint:  os.Getenv("LEDGER_S3_ENDPOINT"),
+			Bucket:    os.Getenv("LEDGER_S3_BUCKET"),
+			Region:    getEnv("LEDGER_S3_REGION", "us-east-1"),
+			AccessKey: os.Getenv("LEDGER_S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("LEDGER_S3_SECRET_KEY"),
+		}
+		if cfg.Endpoint == "" || cfg.Bucket == "" {
+			return nil, errors.New("LEDGER_S3_ENDPOINT and LEDGER_S3_BUCKET are required")
+		}
+		logger.Info(
+			"blob store ready",
+			slog.String("kind", kind),
+			slog.String("endpoint", cfg.Endpoint),
+			slog.String("bucket", cfg.Bucket),
+		)
+		return NewS3(cfg, nil), nil
+
+	default:
+		return nil, fmt.Errorf("unknown LEDGER_BLOB_STORE %q", kind)
+	}
+}
+
+// validKey rejects keys that could escape the store's root.
+func validKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") {
+		return fmt.Errorf("invalid blob key %q", key)
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return fmt.Errorf("invalid blob key %q", key)
+		}
+	}
+
+	return nil
+}
+
+func getEnv(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
@@ -0,0 +1,38 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestFS_RoundTrip(t *testing.T) {
+	s := NewFS(t.TempDir())
+	ctx := context.Background()
+
+	if err := s.Put(ctx, "transactions/1/a.png", []byte("png"), "image/png"); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+
+	data, err := s.Get(ctx, "transactions/1/a.png")
+	if err != nil || string(data) != "png" {
+		t.Fatalf("get: %q, %v", data, err)
+	}
+
+	if err := s.Delete(ctx, "transactions/1/a.png"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := s.Get(ctx, "transactions/1/a.png"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got %v", err)
+	}
+}
+
+func TestFS_RejectsEscapingKeys(t *testing.T) {
+	s := NewFS(t.TempDir())
+
+	for _, key := range []string{"", "/etc/passwd", "../x", "a/../../x", "a//b"} {
+		if err := s.Put(context.Background(), key, []byte("x"), ""); err == nil {
+			t.Fatalf("expected key %q to be rejected", key)
+		}
+	}
+}
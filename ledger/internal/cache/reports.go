@@ -0,0 +1,200 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// reportsVersionKey is bumped on every invalidation.
+	reportsVersionKey = "report:version"
+	// reportsToIndexKey scores each cached report key by the last day it
+	// covers, reportsFromIndexKey maps it to the first one.
+	reportsToIndexKey   = "report:index:to"
+	reportsFromIndexKey = "report:index:from"
+)
+
+// storeScript stores a report only if no invalidation happened since the
+// caller's cache miss, so a report computed before a write cannot be
+// stored after the write evicted it.
+var storeScript = redis.NewScript(`
+local version = redis.call('GET', KEYS[1]) or '0'
+if version ~= ARGV[1] then
+	return 0
+end
+redis.call('SET', KEYS[2], ARGV[2], 'PX', ARGV[3])
+redis.call('ZADD', KEYS[3], ARGV[5], KEYS[2])
+redis.call('HSET', KEYS[4], KEYS[2], ARGV[4])
+redis.call('PEXPIRE', KEYS[3], ARGV[3])
+redis.call('PEXPIRE', KEYS[4], ARGV[3])
+return 1
+`)
+
+// ReportCache caches reports in Redis together with the date range each
+// one covers, so writes evict only the reports they change.
+type ReportCache struct {
+	rdb *redis.Client
+	ttl time.Duration
+}
+
+// Token is returned on a cache miss and must be passed back to Store.
+type Token string
+
+func NewReportCache(rdb *redis.Client, ttl time.Duration) *ReportCache {
+	return &ReportCache{rdb: rdb, ttl: ttl}
+}
+
+// Lookup loads the report stored under key into v.
+func (c *ReportCache) Lookup(ctx context.Context, key string, v any) (bool, Token) {
+	version, err := c.rdb.Get(ctx, reportsVersionKey).Result()
+	if err == redis.Nil {
+		version = "0"
+	} else if err != nil {
+		return false, ""
+	}
+
+	data, err := c.rdb.Get(ctx, key).Bytes()
+	if err != nil {
+		return false, Token(version)
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return false, Token(version)
+	}
+
+	return true, Token(version)
+}
+
+// Store caches v under key as the report for the days from..to. It is a
+// no-op when the data may have changed since the Lookup that issued token.
+func (c *ReportCache) Store(ctx context.Context, token Token, key string, from, to time.Time, v any) (bool, error) {
+	if token == "" {
+		return false, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return false, err
+	}
+
+	stored, err := storeScript.Run(
+		ctx,
+		c.rdb,
+		[]string{reportsVersionKey, key, reportsToIndexKey, reportsFromIndexKey},
+		string(token),
+		data,
+		c.ttl.Milliseconds(),
+		dayNumber(from),
+		dayNumber(to),
+	).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return stored == 1, nil
+}
+
+// Invalidate evicts every cached report whose range contains one of dates.
+func (c *ReportCache) Invalidate(ctx context.Context, dates ...time.Time) error {
+	if len(dates) == 0 {
+		return nil
+	}
+
+	if err := c.rdb.Incr(ctx, reportsVersionKey).Err(); err != nil {
+		return err
+	}
+
+	days := make([]int64, 0, len(dates))
+	first := dayNumber(dates[0])
+	for _, d := range dates {
+		n := dayNumber(d)
+		days = append(days, n)
+		first = min(first, n)
+	}
+
+	candidates, err := c.rdb.ZRangeByScore(ctx, reportsToIndexKey, &redis.ZRangeBy{
+		Min: strconv.FormatInt(first, 10),
+		Max: "+inf",
+	}).Result()
+	if err != nil || len(candidates) == 0 {
+		return err
+	}
+
+	froms, err := c.rdb.HMGet(ctx, reportsFromIndexKey, candidates...).Result()
+	if err != nil {
+		return err
+	}
+
+	tos, err := c.rdb.ZMScore(ctx, reportsToIndexKey, candidates...).Result()
+	if err != nil {
+		return err
+	}
+
+	var evict []string
+	for i, key := range candidates {
+		from, ok := froms[i].(string)
+		if !ok {
+			evict = append(evict, key)
+			continue
+		}
+		fromDay, err := strconv.ParseInt(from, 10, 64)
+		if err != nil {
+			evict = append(evict, key)
+			continue
+		}
+
+		for _, d := range days {
+			if fromDay <= d && d <= int64(tos[i]) {
+				evict = append(evict, key)
+				break
+			}
+		}
+	}
+
+	if len(evict) == 0 {
+		return nil
+	}
+
+	members := make([]any, 0, len(evict))
+	for _, key := range evict {
+		members = append(members, key)
+	}
+
+	_, err = c.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, evict...)
+		pipe.ZRem(ctx, reportsToIndexKey, members...)
+		pipe.HDel(ctx, reportsFromIndexKey, evict...)
+		return nil
+	})
+	return err
+}
+
+// InvalidateAll evicts every cached report, for writes that are not tied
+// to particular days, such as renaming a category.
+func (c *ReportCache) InvalidateAll(ctx context.Context) error {
+	if err := c.rdb.Incr(ctx, reportsVersionKey).Err(); err != nil {
+		return err
+	}
+
+	keys, err := c.rdb.ZRange(ctx, reportsToIndexKey, 0, -1).Result()
+	if err != nil || len(keys) == 0 {
+		return err
+	}
+
+	_, err = c.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, keys...)
+		pipe.Del(ctx, reportsToIndexKey, reportsFromIndexKey)
+		return nil
+	})
+	return err
+}
+
+// dayNumber counts days since the Unix epoch, ignoring the time of day.
+func dayNumber(t time.Time) int64 {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400
+}
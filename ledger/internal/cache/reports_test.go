@@ -0,0 +1,88 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestReportCache(t *testing.T) *ReportCache {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	return NewReportCache(rdb, time.Hour)
+}
+
+func day(m time.Month, d int) time.Time {
+	return time.Date(2026, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestReportCache_InvalidateOverlapping(t *testing.T) {
+	ctx := context.Background()
+	c := newTestReportCache(t)
+
+	store := func(key string, from, to time.Time) {
+		var v int
+		_, token := c.Lookup(ctx, key, &v)
+		if ok, err := c.Store(ctx, token, key, from, to, 1); !ok || err != nil {
+			t.Fatalf("store %s: stored=%v err=%v", key, ok, err)
+		}
+	}
+	store("january", day(1, 1), day(1, 31))
+	store("february", day(2, 1), day(2, 28))
+	store("q1", day(1, 1), day(3, 31))
+
+	if err := c.Invalidate(ctx, day(2, 14)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for key, want := range map[string]bool{"january": true, "february": false, "q1": false} {
+		var v int
+		if hit, _ := c.Lookup(ctx, key, &v); hit != want {
+			t.Fatalf("%s: expected hit=%v, got %v", key, want, hit)
+		}
+	}
+}
+
+func TestReportCache_StoreAfterInvalidateIsSkipped(t *testing.T) {
+	ctx := context.Background()
+	c := newTestReportCache(t)
+
+	var v int
+	_, token := c.Lookup(ctx, "march", &v)
+
+	if err := c.Invalidate(ctx, day(3, 3)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	stored, err := c.Store(ctx, token, "march", day(3, 1), day(3, 31), 42)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stored {
+		t.Fatal("report computed before a write must not be cached after it")
+	}
+}
+
+func TestReportCache_InvalidateAll(t *testing.T) {
+	ctx := context.Background()
+	c := newTestReportCache(t)
+
+	var v int
+	_, token := c.Lookup(ctx, "january", &v)
+	if ok, err := c.Store(ctx, token, "january", day(1, 1), day(1, 31), 1); !ok || err != nil {
+		t.Fatalf("store: stored=%v err=%v", ok, err)
+	}
+
+	if err := c.InvalidateAll(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if hit, _ := c.Lookup(ctx, "january", &v); hit {
+		t.Fatal("expected every report to be evicted")
+	}
+}
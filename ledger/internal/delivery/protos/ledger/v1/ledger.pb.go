@@ -24,12 +24,41 @@ const (
 )
 
 type Transaction struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Date          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount      float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Category    string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Date        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	// anomaly is set when the amount is unusual for the category.
+	Anomaly *Anomaly `protobuf:"bytes,6,opt,name=anomaly,proto3" json:"anomaly,omitempty"`
+	Tags    []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	// recurring_id is the recurring transaction this is an occurrence of.
+	RecurringId int64 `protobuf:"varint,8,opt,name=recurring_id,json=recurringId,proto3" json:"recurring_id,omitempty"`
+	// splits spread amount over several categories; category is then the
+	// category of the largest line.
+	Splits []*Split `protobuf:"bytes,9,rep,name=splits,proto3" json:"splits,omitempty"`
+	// payee_id is zero when the transaction has no payee.
+	PayeeId int64  `protobuf:"varint,10,opt,name=payee_id,json=payeeId,proto3" json:"payee_id,omitempty"`
+	Payee   string `protobuf:"bytes,11,opt,name=payee,proto3" json:"payee,omitempty"`
+	// suggestion is set when the category was missing and filled in from
+	// the transaction history.
+	Suggestion *CategorySuggestion `protobuf:"bytes,12,opt,name=suggestion,proto3" json:"suggestion,omitempty"`
+	// duplicate_of is the earlier transaction this one looked like when it
+	// was stored under the flag policy, or zero.
+	DuplicateOf int64 `protobuf:"varint,13,opt,name=duplicate_of,json=duplicateOf,proto3" json:"duplicate_of,omitempty"`
+	// account is the bank account paid from; empty is the default one.
+	Account string `protobuf:"bytes,14,opt,name=account,proto3" json:"account,omitempty"`
+	// cleared is set once the transaction shows on a bank statement, i.e.
+	// when status is cleared or reconciled.
+	Cleared bool `protobuf:"varint,15,opt,name=cleared,proto3" json:"cleared,omitempty"`
+	// reconciliation_id locks the transaction when set.
+	ReconciliationId int64 `protobuf:"varint,16,opt,name=reconciliation_id,json=reconciliationId,proto3" json:"reconciliation_id,omitempty"`
+	// status is pending, cleared, reconciled or voided.
+	Status          string                 `protobuf:"bytes,17,opt,name=status,proto3" json:"status,omitempty"`
+	StatusChangedAt *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=status_changed_at,json=statusChangedAt,proto3" json:"status_changed_at,omitempty"`
+	// refunded is the total refunded so far; amount is what was paid.
+	Refunded      float64 `protobuf:"fixed64,19,opt,name=refunded,proto3" json:"refunded,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -99,28 +128,127 @@ func (x *Transaction) GetDate() *timestamppb.Timestamp {
 	return nil
 }
 
-type Budget struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
-	Limit         float64                `protobuf:"fixed64,2,opt,name=limit,proto3" json:"limit,omitempty"`
+func (x *Transaction) GetAnomaly() *Anomaly {
+	if x != nil {
+		return x.Anomaly
+	}
+	return nil
+}
+
+func (x *Transaction) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Transaction) GetRecurringId() int64 {
+	if x != nil {
+		return x.RecurringId
+	}
+	return 0
+}
+
+func (x *Transaction) GetSplits() []*Split {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+func (x *Transaction) GetPayeeId() int64 {
+	if x != nil {
+		return x.PayeeId
+	}
+	return 0
+}
+
+func (x *Transaction) GetPayee() string {
+	if x != nil {
+		return x.Payee
+	}
+	return ""
+}
+
+func (x *Transaction) GetSuggestion() *CategorySuggestion {
+	if x != nil {
+		return x.Suggestion
+	}
+	return nil
+}
+
+func (x *Transaction) GetDuplicateOf() int64 {
+	if x != nil {
+		return x.DuplicateOf
+	}
+	return 0
+}
+
+func (x *Transaction) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *Transaction) GetCleared() bool {
+	if x != nil {
+		return x.Cleared
+	}
+	return false
+}
+
+func (x *Transaction) GetReconciliationId() int64 {
+	if x != nil {
+		return x.ReconciliationId
+	}
+	return 0
+}
+
+func (x *Transaction) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Transaction) GetStatusChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StatusChangedAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetRefunded() float64 {
+	if x != nil {
+		return x.Refunded
+	}
+	return 0
+}
+
+type CategorySuggestion struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Category string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	// confidence is the probability of the category, from 0 to 1.
+	Confidence    float64 `protobuf:"fixed64,2,opt,name=confidence,proto3" json:"confidence,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Budget) Reset() {
-	*x = Budget{}
+func (x *CategorySuggestion) Reset() {
+	*x = CategorySuggestion{}
 	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Budget) String() string {
+func (x *CategorySuggestion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Budget) ProtoMessage() {}
+func (*CategorySuggestion) ProtoMessage() {}
 
-func (x *Budget) ProtoReflect() protoreflect.Message {
+func (x *CategorySuggestion) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -132,116 +260,4751 @@ func (x *Budget) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
-func (*Budget) Descriptor() ([]byte, []int) {
+// Deprecated: Use CategorySuggestion.ProtoReflect.Descriptor instead.
+func (*CategorySuggestion) Descriptor() ([]byte, []int) {
 	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Budget) GetCategory() string {
+func (x *CategorySuggestion) GetCategory() string {
 	if x != nil {
 		return x.Category
 	}
 	return ""
 }
 
-func (x *Budget) GetLimit() float64 {
+func (x *CategorySuggestion) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+type SuggestCategoryRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Description string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Amount      float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Payee       string                 `protobuf:"bytes,3,opt,name=payee,proto3" json:"payee,omitempty"`
+	// limit is the number of suggestions; 3 by default.
+	Limit         uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestCategoryRequest) Reset() {
+	*x = SuggestCategoryRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestCategoryRequest) ProtoMessage() {}
+
+func (x *SuggestCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestCategoryRequest.ProtoReflect.Descriptor instead.
+func (*SuggestCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SuggestCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SuggestCategoryRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SuggestCategoryRequest) GetPayee() string {
+	if x != nil {
+		return x.Payee
+	}
+	return ""
+}
+
+func (x *SuggestCategoryRequest) GetLimit() uint32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-type CreateTransactionRequest struct {
+type SuggestCategoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Amount        float64                `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Date          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Suggestions   []*CategorySuggestion  `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTransactionRequest) Reset() {
-	*x = CreateTransactionRequest{}
-	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[2]
+func (x *SuggestCategoryResponse) Reset() {
+	*x = SuggestCategoryResponse{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestCategoryResponse) ProtoMessage() {}
+
+func (x *SuggestCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestCategoryResponse.ProtoReflect.Descriptor instead.
+func (*SuggestCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SuggestCategoryResponse) GetSuggestions() []*CategorySuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type Split struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Split) Reset() {
+	*x = Split{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Split) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Split) ProtoMessage() {}
+
+func (x *Split) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Split.ProtoReflect.Descriptor instead.
+func (*Split) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Split) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Split) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type Anomaly struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Score         float64                `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
+	Median        float64                `protobuf:"fixed64,2,opt,name=median,proto3" json:"median,omitempty"`
+	Samples       uint32                 `protobuf:"varint,3,opt,name=samples,proto3" json:"samples,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Anomaly) Reset() {
+	*x = Anomaly{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTransactionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Anomaly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly) ProtoMessage() {}
+
+func (x *Anomaly) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly.ProtoReflect.Descriptor instead.
+func (*Anomaly) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Anomaly) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Anomaly) GetMedian() float64 {
+	if x != nil {
+		return x.Median
+	}
+	return 0
+}
+
+func (x *Anomaly) GetSamples() uint32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+type Budget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Limit         float64                `protobuf:"fixed64,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Budget) Reset() {
+	*x = Budget{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Budget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Budget) ProtoMessage() {}
+
+func (x *Budget) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
+func (*Budget) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Budget) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Budget) GetLimit() float64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type CreateTransactionRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Amount      float64                `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Category    string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Date        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	// dry_run runs validation and budget checks without saving.
+	DryRun bool     `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Tags   []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	// splits must add up to amount. category can be left empty.
+	Splits []*Split `protobuf:"bytes,7,rep,name=splits,proto3" json:"splits,omitempty"`
+	// payee_id picks an existing payee. Otherwise payee is looked up by
+	// name and created if needed. Bulk imports take the payee from the
+	// start of the description when both are empty.
+	PayeeId int64  `protobuf:"varint,8,opt,name=payee_id,json=payeeId,proto3" json:"payee_id,omitempty"`
+	Payee   string `protobuf:"bytes,9,opt,name=payee,proto3" json:"payee,omitempty"`
+	// duplicate_policy is reject, flag or allow; empty uses the ledger's
+	// configured policy.
+	DuplicatePolicy string `protobuf:"bytes,10,opt,name=duplicate_policy,json=duplicatePolicy,proto3" json:"duplicate_policy,omitempty"`
+	Account         string `protobuf:"bytes,11,opt,name=account,proto3" json:"account,omitempty"`
+	// status is pending, the default, or cleared.
+	Status        string `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTransactionRequest) Reset() {
+	*x = CreateTransactionRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransactionRequest) ProtoMessage() {}
+
+func (x *CreateTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransactionRequest.ProtoReflect.Descriptor instead.
+func (*CreateTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateTransactionRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateTransactionRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateTransactionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTransactionRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *CreateTransactionRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *CreateTransactionRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateTransactionRequest) GetSplits() []*Split {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+func (x *CreateTransactionRequest) GetPayeeId() int64 {
+	if x != nil {
+		return x.PayeeId
+	}
+	return 0
+}
+
+func (x *CreateTransactionRequest) GetPayee() string {
+	if x != nil {
+		return x.Payee
+	}
+	return ""
+}
+
+func (x *CreateTransactionRequest) GetDuplicatePolicy() string {
+	if x != nil {
+		return x.DuplicatePolicy
+	}
+	return ""
+}
+
+func (x *CreateTransactionRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *CreateTransactionRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CreateBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Limit         float64                `protobuf:"fixed64,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBudgetRequest) Reset() {
+	*x = CreateBudgetRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBudgetRequest) ProtoMessage() {}
+
+func (x *CreateBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBudgetRequest.ProtoReflect.Descriptor instead.
+func (*CreateBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateBudgetRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateBudgetRequest) GetLimit() float64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListTransactionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tags keeps only transactions carrying every one of them.
+	Tags []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	// statuses keeps only transactions in one of them.
+	Statuses      []string `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsRequest) Reset() {
+	*x = ListTransactionsRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsRequest) ProtoMessage() {}
+
+func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListTransactionsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListTransactionsRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type ListTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsResponse) Reset() {
+	*x = ListTransactionsResponse{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsResponse) ProtoMessage() {}
+
+func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type ListBudgetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budgets       []*Budget              `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBudgetsResponse) Reset() {
+	*x = ListBudgetsResponse{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBudgetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetsResponse) ProtoMessage() {}
+
+func (x *ListBudgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetsResponse.ProtoReflect.Descriptor instead.
+func (*ListBudgetsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListBudgetsResponse) GetBudgets() []*Budget {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+type ReportSummaryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// statuses counts only transactions in one of them; voided ones are
+	// never counted.
+	Statuses      []string `protobuf:"bytes,3,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportSummaryRequest) Reset() {
+	*x = ReportSummaryRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSummaryRequest) ProtoMessage() {}
+
+func (x *ReportSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSummaryRequest.ProtoReflect.Descriptor instead.
+func (*ReportSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReportSummaryRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ReportSummaryRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ReportSummaryRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type ReportSummaryResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Totals map[string]float64     `protobuf:"bytes,1,rep,name=totals,proto3" json:"totals,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	// rollup adds every category's total to all of its parent categories.
+	// Only GetReportSummary sets it.
+	Rollup        map[string]float64 `protobuf:"bytes,2,rep,name=rollup,proto3" json:"rollup,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportSummaryResponse) Reset() {
+	*x = ReportSummaryResponse{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSummaryResponse) ProtoMessage() {}
+
+func (x *ReportSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSummaryResponse.ProtoReflect.Descriptor instead.
+func (*ReportSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReportSummaryResponse) GetTotals() map[string]float64 {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+func (x *ReportSummaryResponse) GetRollup() map[string]float64 {
+	if x != nil {
+		return x.Rollup
+	}
+	return nil
+}
+
+type Category struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// parent is empty for top-level categories.
+	Parent string `protobuf:"bytes,3,opt,name=parent,proto3" json:"parent,omitempty"`
+	// aliases are lower-cased names that resolve to this category.
+	Aliases       []string `protobuf:"bytes,4,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Category) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *Category) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Parent        string                 `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type UpdateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Parent        string                 `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AddCategoryAliasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alias         string                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCategoryAliasRequest) Reset() {
+	*x = AddCategoryAliasRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCategoryAliasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCategoryAliasRequest) ProtoMessage() {}
+
+func (x *AddCategoryAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCategoryAliasRequest.ProtoReflect.Descriptor instead.
+func (*AddCategoryAliasRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AddCategoryAliasRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *AddCategoryAliasRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type RenameCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameCategoryRequest) Reset() {
+	*x = RenameCategoryRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameCategoryRequest) ProtoMessage() {}
+
+func (x *RenameCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameCategoryRequest.ProtoReflect.Descriptor instead.
+func (*RenameCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RenameCategoryRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *RenameCategoryRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type MergeCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sources       []string               `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCategoriesRequest) Reset() {
+	*x = MergeCategoriesRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCategoriesRequest) ProtoMessage() {}
+
+func (x *MergeCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*MergeCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MergeCategoriesRequest) GetSources() []string {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *MergeCategoriesRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+// Dates are YYYY-MM-DD. schedule is an RRULE subset, e.g.
+// "FREQ=MONTHLY;BYMONTHDAY=1", "FREQ=WEEKLY;BYDAY=FR" or
+// "FREQ=DAILY;INTERVAL=14".
+type RecurringTransaction struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount      float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Category    string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Schedule    string                 `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	StartDate   string                 `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// end_date is empty when the schedule does not end.
+	EndDate string `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// next_date is empty once the schedule is over.
+	NextDate      string `protobuf:"bytes,8,opt,name=next_date,json=nextDate,proto3" json:"next_date,omitempty"`
+	LastError     string `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecurringTransaction) Reset() {
+	*x = RecurringTransaction{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecurringTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringTransaction) ProtoMessage() {}
+
+func (x *RecurringTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurringTransaction.ProtoReflect.Descriptor instead.
+func (*RecurringTransaction) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RecurringTransaction) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RecurringTransaction) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RecurringTransaction) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *RecurringTransaction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RecurringTransaction) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *RecurringTransaction) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *RecurringTransaction) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *RecurringTransaction) GetNextDate() string {
+	if x != nil {
+		return x.NextDate
+	}
+	return ""
+}
+
+func (x *RecurringTransaction) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+type CreateRecurringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        float64                `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Schedule      string                 `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	StartDate     string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecurringRequest) Reset() {
+	*x = CreateRecurringRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecurringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecurringRequest) ProtoMessage() {}
+
+func (x *CreateRecurringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecurringRequest.ProtoReflect.Descriptor instead.
+func (*CreateRecurringRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateRecurringRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateRecurringRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateRecurringRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateRecurringRequest) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *CreateRecurringRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CreateRecurringRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type UpdateRecurringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Schedule      string                 `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	StartDate     string                 `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRecurringRequest) Reset() {
+	*x = UpdateRecurringRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecurringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecurringRequest) ProtoMessage() {}
+
+func (x *UpdateRecurringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRecurringRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRecurringRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateRecurringRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateRecurringRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UpdateRecurringRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UpdateRecurringRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateRecurringRequest) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *UpdateRecurringRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *UpdateRecurringRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type DeleteRecurringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecurringRequest) Reset() {
+	*x = DeleteRecurringRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecurringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecurringRequest) ProtoMessage() {}
+
+func (x *DeleteRecurringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecurringRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecurringRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteRecurringRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListRecurringResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Recurring     []*RecurringTransaction `protobuf:"bytes,1,rep,name=recurring,proto3" json:"recurring,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecurringResponse) Reset() {
+	*x = ListRecurringResponse{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecurringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecurringResponse) ProtoMessage() {}
+
+func (x *ListRecurringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecurringResponse.ProtoReflect.Descriptor instead.
+func (*ListRecurringResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListRecurringResponse) GetRecurring() []*RecurringTransaction {
+	if x != nil {
+		return x.Recurring
+	}
+	return nil
+}
+
+// Rule sets the category, tags or description of matching transactions.
+// Every condition that is set must match. Rules run by priority, lowest
+// first; a field set by one rule is kept, tags of all matches are added.
+type Rule struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Priority int32                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	// description_contains matches a substring, ignoring case.
+	DescriptionContains string `protobuf:"bytes,4,opt,name=description_contains,json=descriptionContains,proto3" json:"description_contains,omitempty"`
+	// description_regex uses Go regexp syntax, e.g. "(?i)^uber".
+	DescriptionRegex string `protobuf:"bytes,5,opt,name=description_regex,json=descriptionRegex,proto3" json:"description_regex,omitempty"`
+	// min_amount and max_amount are inclusive; 0 means no bound.
+	MinAmount      float64  `protobuf:"fixed64,6,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MaxAmount      float64  `protobuf:"fixed64,7,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	Payee          string   `protobuf:"bytes,8,opt,name=payee,proto3" json:"payee,omitempty"`
+	SetCategory    string   `protobuf:"bytes,9,opt,name=set_category,json=setCategory,proto3" json:"set_category,omitempty"`
+	SetTags        []string `protobuf:"bytes,10,rep,name=set_tags,json=setTags,proto3" json:"set_tags,omitempty"`
+	SetDescription string   `protobuf:"bytes,11,opt,name=set_description,json=setDescription,proto3" json:"set_description,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Rule) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Rule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Rule) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Rule) GetDescriptionContains() string {
+	if x != nil {
+		return x.DescriptionContains
+	}
+	return ""
+}
+
+func (x *Rule) GetDescriptionRegex() string {
+	if x != nil {
+		return x.DescriptionRegex
+	}
+	return ""
+}
+
+func (x *Rule) GetMinAmount() float64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *Rule) GetMaxAmount() float64 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *Rule) GetPayee() string {
+	if x != nil {
+		return x.Payee
+	}
+	return ""
+}
+
+func (x *Rule) GetSetCategory() string {
+	if x != nil {
+		return x.SetCategory
+	}
+	return ""
+}
+
+func (x *Rule) GetSetTags() []string {
+	if x != nil {
+		return x.SetTags
+	}
+	return nil
+}
+
+func (x *Rule) GetSetDescription() string {
+	if x != nil {
+		return x.SetDescription
+	}
+	return ""
+}
+
+type DeleteRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRuleRequest) Reset() {
+	*x = DeleteRuleRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRuleRequest) ProtoMessage() {}
+
+func (x *DeleteRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRuleRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteRuleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*Rule                `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRulesResponse) Reset() {
+	*x = ListRulesResponse{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRulesResponse) ProtoMessage() {}
+
+func (x *ListRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListRulesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListRulesResponse) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type ApplyRulesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// dry_run returns the changes without saving them.
+	DryRun        bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyRulesRequest) Reset() {
+	*x = ApplyRulesRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyRulesRequest) ProtoMessage() {}
+
+func (x *ApplyRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyRulesRequest.ProtoReflect.Descriptor instead.
+func (*ApplyRulesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ApplyRulesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type RuleChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	RuleIds       []int64                `protobuf:"varint,2,rep,packed,name=rule_ids,json=ruleIds,proto3" json:"rule_ids,omitempty"`
+	Before        *Transaction           `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	After         *Transaction           `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`
+	// category_kept is set when a rule would have changed the category of
+	// a split transaction, which keeps that of its largest line.
+	CategoryKept  bool `protobuf:"varint,5,opt,name=category_kept,json=categoryKept,proto3" json:"category_kept,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleChange) Reset() {
+	*x = RuleChange{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleChange) ProtoMessage() {}
+
+func (x *RuleChange) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleChange.ProtoReflect.Descriptor instead.
+func (*RuleChange) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RuleChange) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *RuleChange) GetRuleIds() []int64 {
+	if x != nil {
+		return x.RuleIds
+	}
+	return nil
+}
+
+func (x *RuleChange) GetBefore() *Transaction {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *RuleChange) GetAfter() *Transaction {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *RuleChange) GetCategoryKept() bool {
+	if x != nil {
+		return x.CategoryKept
+	}
+	return false
+}
+
+type ApplyRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*RuleChange          `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyRulesResponse) Reset() {
+	*x = ApplyRulesResponse{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyRulesResponse) ProtoMessage() {}
+
+func (x *ApplyRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyRulesResponse.ProtoReflect.Descriptor instead.
+func (*ApplyRulesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ApplyRulesResponse) GetChanges() []*RuleChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type Payee struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payee) Reset() {
+	*x = Payee{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payee) ProtoMessage() {}
+
+func (x *Payee) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payee.ProtoReflect.Descriptor instead.
+func (*Payee) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Payee) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Payee) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreatePayeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePayeeRequest) Reset() {
+	*x = CreatePayeeRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePayeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePayeeRequest) ProtoMessage() {}
+
+func (x *CreatePayeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePayeeRequest.ProtoReflect.Descriptor instead.
+func (*CreatePayeeRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreatePayeeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListPayeesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payees        []*Payee               `protobuf:"bytes,1,rep,name=payees,proto3" json:"payees,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPayeesResponse) Reset() {
+	*x = ListPayeesResponse{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPayeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayeesResponse) ProtoMessage() {}
+
+func (x *ListPayeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayeesResponse.ProtoReflect.Descriptor instead.
+func (*ListPayeesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListPayeesResponse) GetPayees() []*Payee {
+	if x != nil {
+		return x.Payees
+	}
+	return nil
+}
+
+type PayeeReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// top limits the report to the payees with the most spend; 0 means all.
+	Top           uint32 `protobuf:"varint,3,opt,name=top,proto3" json:"top,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayeeReportRequest) Reset() {
+	*x = PayeeReportRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayeeReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayeeReportRequest) ProtoMessage() {}
+
+func (x *PayeeReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayeeReportRequest.ProtoReflect.Descriptor instead.
+func (*PayeeReportRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PayeeReportRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PayeeReportRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *PayeeReportRequest) GetTop() uint32 {
+	if x != nil {
+		return x.Top
+	}
+	return 0
+}
+
+type PayeeSpend struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PayeeId       int64                  `protobuf:"varint,1,opt,name=payee_id,json=payeeId,proto3" json:"payee_id,omitempty"`
+	Payee         string                 `protobuf:"bytes,2,opt,name=payee,proto3" json:"payee,omitempty"`
+	Spent         float64                `protobuf:"fixed64,3,opt,name=spent,proto3" json:"spent,omitempty"`
+	Count         uint32                 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayeeSpend) Reset() {
+	*x = PayeeSpend{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayeeSpend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayeeSpend) ProtoMessage() {}
+
+func (x *PayeeSpend) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayeeSpend.ProtoReflect.Descriptor instead.
+func (*PayeeSpend) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PayeeSpend) GetPayeeId() int64 {
+	if x != nil {
+		return x.PayeeId
+	}
+	return 0
+}
+
+func (x *PayeeSpend) GetPayee() string {
+	if x != nil {
+		return x.Payee
+	}
+	return ""
+}
+
+func (x *PayeeSpend) GetSpent() float64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+func (x *PayeeSpend) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PayeeReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payees        []*PayeeSpend          `protobuf:"bytes,1,rep,name=payees,proto3" json:"payees,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayeeReportResponse) Reset() {
+	*x = PayeeReportResponse{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayeeReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayeeReportResponse) ProtoMessage() {}
+
+func (x *PayeeReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayeeReportResponse.ProtoReflect.Descriptor instead.
+func (*PayeeReportResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PayeeReportResponse) GetPayees() []*PayeeSpend {
+	if x != nil {
+		return x.Payees
+	}
+	return nil
+}
+
+type Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TransactionId int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Attachment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Attachment) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *Attachment) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// content_type must be one of application/pdf, image/jpeg, image/png or
+// image/webp, and data at most 10 MiB.
+type UploadAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Data          []byte                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UploadAttachmentRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *UploadAttachmentRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *UploadAttachmentRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UploadAttachmentRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsRequest) Reset() {
+	*x = ListAttachmentsRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListAttachmentsRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+type ListAttachmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachments   []*Attachment          `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsResponse) Reset() {
+	*x = ListAttachmentsResponse{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListAttachmentsResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type GetAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentRequest) Reset() {
+	*x = GetAttachmentRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentRequest) ProtoMessage() {}
+
+func (x *GetAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*GetAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetAttachmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AttachmentContent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentContent) Reset() {
+	*x = AttachmentContent{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentContent) ProtoMessage() {}
+
+func (x *AttachmentContent) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentContent.ProtoReflect.Descriptor instead.
+func (*AttachmentContent) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AttachmentContent) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *AttachmentContent) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type SpendingSeriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// granularity is one of day, week, month, quarter, year; month by default.
+	Granularity   string   `protobuf:"bytes,3,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	Categories    []string `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpendingSeriesRequest) Reset() {
+	*x = SpendingSeriesRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpendingSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendingSeriesRequest) ProtoMessage() {}
+
+func (x *SpendingSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendingSeriesRequest.ProtoReflect.Descriptor instead.
+func (*SpendingSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SpendingSeriesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SpendingSeriesRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *SpendingSeriesRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *SpendingSeriesRequest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type CategorySeries struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Category string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	// amounts holds one value per bucket, in the order of buckets.
+	Amounts       []float64 `protobuf:"fixed64,2,rep,packed,name=amounts,proto3" json:"amounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategorySeries) Reset() {
+	*x = CategorySeries{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategorySeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategorySeries) ProtoMessage() {}
+
+func (x *CategorySeries) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategorySeries.ProtoReflect.Descriptor instead.
+func (*CategorySeries) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CategorySeries) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CategorySeries) GetAmounts() []float64 {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+type SpendingSeriesResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Granularity string                 `protobuf:"bytes,1,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	// buckets are bucket start dates, YYYY-MM-DD.
+	Buckets       []string          `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	Series        []*CategorySeries `protobuf:"bytes,3,rep,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpendingSeriesResponse) Reset() {
+	*x = SpendingSeriesResponse{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpendingSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendingSeriesResponse) ProtoMessage() {}
+
+func (x *SpendingSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendingSeriesResponse.ProtoReflect.Descriptor instead.
+func (*SpendingSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SpendingSeriesResponse) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *SpendingSeriesResponse) GetBuckets() []string {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *SpendingSeriesResponse) GetSeries() []*CategorySeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type BudgetReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetReportRequest) Reset() {
+	*x = BudgetReportRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetReportRequest) ProtoMessage() {}
+
+func (x *BudgetReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetReportRequest.ProtoReflect.Descriptor instead.
+func (*BudgetReportRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *BudgetReportRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *BudgetReportRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type BudgetReportLine struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Category    string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Limit       float64                `protobuf:"fixed64,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Spent       float64                `protobuf:"fixed64,3,opt,name=spent,proto3" json:"spent,omitempty"`
+	Remaining   float64                `protobuf:"fixed64,4,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	PercentUsed float64                `protobuf:"fixed64,5,opt,name=percent_used,json=percentUsed,proto3" json:"percent_used,omitempty"`
+	// status is "under" or "over".
+	Status        string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetReportLine) Reset() {
+	*x = BudgetReportLine{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetReportLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetReportLine) ProtoMessage() {}
+
+func (x *BudgetReportLine) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetReportLine.ProtoReflect.Descriptor instead.
+func (*BudgetReportLine) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BudgetReportLine) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *BudgetReportLine) GetLimit() float64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *BudgetReportLine) GetSpent() float64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+func (x *BudgetReportLine) GetRemaining() float64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *BudgetReportLine) GetPercentUsed() float64 {
+	if x != nil {
+		return x.PercentUsed
+	}
+	return 0
+}
+
+func (x *BudgetReportLine) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CategorySpend struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Spent         float64                `protobuf:"fixed64,2,opt,name=spent,proto3" json:"spent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategorySpend) Reset() {
+	*x = CategorySpend{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategorySpend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategorySpend) ProtoMessage() {}
+
+func (x *CategorySpend) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategorySpend.ProtoReflect.Descriptor instead.
+func (*CategorySpend) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CategorySpend) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CategorySpend) GetSpent() float64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+type BudgetReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budgets       []*BudgetReportLine    `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"`
+	Unbudgeted    []*CategorySpend       `protobuf:"bytes,2,rep,name=unbudgeted,proto3" json:"unbudgeted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetReportResponse) Reset() {
+	*x = BudgetReportResponse{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetReportResponse) ProtoMessage() {}
+
+func (x *BudgetReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetReportResponse.ProtoReflect.Descriptor instead.
+func (*BudgetReportResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *BudgetReportResponse) GetBudgets() []*BudgetReportLine {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+func (x *BudgetReportResponse) GetUnbudgeted() []*CategorySpend {
+	if x != nil {
+		return x.Unbudgeted
+	}
+	return nil
+}
+
+type ForecastRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// as_of is YYYY-MM-DD; today by default.
+	AsOf string `protobuf:"bytes,1,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	// period is one of week, month, quarter, year; month by default.
+	Period        string `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForecastRequest) Reset() {
+	*x = ForecastRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForecastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastRequest) ProtoMessage() {}
+
+func (x *ForecastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastRequest.ProtoReflect.Descriptor instead.
+func (*ForecastRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ForecastRequest) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
+func (x *ForecastRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+type ForecastLine struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Category          string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Spent             float64                `protobuf:"fixed64,2,opt,name=spent,proto3" json:"spent,omitempty"`
+	PaceProjection    float64                `protobuf:"fixed64,3,opt,name=pace_projection,json=paceProjection,proto3" json:"pace_projection,omitempty"`
+	HistoryProjection float64                `protobuf:"fixed64,4,opt,name=history_projection,json=historyProjection,proto3" json:"history_projection,omitempty"`
+	HasHistory        bool                   `protobuf:"varint,5,opt,name=has_history,json=hasHistory,proto3" json:"has_history,omitempty"`
+	RecurringPending  float64                `protobuf:"fixed64,6,opt,name=recurring_pending,json=recurringPending,proto3" json:"recurring_pending,omitempty"`
+	Projected         float64                `protobuf:"fixed64,7,opt,name=projected,proto3" json:"projected,omitempty"`
+	Limit             float64                `protobuf:"fixed64,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	HasBudget         bool                   `protobuf:"varint,9,opt,name=has_budget,json=hasBudget,proto3" json:"has_budget,omitempty"`
+	OverBudget        bool                   `protobuf:"varint,10,opt,name=over_budget,json=overBudget,proto3" json:"over_budget,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ForecastLine) Reset() {
+	*x = ForecastLine{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForecastLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastLine) ProtoMessage() {}
+
+func (x *ForecastLine) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastLine.ProtoReflect.Descriptor instead.
+func (*ForecastLine) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ForecastLine) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ForecastLine) GetSpent() float64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+func (x *ForecastLine) GetPaceProjection() float64 {
+	if x != nil {
+		return x.PaceProjection
+	}
+	return 0
+}
+
+func (x *ForecastLine) GetHistoryProjection() float64 {
+	if x != nil {
+		return x.HistoryProjection
+	}
+	return 0
+}
+
+func (x *ForecastLine) GetHasHistory() bool {
+	if x != nil {
+		return x.HasHistory
+	}
+	return false
+}
+
+func (x *ForecastLine) GetRecurringPending() float64 {
+	if x != nil {
+		return x.RecurringPending
+	}
+	return 0
+}
+
+func (x *ForecastLine) GetProjected() float64 {
+	if x != nil {
+		return x.Projected
+	}
+	return 0
+}
+
+func (x *ForecastLine) GetLimit() float64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ForecastLine) GetHasBudget() bool {
+	if x != nil {
+		return x.HasBudget
+	}
+	return false
+}
+
+func (x *ForecastLine) GetOverBudget() bool {
+	if x != nil {
+		return x.OverBudget
+	}
+	return false
+}
+
+type ForecastResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	PeriodStart   string                 `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd     string                 `protobuf:"bytes,3,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	AsOf          string                 `protobuf:"bytes,4,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	Lines         []*ForecastLine        `protobuf:"bytes,5,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForecastResponse) Reset() {
+	*x = ForecastResponse{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForecastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastResponse) ProtoMessage() {}
+
+func (x *ForecastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastResponse.ProtoReflect.Descriptor instead.
+func (*ForecastResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ForecastResponse) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *ForecastResponse) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *ForecastResponse) GetPeriodEnd() string {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return ""
+}
+
+func (x *ForecastResponse) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
+func (x *ForecastResponse) GetLines() []*ForecastLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type AnomaliesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnomaliesRequest) Reset() {
+	*x = AnomaliesRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnomaliesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomaliesRequest) ProtoMessage() {}
+
+func (x *AnomaliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomaliesRequest.ProtoReflect.Descriptor instead.
+func (*AnomaliesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AnomaliesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *AnomaliesRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type DuplicateGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateGroup) Reset() {
+	*x = DuplicateGroup{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateGroup) ProtoMessage() {}
+
+func (x *DuplicateGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateGroup.ProtoReflect.Descriptor instead.
+func (*DuplicateGroup) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DuplicateGroup) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type DuplicateGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*DuplicateGroup      `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateGroupsResponse) Reset() {
+	*x = DuplicateGroupsResponse{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateGroupsResponse) ProtoMessage() {}
+
+func (x *DuplicateGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateGroupsResponse.ProtoReflect.Descriptor instead.
+func (*DuplicateGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DuplicateGroupsResponse) GetGroups() []*DuplicateGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type MergeDuplicatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeepId        int64                  `protobuf:"varint,1,opt,name=keep_id,json=keepId,proto3" json:"keep_id,omitempty"`
+	DuplicateIds  []int64                `protobuf:"varint,2,rep,packed,name=duplicate_ids,json=duplicateIds,proto3" json:"duplicate_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeDuplicatesRequest) Reset() {
+	*x = MergeDuplicatesRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeDuplicatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeDuplicatesRequest) ProtoMessage() {}
+
+func (x *MergeDuplicatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeDuplicatesRequest.ProtoReflect.Descriptor instead.
+func (*MergeDuplicatesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *MergeDuplicatesRequest) GetKeepId() int64 {
+	if x != nil {
+		return x.KeepId
+	}
+	return 0
+}
+
+func (x *MergeDuplicatesRequest) GetDuplicateIds() []int64 {
+	if x != nil {
+		return x.DuplicateIds
+	}
+	return nil
+}
+
+type DismissDuplicatesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TransactionIds []int64                `protobuf:"varint,1,rep,packed,name=transaction_ids,json=transactionIds,proto3" json:"transaction_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DismissDuplicatesRequest) Reset() {
+	*x = DismissDuplicatesRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DismissDuplicatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissDuplicatesRequest) ProtoMessage() {}
+
+func (x *DismissDuplicatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissDuplicatesRequest.ProtoReflect.Descriptor instead.
+func (*DismissDuplicatesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DismissDuplicatesRequest) GetTransactionIds() []int64 {
+	if x != nil {
+		return x.TransactionIds
+	}
+	return nil
+}
+
+type Reconciliation struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Account string                 `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// statement_date is YYYY-MM-DD.
+	StatementDate    string  `protobuf:"bytes,3,opt,name=statement_date,json=statementDate,proto3" json:"statement_date,omitempty"`
+	StatementBalance float64 `protobuf:"fixed64,4,opt,name=statement_balance,json=statementBalance,proto3" json:"statement_balance,omitempty"`
+	OpeningBalance   float64 `protobuf:"fixed64,5,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"`
+	// cleared_total is the spend of the cleared transactions, and
+	// cleared_balance the opening balance less it.
+	ClearedTotal   float64 `protobuf:"fixed64,6,opt,name=cleared_total,json=clearedTotal,proto3" json:"cleared_total,omitempty"`
+	ClearedBalance float64 `protobuf:"fixed64,7,opt,name=cleared_balance,json=clearedBalance,proto3" json:"cleared_balance,omitempty"`
+	// difference is statement_balance less cleared_balance; it must be
+	// zero to complete.
+	Difference float64 `protobuf:"fixed64,8,opt,name=difference,proto3" json:"difference,omitempty"`
+	// completed_at is unset while the reconciliation is open.
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// transactions are the unreconciled ones up to the statement date,
+	// only listed while the reconciliation is open.
+	Transactions  []*Transaction `protobuf:"bytes,10,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reconciliation) Reset() {
+	*x = Reconciliation{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reconciliation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reconciliation) ProtoMessage() {}
+
+func (x *Reconciliation) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reconciliation.ProtoReflect.Descriptor instead.
+func (*Reconciliation) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *Reconciliation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *Reconciliation) GetStatementDate() string {
+	if x != nil {
+		return x.StatementDate
+	}
+	return ""
+}
+
+func (x *Reconciliation) GetStatementBalance() float64 {
+	if x != nil {
+		return x.StatementBalance
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetOpeningBalance() float64 {
+	if x != nil {
+		return x.OpeningBalance
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetClearedTotal() float64 {
+	if x != nil {
+		return x.ClearedTotal
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetClearedBalance() float64 {
+	if x != nil {
+		return x.ClearedBalance
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetDifference() float64 {
+	if x != nil {
+		return x.Difference
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Reconciliation) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type StartReconciliationRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Account          string                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	StatementDate    string                 `protobuf:"bytes,2,opt,name=statement_date,json=statementDate,proto3" json:"statement_date,omitempty"`
+	StatementBalance float64                `protobuf:"fixed64,3,opt,name=statement_balance,json=statementBalance,proto3" json:"statement_balance,omitempty"`
+	// opening_balance is only used for the first reconciliation of an
+	// account; later ones open at the previous statement balance.
+	OpeningBalance float64 `protobuf:"fixed64,4,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartReconciliationRequest) Reset() {
+	*x = StartReconciliationRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartReconciliationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartReconciliationRequest) ProtoMessage() {}
+
+func (x *StartReconciliationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartReconciliationRequest.ProtoReflect.Descriptor instead.
+func (*StartReconciliationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *StartReconciliationRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *StartReconciliationRequest) GetStatementDate() string {
+	if x != nil {
+		return x.StatementDate
+	}
+	return ""
+}
+
+func (x *StartReconciliationRequest) GetStatementBalance() float64 {
+	if x != nil {
+		return x.StatementBalance
+	}
+	return 0
+}
+
+func (x *StartReconciliationRequest) GetOpeningBalance() float64 {
+	if x != nil {
+		return x.OpeningBalance
+	}
+	return 0
+}
+
+type ReconciliationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconciliationRequest) Reset() {
+	*x = ReconciliationRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationRequest) ProtoMessage() {}
+
+func (x *ReconciliationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationRequest.ProtoReflect.Descriptor instead.
+func (*ReconciliationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ReconciliationRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListReconciliationsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Reconciliations []*Reconciliation      `protobuf:"bytes,1,rep,name=reconciliations,proto3" json:"reconciliations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListReconciliationsResponse) Reset() {
+	*x = ListReconciliationsResponse{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReconciliationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationsResponse) ProtoMessage() {}
+
+func (x *ListReconciliationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationsResponse.ProtoReflect.Descriptor instead.
+func (*ListReconciliationsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListReconciliationsResponse) GetReconciliations() []*Reconciliation {
+	if x != nil {
+		return x.Reconciliations
+	}
+	return nil
+}
+
+type SetClearedRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TransactionIds []int64                `protobuf:"varint,1,rep,packed,name=transaction_ids,json=transactionIds,proto3" json:"transaction_ids,omitempty"`
+	Cleared        bool                   `protobuf:"varint,2,opt,name=cleared,proto3" json:"cleared,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetClearedRequest) Reset() {
+	*x = SetClearedRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetClearedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetClearedRequest) ProtoMessage() {}
+
+func (x *SetClearedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetClearedRequest.ProtoReflect.Descriptor instead.
+func (*SetClearedRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SetClearedRequest) GetTransactionIds() []int64 {
+	if x != nil {
+		return x.TransactionIds
+	}
+	return nil
+}
+
+func (x *SetClearedRequest) GetCleared() bool {
+	if x != nil {
+		return x.Cleared
+	}
+	return false
+}
+
+type SetTransactionStatusRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TransactionIds []int64                `protobuf:"varint,1,rep,packed,name=transaction_ids,json=transactionIds,proto3" json:"transaction_ids,omitempty"`
+	// status is pending, cleared or voided.
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransactionStatusRequest) Reset() {
+	*x = SetTransactionStatusRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransactionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransactionStatusRequest) ProtoMessage() {}
+
+func (x *SetTransactionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransactionStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetTransactionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SetTransactionStatusRequest) GetTransactionIds() []int64 {
+	if x != nil {
+		return x.TransactionIds
+	}
+	return nil
+}
+
+func (x *SetTransactionStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type StatusChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusChangesRequest) Reset() {
+	*x = StatusChangesRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusChangesRequest) ProtoMessage() {}
+
+func (x *StatusChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusChangesRequest.ProtoReflect.Descriptor instead.
+func (*StatusChangesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *StatusChangesRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+type StatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// from_status is empty for the status the transaction was created with.
+	FromStatus    string                 `protobuf:"bytes,2,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus      string                 `protobuf:"bytes,3,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusChange) Reset() {
+	*x = StatusChange{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusChange) ProtoMessage() {}
+
+func (x *StatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusChange.ProtoReflect.Descriptor instead.
+func (*StatusChange) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *StatusChange) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *StatusChange) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *StatusChange) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *StatusChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type StatusChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*StatusChange        `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusChangesResponse) Reset() {
+	*x = StatusChangesResponse{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusChangesResponse) ProtoMessage() {}
+
+func (x *StatusChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusChangesResponse.ProtoReflect.Descriptor instead.
+func (*StatusChangesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *StatusChangesResponse) GetChanges() []*StatusChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type Refund struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TransactionId int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// date is when the money came back; the spend is taken off
+	// original_date, the date of the original transaction.
+	Date          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
+	OriginalDate  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=original_date,json=originalDate,proto3" json:"original_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Refund) Reset() {
+	*x = Refund{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Refund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Refund) ProtoMessage() {}
+
+func (x *Refund) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Refund.ProtoReflect.Descriptor instead.
+func (*Refund) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *Refund) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Refund) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *Refund) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Refund) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Refund) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Refund) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Refund) GetOriginalDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OriginalDate
+	}
+	return nil
+}
+
+type CreateRefundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// category picks the line refunded of a split transaction; it defaults
+	// to the original's category otherwise.
+	Category    string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// date defaults to today.
+	Date          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRefundRequest) Reset() {
+	*x = CreateRefundRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRefundRequest) ProtoMessage() {}
+
+func (x *CreateRefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRefundRequest.ProtoReflect.Descriptor instead.
+func (*CreateRefundRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateRefundRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *CreateRefundRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateRefundRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateRefundRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateRefundRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type ListRefundsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRefundsRequest) Reset() {
+	*x = ListRefundsRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRefundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefundsRequest) ProtoMessage() {}
+
+func (x *ListRefundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefundsRequest.ProtoReflect.Descriptor instead.
+func (*ListRefundsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListRefundsRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+type ListRefundsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Refunds       []*Refund              `protobuf:"bytes,1,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRefundsResponse) Reset() {
+	*x = ListRefundsResponse{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRefundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefundsResponse) ProtoMessage() {}
+
+func (x *ListRefundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefundsResponse.ProtoReflect.Descriptor instead.
+func (*ListRefundsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListRefundsResponse) GetRefunds() []*Refund {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
+type Webhook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url   string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// secret signs deliveries; it is only returned when the webhook is
+	// created.
+	Secret string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	// events are transaction.created, budget.exceeded,
+	// budget.threshold_crossed, budget.updated or import.completed.
+	Events []string `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	// min_amount leaves out transaction.created for smaller transactions.
+	MinAmount float64 `protobuf:"fixed64,5,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	// threshold is the percentage of a budget whose crossing sends
+	// budget.threshold_crossed.
+	Threshold     float64                `protobuf:"fixed64,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *Webhook) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Webhook) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Webhook) GetMinAmount() float64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *Webhook) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Url   string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// secret is generated when empty.
+	Secret    string   `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	Events    []string `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	MinAmount float64  `protobuf:"fixed64,4,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	// threshold defaults to 80.
+	Threshold     float64 `protobuf:"fixed64,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *CreateWebhookRequest) GetMinAmount() float64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *CreateWebhookRequest) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DeleteWebhookRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type WebhookDeliveriesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId int64                  `protobuf:"varint,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	// limit defaults to 50 and is at most 500.
+	Limit         uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDeliveriesRequest) Reset() {
+	*x = WebhookDeliveriesRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *WebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *WebhookDeliveriesRequest) GetWebhookId() int64 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+func (x *WebhookDeliveriesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type WebhookDelivery struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId int64                  `protobuf:"varint,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	EventId   string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Attempt   uint32                 `protobuf:"varint,5,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// status_code is 0 when no response came back; error says why.
+	StatusCode    uint32                 `protobuf:"varint,6,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	Succeeded     bool                   `protobuf:"varint,8,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,9,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *WebhookDelivery) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetWebhookId() int64 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
 }
 
-func (*CreateTransactionRequest) ProtoMessage() {}
-
-func (x *CreateTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[2]
+func (x *WebhookDelivery) GetEventType() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.EventType
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CreateTransactionRequest.ProtoReflect.Descriptor instead.
-func (*CreateTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{2}
+func (x *WebhookDelivery) GetAttempt() uint32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
 }
 
-func (x *CreateTransactionRequest) GetAmount() float64 {
+func (x *WebhookDelivery) GetStatusCode() uint32 {
 	if x != nil {
-		return x.Amount
+		return x.StatusCode
 	}
 	return 0
 }
 
-func (x *CreateTransactionRequest) GetCategory() string {
+func (x *WebhookDelivery) GetError() string {
 	if x != nil {
-		return x.Category
+		return x.Error
 	}
 	return ""
 }
 
-func (x *CreateTransactionRequest) GetDescription() string {
+func (x *WebhookDelivery) GetSucceeded() bool {
 	if x != nil {
-		return x.Description
+		return x.Succeeded
 	}
-	return ""
+	return false
 }
 
-func (x *CreateTransactionRequest) GetDate() *timestamppb.Timestamp {
+func (x *WebhookDelivery) GetDurationMs() int64 {
 	if x != nil {
-		return x.Date
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type CreateBudgetRequest struct {
+type WebhookDeliveriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
-	Limit         float64                `protobuf:"fixed64,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateBudgetRequest) Reset() {
-	*x = CreateBudgetRequest{}
-	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[3]
+func (x *WebhookDeliveriesResponse) Reset() {
+	*x = WebhookDeliveriesResponse{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBudgetRequest) String() string {
+func (x *WebhookDeliveriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBudgetRequest) ProtoMessage() {}
+func (*WebhookDeliveriesResponse) ProtoMessage() {}
 
-func (x *CreateBudgetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[3]
+func (x *WebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -252,47 +5015,46 @@ func (x *CreateBudgetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBudgetRequest.ProtoReflect.Descriptor instead.
-func (*CreateBudgetRequest) Descriptor() ([]byte, []int) {
-	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *CreateBudgetRequest) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
+// Deprecated: Use WebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *CreateBudgetRequest) GetLimit() float64 {
+func (x *WebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
 	if x != nil {
-		return x.Limit
+		return x.Deliveries
 	}
-	return 0
+	return nil
 }
 
-type ListTransactionsResponse struct {
+// NotificationRecipient gets the emails it opted in to.
+type NotificationRecipient struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	BudgetAlerts  bool                   `protobuf:"varint,4,opt,name=budget_alerts,json=budgetAlerts,proto3" json:"budget_alerts,omitempty"`
+	WeeklyDigest  bool                   `protobuf:"varint,5,opt,name=weekly_digest,json=weeklyDigest,proto3" json:"weekly_digest,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTransactionsResponse) Reset() {
-	*x = ListTransactionsResponse{}
-	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[4]
+func (x *NotificationRecipient) Reset() {
+	*x = NotificationRecipient{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTransactionsResponse) String() string {
+func (x *NotificationRecipient) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTransactionsResponse) ProtoMessage() {}
+func (*NotificationRecipient) ProtoMessage() {}
 
-func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[4]
+func (x *NotificationRecipient) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -303,40 +5065,78 @@ func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
-func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use NotificationRecipient.ProtoReflect.Descriptor instead.
+func (*NotificationRecipient) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *ListTransactionsResponse) GetTransactions() []*Transaction {
+func (x *NotificationRecipient) GetId() int64 {
 	if x != nil {
-		return x.Transactions
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NotificationRecipient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NotificationRecipient) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *NotificationRecipient) GetBudgetAlerts() bool {
+	if x != nil {
+		return x.BudgetAlerts
+	}
+	return false
+}
+
+func (x *NotificationRecipient) GetWeeklyDigest() bool {
+	if x != nil {
+		return x.WeeklyDigest
+	}
+	return false
+}
+
+func (x *NotificationRecipient) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type ListBudgetsResponse struct {
+type SetNotificationRecipientRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Budgets       []*Budget              `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	BudgetAlerts  bool                   `protobuf:"varint,3,opt,name=budget_alerts,json=budgetAlerts,proto3" json:"budget_alerts,omitempty"`
+	WeeklyDigest  bool                   `protobuf:"varint,4,opt,name=weekly_digest,json=weeklyDigest,proto3" json:"weekly_digest,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBudgetsResponse) Reset() {
-	*x = ListBudgetsResponse{}
-	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[5]
+func (x *SetNotificationRecipientRequest) Reset() {
+	*x = SetNotificationRecipientRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBudgetsResponse) String() string {
+func (x *SetNotificationRecipientRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBudgetsResponse) ProtoMessage() {}
+func (*SetNotificationRecipientRequest) ProtoMessage() {}
 
-func (x *ListBudgetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[5]
+func (x *SetNotificationRecipientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -347,41 +5147,61 @@ func (x *ListBudgetsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBudgetsResponse.ProtoReflect.Descriptor instead.
-func (*ListBudgetsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use SetNotificationRecipientRequest.ProtoReflect.Descriptor instead.
+func (*SetNotificationRecipientRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *ListBudgetsResponse) GetBudgets() []*Budget {
+func (x *SetNotificationRecipientRequest) GetName() string {
 	if x != nil {
-		return x.Budgets
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type ReportSummaryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+func (x *SetNotificationRecipientRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SetNotificationRecipientRequest) GetBudgetAlerts() bool {
+	if x != nil {
+		return x.BudgetAlerts
+	}
+	return false
+}
+
+func (x *SetNotificationRecipientRequest) GetWeeklyDigest() bool {
+	if x != nil {
+		return x.WeeklyDigest
+	}
+	return false
+}
+
+type ListNotificationRecipientsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Recipients    []*NotificationRecipient `protobuf:"bytes,1,rep,name=recipients,proto3" json:"recipients,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReportSummaryRequest) Reset() {
-	*x = ReportSummaryRequest{}
-	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[6]
+func (x *ListNotificationRecipientsResponse) Reset() {
+	*x = ListNotificationRecipientsResponse{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReportSummaryRequest) String() string {
+func (x *ListNotificationRecipientsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportSummaryRequest) ProtoMessage() {}
+func (*ListNotificationRecipientsResponse) ProtoMessage() {}
 
-func (x *ReportSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[6]
+func (x *ListNotificationRecipientsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -392,47 +5212,40 @@ func (x *ReportSummaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportSummaryRequest.ProtoReflect.Descriptor instead.
-func (*ReportSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *ReportSummaryRequest) GetFrom() string {
-	if x != nil {
-		return x.From
-	}
-	return ""
+// Deprecated: Use ListNotificationRecipientsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationRecipientsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *ReportSummaryRequest) GetTo() string {
+func (x *ListNotificationRecipientsResponse) GetRecipients() []*NotificationRecipient {
 	if x != nil {
-		return x.To
+		return x.Recipients
 	}
-	return ""
+	return nil
 }
 
-type ReportSummaryResponse struct {
+type DeleteNotificationRecipientRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Totals        map[string]float64     `protobuf:"bytes,1,rep,name=totals,proto3" json:"totals,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReportSummaryResponse) Reset() {
-	*x = ReportSummaryResponse{}
-	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[7]
+func (x *DeleteNotificationRecipientRequest) Reset() {
+	*x = DeleteNotificationRecipientRequest{}
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReportSummaryResponse) String() string {
+func (x *DeleteNotificationRecipientRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportSummaryResponse) ProtoMessage() {}
+func (*DeleteNotificationRecipientRequest) ProtoMessage() {}
 
-func (x *ReportSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[7]
+func (x *DeleteNotificationRecipientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -443,16 +5256,16 @@ func (x *ReportSummaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportSummaryResponse.ProtoReflect.Descriptor instead.
-func (*ReportSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use DeleteNotificationRecipientRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationRecipientRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *ReportSummaryResponse) GetTotals() map[string]float64 {
+func (x *DeleteNotificationRecipientRequest) GetId() int64 {
 	if x != nil {
-		return x.Totals
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
 type BulkImportError struct {
@@ -465,7 +5278,7 @@ type BulkImportError struct {
 
 func (x *BulkImportError) Reset() {
 	*x = BulkImportError{}
-	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[8]
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -477,7 +5290,7 @@ func (x *BulkImportError) String() string {
 func (*BulkImportError) ProtoMessage() {}
 
 func (x *BulkImportError) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[8]
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -490,7 +5303,7 @@ func (x *BulkImportError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkImportError.ProtoReflect.Descriptor instead.
 func (*BulkImportError) Descriptor() ([]byte, []int) {
-	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{8}
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *BulkImportError) GetIndex() uint32 {
@@ -508,16 +5321,20 @@ func (x *BulkImportError) GetError() string {
 }
 
 type BulkCreateTransactionsRequest struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Transactions  []*CreateTransactionRequest `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	Workers       uint32                      `protobuf:"varint,2,opt,name=workers,proto3" json:"workers,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState      `protogen:"open.v1"`
+	Transactions []*CreateTransactionRequest `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Workers      uint32                      `protobuf:"varint,2,opt,name=workers,proto3" json:"workers,omitempty"`
+	// dry_run reports the would-be outcome without saving anything.
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// duplicate_policy applies to every row; see CreateTransactionRequest.
+	DuplicatePolicy string `protobuf:"bytes,4,opt,name=duplicate_policy,json=duplicatePolicy,proto3" json:"duplicate_policy,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *BulkCreateTransactionsRequest) Reset() {
 	*x = BulkCreateTransactionsRequest{}
-	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[9]
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -529,7 +5346,7 @@ func (x *BulkCreateTransactionsRequest) String() string {
 func (*BulkCreateTransactionsRequest) ProtoMessage() {}
 
 func (x *BulkCreateTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[9]
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -542,7 +5359,7 @@ func (x *BulkCreateTransactionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkCreateTransactionsRequest.ProtoReflect.Descriptor instead.
 func (*BulkCreateTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{9}
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *BulkCreateTransactionsRequest) GetTransactions() []*CreateTransactionRequest {
@@ -559,18 +5376,34 @@ func (x *BulkCreateTransactionsRequest) GetWorkers() uint32 {
 	return 0
 }
 
+func (x *BulkCreateTransactionsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *BulkCreateTransactionsRequest) GetDuplicatePolicy() string {
+	if x != nil {
+		return x.DuplicatePolicy
+	}
+	return ""
+}
+
 type BulkCreateTransactionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Accepted      uint32                 `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
-	Rejected      uint32                 `protobuf:"varint,2,opt,name=rejected,proto3" json:"rejected,omitempty"`
-	Errors        []*BulkImportError     `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Accepted uint32                 `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Rejected uint32                 `protobuf:"varint,2,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	Errors   []*BulkImportError     `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	// category_spend is set on dry runs: spend per category after the batch.
+	CategorySpend map[string]float64 `protobuf:"bytes,4,rep,name=category_spend,json=categorySpend,proto3" json:"category_spend,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BulkCreateTransactionsResponse) Reset() {
 	*x = BulkCreateTransactionsResponse{}
-	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[10]
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -582,7 +5415,7 @@ func (x *BulkCreateTransactionsResponse) String() string {
 func (*BulkCreateTransactionsResponse) ProtoMessage() {}
 
 func (x *BulkCreateTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[10]
+	mi := &file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -595,7 +5428,7 @@ func (x *BulkCreateTransactionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkCreateTransactionsResponse.ProtoReflect.Descriptor instead.
 func (*BulkCreateTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{10}
+	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *BulkCreateTransactionsResponse) GetAccepted() uint32 {
@@ -619,57 +5452,501 @@ func (x *BulkCreateTransactionsResponse) GetErrors() []*BulkImportError {
 	return nil
 }
 
+func (x *BulkCreateTransactionsResponse) GetCategorySpend() map[string]float64 {
+	if x != nil {
+		return x.CategorySpend
+	}
+	return nil
+}
+
 var File_internal_delivery_protos_ledger_v1_ledger_proto protoreflect.FileDescriptor
 
 const file_internal_delivery_protos_ledger_v1_ledger_proto_rawDesc = "" +
 	"\n" +
-	"/internal/delivery/protos/ledger/v1/ledger.proto\x12\tledger.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa3\x01\n" +
+	"/internal/delivery/protos/ledger/v1/ledger.proto\x12\tledger.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa2\x05\n" +
 	"\vTransaction\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1a\n" +
 	"\bcategory\x18\x03 \x01(\tR\bcategory\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12.\n" +
-	"\x04date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\":\n" +
+	"\x04date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12,\n" +
+	"\aanomaly\x18\x06 \x01(\v2\x12.ledger.v1.AnomalyR\aanomaly\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12!\n" +
+	"\frecurring_id\x18\b \x01(\x03R\vrecurringId\x12(\n" +
+	"\x06splits\x18\t \x03(\v2\x10.ledger.v1.SplitR\x06splits\x12\x19\n" +
+	"\bpayee_id\x18\n" +
+	" \x01(\x03R\apayeeId\x12\x14\n" +
+	"\x05payee\x18\v \x01(\tR\x05payee\x12=\n" +
+	"\n" +
+	"suggestion\x18\f \x01(\v2\x1d.ledger.v1.CategorySuggestionR\n" +
+	"suggestion\x12!\n" +
+	"\fduplicate_of\x18\r \x01(\x03R\vduplicateOf\x12\x18\n" +
+	"\aaccount\x18\x0e \x01(\tR\aaccount\x12\x18\n" +
+	"\acleared\x18\x0f \x01(\bR\acleared\x12+\n" +
+	"\x11reconciliation_id\x18\x10 \x01(\x03R\x10reconciliationId\x12\x16\n" +
+	"\x06status\x18\x11 \x01(\tR\x06status\x12F\n" +
+	"\x11status_changed_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\x0fstatusChangedAt\x12\x1a\n" +
+	"\brefunded\x18\x13 \x01(\x01R\brefunded\"P\n" +
+	"\x12CategorySuggestion\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x02 \x01(\x01R\n" +
+	"confidence\"~\n" +
+	"\x16SuggestCategoryRequest\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05payee\x18\x03 \x01(\tR\x05payee\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\rR\x05limit\"Z\n" +
+	"\x17SuggestCategoryResponse\x12?\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x1d.ledger.v1.CategorySuggestionR\vsuggestions\";\n" +
+	"\x05Split\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\"Q\n" +
+	"\aAnomaly\x12\x14\n" +
+	"\x05score\x18\x01 \x01(\x01R\x05score\x12\x16\n" +
+	"\x06median\x18\x02 \x01(\x01R\x06median\x12\x18\n" +
+	"\asamples\x18\x03 \x01(\rR\asamples\":\n" +
 	"\x06Budget\x12\x1a\n" +
 	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x01R\x05limit\"\xa0\x01\n" +
+	"\x05limit\x18\x02 \x01(\x01R\x05limit\"\x85\x03\n" +
 	"\x18CreateTransactionRequest\x12\x16\n" +
 	"\x06amount\x18\x01 \x01(\x01R\x06amount\x12\x1a\n" +
 	"\bcategory\x18\x02 \x01(\tR\bcategory\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12.\n" +
-	"\x04date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"G\n" +
+	"\x04date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12(\n" +
+	"\x06splits\x18\a \x03(\v2\x10.ledger.v1.SplitR\x06splits\x12\x19\n" +
+	"\bpayee_id\x18\b \x01(\x03R\apayeeId\x12\x14\n" +
+	"\x05payee\x18\t \x01(\tR\x05payee\x12)\n" +
+	"\x10duplicate_policy\x18\n" +
+	" \x01(\tR\x0fduplicatePolicy\x12\x18\n" +
+	"\aaccount\x18\v \x01(\tR\aaccount\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\"G\n" +
 	"\x13CreateBudgetRequest\x12\x1a\n" +
 	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x01R\x05limit\"V\n" +
+	"\x05limit\x18\x02 \x01(\x01R\x05limit\"I\n" +
+	"\x17ListTransactionsRequest\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\x12\x1a\n" +
+	"\bstatuses\x18\x02 \x03(\tR\bstatuses\"V\n" +
 	"\x18ListTransactionsResponse\x12:\n" +
 	"\ftransactions\x18\x01 \x03(\v2\x16.ledger.v1.TransactionR\ftransactions\"B\n" +
 	"\x13ListBudgetsResponse\x12+\n" +
-	"\abudgets\x18\x01 \x03(\v2\x11.ledger.v1.BudgetR\abudgets\":\n" +
+	"\abudgets\x18\x01 \x03(\v2\x11.ledger.v1.BudgetR\abudgets\"V\n" +
 	"\x14ReportSummaryRequest\x12\x12\n" +
 	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
-	"\x02to\x18\x02 \x01(\tR\x02to\"\x98\x01\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x1a\n" +
+	"\bstatuses\x18\x03 \x03(\tR\bstatuses\"\x99\x02\n" +
 	"\x15ReportSummaryResponse\x12D\n" +
-	"\x06totals\x18\x01 \x03(\v2,.ledger.v1.ReportSummaryResponse.TotalsEntryR\x06totals\x1a9\n" +
+	"\x06totals\x18\x01 \x03(\v2,.ledger.v1.ReportSummaryResponse.TotalsEntryR\x06totals\x12D\n" +
+	"\x06rollup\x18\x02 \x03(\v2,.ledger.v1.ReportSummaryResponse.RollupEntryR\x06rollup\x1a9\n" +
 	"\vTotalsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"=\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1a9\n" +
+	"\vRollupEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"`\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06parent\x18\x03 \x01(\tR\x06parent\x12\x18\n" +
+	"\aaliases\x18\x04 \x03(\tR\aaliases\"C\n" +
+	"\x15CreateCategoryRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06parent\x18\x02 \x01(\tR\x06parent\"C\n" +
+	"\x15UpdateCategoryRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06parent\x18\x02 \x01(\tR\x06parent\"+\n" +
+	"\x15DeleteCategoryRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"K\n" +
+	"\x17AddCategoryAliasRequest\x12\x14\n" +
+	"\x05alias\x18\x01 \x01(\tR\x05alias\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\";\n" +
+	"\x15RenameCategoryRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"J\n" +
+	"\x16MergeCategoriesRequest\x12\x18\n" +
+	"\asources\x18\x01 \x03(\tR\asources\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\"\x8e\x02\n" +
+	"\x14RecurringTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bschedule\x18\x05 \x01(\tR\bschedule\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\a \x01(\tR\aendDate\x12\x1b\n" +
+	"\tnext_date\x18\b \x01(\tR\bnextDate\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\"\xc4\x01\n" +
+	"\x16CreateRecurringRequest\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bschedule\x18\x04 \x01(\tR\bschedule\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x06 \x01(\tR\aendDate\"\xd4\x01\n" +
+	"\x16UpdateRecurringRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bschedule\x18\x05 \x01(\tR\bschedule\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\a \x01(\tR\aendDate\"(\n" +
+	"\x16DeleteRecurringRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"V\n" +
+	"\x15ListRecurringResponse\x12=\n" +
+	"\trecurring\x18\x01 \x03(\v2\x1f.ledger.v1.RecurringTransactionR\trecurring\"\xe1\x02\n" +
+	"\x04Rule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\x05R\bpriority\x121\n" +
+	"\x14description_contains\x18\x04 \x01(\tR\x13descriptionContains\x12+\n" +
+	"\x11description_regex\x18\x05 \x01(\tR\x10descriptionRegex\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\x06 \x01(\x01R\tminAmount\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\a \x01(\x01R\tmaxAmount\x12\x14\n" +
+	"\x05payee\x18\b \x01(\tR\x05payee\x12!\n" +
+	"\fset_category\x18\t \x01(\tR\vsetCategory\x12\x19\n" +
+	"\bset_tags\x18\n" +
+	" \x03(\tR\asetTags\x12'\n" +
+	"\x0fset_description\x18\v \x01(\tR\x0esetDescription\"#\n" +
+	"\x11DeleteRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\":\n" +
+	"\x11ListRulesResponse\x12%\n" +
+	"\x05rules\x18\x01 \x03(\v2\x0f.ledger.v1.RuleR\x05rules\",\n" +
+	"\x11ApplyRulesRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\"\xd1\x01\n" +
+	"\n" +
+	"RuleChange\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x12\x19\n" +
+	"\brule_ids\x18\x02 \x03(\x03R\aruleIds\x12.\n" +
+	"\x06before\x18\x03 \x01(\v2\x16.ledger.v1.TransactionR\x06before\x12,\n" +
+	"\x05after\x18\x04 \x01(\v2\x16.ledger.v1.TransactionR\x05after\x12#\n" +
+	"\rcategory_kept\x18\x05 \x01(\bR\fcategoryKept\"E\n" +
+	"\x12ApplyRulesResponse\x12/\n" +
+	"\achanges\x18\x01 \x03(\v2\x15.ledger.v1.RuleChangeR\achanges\"+\n" +
+	"\x05Payee\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"(\n" +
+	"\x12CreatePayeeRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\">\n" +
+	"\x12ListPayeesResponse\x12(\n" +
+	"\x06payees\x18\x01 \x03(\v2\x10.ledger.v1.PayeeR\x06payees\"J\n" +
+	"\x12PayeeReportRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x10\n" +
+	"\x03top\x18\x03 \x01(\rR\x03top\"i\n" +
+	"\n" +
+	"PayeeSpend\x12\x19\n" +
+	"\bpayee_id\x18\x01 \x01(\x03R\apayeeId\x12\x14\n" +
+	"\x05payee\x18\x02 \x01(\tR\x05payee\x12\x14\n" +
+	"\x05spent\x18\x03 \x01(\x01R\x05spent\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\rR\x05count\"D\n" +
+	"\x13PayeeReportResponse\x12-\n" +
+	"\x06payees\x18\x01 \x03(\v2\x15.ledger.v1.PayeeSpendR\x06payees\"\xd1\x01\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03R\rtransactionId\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x93\x01\n" +
+	"\x17UploadAttachmentRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\"?\n" +
+	"\x16ListAttachmentsRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\"R\n" +
+	"\x17ListAttachmentsResponse\x127\n" +
+	"\vattachments\x18\x01 \x03(\v2\x15.ledger.v1.AttachmentR\vattachments\"&\n" +
+	"\x14GetAttachmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"^\n" +
+	"\x11AttachmentContent\x125\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x15.ledger.v1.AttachmentR\n" +
+	"attachment\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"M\n" +
+	"\x16ListCategoriesResponse\x123\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x13.ledger.v1.CategoryR\n" +
+	"categories\"}\n" +
+	"\x15SpendingSeriesRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12 \n" +
+	"\vgranularity\x18\x03 \x01(\tR\vgranularity\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x04 \x03(\tR\n" +
+	"categories\"F\n" +
+	"\x0eCategorySeries\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x18\n" +
+	"\aamounts\x18\x02 \x03(\x01R\aamounts\"\x87\x01\n" +
+	"\x16SpendingSeriesResponse\x12 \n" +
+	"\vgranularity\x18\x01 \x01(\tR\vgranularity\x12\x18\n" +
+	"\abuckets\x18\x02 \x03(\tR\abuckets\x121\n" +
+	"\x06series\x18\x03 \x03(\v2\x19.ledger.v1.CategorySeriesR\x06series\"9\n" +
+	"\x13BudgetReportRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"\xb3\x01\n" +
+	"\x10BudgetReportLine\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x01R\x05limit\x12\x14\n" +
+	"\x05spent\x18\x03 \x01(\x01R\x05spent\x12\x1c\n" +
+	"\tremaining\x18\x04 \x01(\x01R\tremaining\x12!\n" +
+	"\fpercent_used\x18\x05 \x01(\x01R\vpercentUsed\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\"A\n" +
+	"\rCategorySpend\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05spent\x18\x02 \x01(\x01R\x05spent\"\x87\x01\n" +
+	"\x14BudgetReportResponse\x125\n" +
+	"\abudgets\x18\x01 \x03(\v2\x1b.ledger.v1.BudgetReportLineR\abudgets\x128\n" +
+	"\n" +
+	"unbudgeted\x18\x02 \x03(\v2\x18.ledger.v1.CategorySpendR\n" +
+	"unbudgeted\">\n" +
+	"\x0fForecastRequest\x12\x13\n" +
+	"\x05as_of\x18\x01 \x01(\tR\x04asOf\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\"\xda\x02\n" +
+	"\fForecastLine\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05spent\x18\x02 \x01(\x01R\x05spent\x12'\n" +
+	"\x0fpace_projection\x18\x03 \x01(\x01R\x0epaceProjection\x12-\n" +
+	"\x12history_projection\x18\x04 \x01(\x01R\x11historyProjection\x12\x1f\n" +
+	"\vhas_history\x18\x05 \x01(\bR\n" +
+	"hasHistory\x12+\n" +
+	"\x11recurring_pending\x18\x06 \x01(\x01R\x10recurringPending\x12\x1c\n" +
+	"\tprojected\x18\a \x01(\x01R\tprojected\x12\x14\n" +
+	"\x05limit\x18\b \x01(\x01R\x05limit\x12\x1d\n" +
+	"\n" +
+	"has_budget\x18\t \x01(\bR\thasBudget\x12\x1f\n" +
+	"\vover_budget\x18\n" +
+	" \x01(\bR\n" +
+	"overBudget\"\xb0\x01\n" +
+	"\x10ForecastResponse\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12!\n" +
+	"\fperiod_start\x18\x02 \x01(\tR\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x03 \x01(\tR\tperiodEnd\x12\x13\n" +
+	"\x05as_of\x18\x04 \x01(\tR\x04asOf\x12-\n" +
+	"\x05lines\x18\x05 \x03(\v2\x17.ledger.v1.ForecastLineR\x05lines\"6\n" +
+	"\x10AnomaliesRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"L\n" +
+	"\x0eDuplicateGroup\x12:\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x16.ledger.v1.TransactionR\ftransactions\"L\n" +
+	"\x17DuplicateGroupsResponse\x121\n" +
+	"\x06groups\x18\x01 \x03(\v2\x19.ledger.v1.DuplicateGroupR\x06groups\"V\n" +
+	"\x16MergeDuplicatesRequest\x12\x17\n" +
+	"\akeep_id\x18\x01 \x01(\x03R\x06keepId\x12#\n" +
+	"\rduplicate_ids\x18\x02 \x03(\x03R\fduplicateIds\"C\n" +
+	"\x18DismissDuplicatesRequest\x12'\n" +
+	"\x0ftransaction_ids\x18\x01 \x03(\x03R\x0etransactionIds\"\xa0\x03\n" +
+	"\x0eReconciliation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\aaccount\x18\x02 \x01(\tR\aaccount\x12%\n" +
+	"\x0estatement_date\x18\x03 \x01(\tR\rstatementDate\x12+\n" +
+	"\x11statement_balance\x18\x04 \x01(\x01R\x10statementBalance\x12'\n" +
+	"\x0fopening_balance\x18\x05 \x01(\x01R\x0eopeningBalance\x12#\n" +
+	"\rcleared_total\x18\x06 \x01(\x01R\fclearedTotal\x12'\n" +
+	"\x0fcleared_balance\x18\a \x01(\x01R\x0eclearedBalance\x12\x1e\n" +
+	"\n" +
+	"difference\x18\b \x01(\x01R\n" +
+	"difference\x12=\n" +
+	"\fcompleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12:\n" +
+	"\ftransactions\x18\n" +
+	" \x03(\v2\x16.ledger.v1.TransactionR\ftransactions\"\xb3\x01\n" +
+	"\x1aStartReconciliationRequest\x12\x18\n" +
+	"\aaccount\x18\x01 \x01(\tR\aaccount\x12%\n" +
+	"\x0estatement_date\x18\x02 \x01(\tR\rstatementDate\x12+\n" +
+	"\x11statement_balance\x18\x03 \x01(\x01R\x10statementBalance\x12'\n" +
+	"\x0fopening_balance\x18\x04 \x01(\x01R\x0eopeningBalance\"'\n" +
+	"\x15ReconciliationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"b\n" +
+	"\x1bListReconciliationsResponse\x12C\n" +
+	"\x0freconciliations\x18\x01 \x03(\v2\x19.ledger.v1.ReconciliationR\x0freconciliations\"V\n" +
+	"\x11SetClearedRequest\x12'\n" +
+	"\x0ftransaction_ids\x18\x01 \x03(\x03R\x0etransactionIds\x12\x18\n" +
+	"\acleared\x18\x02 \x01(\bR\acleared\"^\n" +
+	"\x1bSetTransactionStatusRequest\x12'\n" +
+	"\x0ftransaction_ids\x18\x01 \x03(\x03R\x0etransactionIds\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"=\n" +
+	"\x14StatusChangesRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\"\xae\x01\n" +
+	"\fStatusChange\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x12\x1f\n" +
+	"\vfrom_status\x18\x02 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x03 \x01(\tR\btoStatus\x129\n" +
+	"\n" +
+	"changed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"J\n" +
+	"\x15StatusChangesResponse\x121\n" +
+	"\achanges\x18\x01 \x03(\v2\x17.ledger.v1.StatusChangeR\achanges\"\x86\x02\n" +
+	"\x06Refund\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03R\rtransactionId\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12.\n" +
+	"\x04date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12?\n" +
+	"\roriginal_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\foriginalDate\"\xc2\x01\n" +
+	"\x13CreateRefundRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12.\n" +
+	"\x04date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\";\n" +
+	"\x12ListRefundsRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\"B\n" +
+	"\x13ListRefundsResponse\x12+\n" +
+	"\arefunds\x18\x01 \x03(\v2\x11.ledger.v1.RefundR\arefunds\"\xd3\x01\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\x12\x16\n" +
+	"\x06events\x18\x04 \x03(\tR\x06events\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\x05 \x01(\x01R\tminAmount\x12\x1c\n" +
+	"\tthreshold\x18\x06 \x01(\x01R\tthreshold\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x95\x01\n" +
+	"\x14CreateWebhookRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x16\n" +
+	"\x06events\x18\x03 \x03(\tR\x06events\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\x04 \x01(\x01R\tminAmount\x12\x1c\n" +
+	"\tthreshold\x18\x05 \x01(\x01R\tthreshold\"F\n" +
+	"\x14ListWebhooksResponse\x12.\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x12.ledger.v1.WebhookR\bwebhooks\"&\n" +
+	"\x14DeleteWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"O\n" +
+	"\x18WebhookDeliveriesRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\x03R\twebhookId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"\xc5\x02\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\x03R\twebhookId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12\x18\n" +
+	"\aattempt\x18\x05 \x01(\rR\aattempt\x12\x1f\n" +
+	"\vstatus_code\x18\x06 \x01(\rR\n" +
+	"statusCode\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x12\x1c\n" +
+	"\tsucceeded\x18\b \x01(\bR\tsucceeded\x12\x1f\n" +
+	"\vduration_ms\x18\t \x01(\x03R\n" +
+	"durationMs\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"W\n" +
+	"\x19WebhookDeliveriesResponse\x12:\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1a.ledger.v1.WebhookDeliveryR\n" +
+	"deliveries\"\xd6\x01\n" +
+	"\x15NotificationRecipient\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12#\n" +
+	"\rbudget_alerts\x18\x04 \x01(\bR\fbudgetAlerts\x12#\n" +
+	"\rweekly_digest\x18\x05 \x01(\bR\fweeklyDigest\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x95\x01\n" +
+	"\x1fSetNotificationRecipientRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12#\n" +
+	"\rbudget_alerts\x18\x03 \x01(\bR\fbudgetAlerts\x12#\n" +
+	"\rweekly_digest\x18\x04 \x01(\bR\fweeklyDigest\"f\n" +
+	"\"ListNotificationRecipientsResponse\x12@\n" +
+	"\n" +
+	"recipients\x18\x01 \x03(\v2 .ledger.v1.NotificationRecipientR\n" +
+	"recipients\"4\n" +
+	"\"DeleteNotificationRecipientRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"=\n" +
 	"\x0fBulkImportError\x12\x14\n" +
 	"\x05index\x18\x01 \x01(\rR\x05index\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\"\x82\x01\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xc6\x01\n" +
 	"\x1dBulkCreateTransactionsRequest\x12G\n" +
 	"\ftransactions\x18\x01 \x03(\v2#.ledger.v1.CreateTransactionRequestR\ftransactions\x12\x18\n" +
-	"\aworkers\x18\x02 \x01(\rR\aworkers\"\x8c\x01\n" +
+	"\aworkers\x18\x02 \x01(\rR\aworkers\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12)\n" +
+	"\x10duplicate_policy\x18\x04 \x01(\tR\x0fduplicatePolicy\"\xb3\x02\n" +
 	"\x1eBulkCreateTransactionsResponse\x12\x1a\n" +
 	"\baccepted\x18\x01 \x01(\rR\baccepted\x12\x1a\n" +
 	"\brejected\x18\x02 \x01(\rR\brejected\x122\n" +
-	"\x06errors\x18\x03 \x03(\v2\x1a.ledger.v1.BulkImportErrorR\x06errors2\xf9\x03\n" +
+	"\x06errors\x18\x03 \x03(\v2\x1a.ledger.v1.BulkImportErrorR\x06errors\x12c\n" +
+	"\x0ecategory_spend\x18\x04 \x03(\v2<.ledger.v1.BulkCreateTransactionsResponse.CategorySpendEntryR\rcategorySpend\x1a@\n" +
+	"\x12CategorySpendEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x012\x91!\n" +
 	"\rLedgerService\x12M\n" +
-	"\x0eAddTransaction\x12#.ledger.v1.CreateTransactionRequest\x1a\x16.ledger.v1.Transaction\x12O\n" +
-	"\x10ListTransactions\x12\x16.google.protobuf.Empty\x1a#.ledger.v1.ListTransactionsResponse\x12>\n" +
+	"\x0eAddTransaction\x12#.ledger.v1.CreateTransactionRequest\x1a\x16.ledger.v1.Transaction\x12[\n" +
+	"\x10ListTransactions\x12\".ledger.v1.ListTransactionsRequest\x1a#.ledger.v1.ListTransactionsResponse\x12>\n" +
 	"\tSetBudget\x12\x1e.ledger.v1.CreateBudgetRequest\x1a\x11.ledger.v1.Budget\x12E\n" +
 	"\vListBudgets\x12\x16.google.protobuf.Empty\x1a\x1e.ledger.v1.ListBudgetsResponse\x12U\n" +
 	"\x10GetReportSummary\x12\x1f.ledger.v1.ReportSummaryRequest\x1a .ledger.v1.ReportSummaryResponse\x12j\n" +
-	"\x13BulkAddTransactions\x12(.ledger.v1.BulkCreateTransactionsRequest\x1a).ledger.v1.BulkCreateTransactionsResponseB-Z+internal/delivery/protos/ledger/v1;ledgerv1b\x06proto3"
+	"\x13BulkAddTransactions\x12(.ledger.v1.BulkCreateTransactionsRequest\x1a).ledger.v1.BulkCreateTransactionsResponse\x12X\n" +
+	"\x11GetSpendingSeries\x12 .ledger.v1.SpendingSeriesRequest\x1a!.ledger.v1.SpendingSeriesResponse\x12R\n" +
+	"\x0fGetBudgetReport\x12\x1e.ledger.v1.BudgetReportRequest\x1a\x1f.ledger.v1.BudgetReportResponse\x12F\n" +
+	"\vGetForecast\x12\x1a.ledger.v1.ForecastRequest\x1a\x1b.ledger.v1.ForecastResponse\x12P\n" +
+	"\fGetAnomalies\x12\x1b.ledger.v1.AnomaliesRequest\x1a#.ledger.v1.ListTransactionsResponse\x12R\n" +
+	"\rGetTagSummary\x12\x1f.ledger.v1.ReportSummaryRequest\x1a .ledger.v1.ReportSummaryResponse\x12O\n" +
+	"\x0eGetPayeeReport\x12\x1d.ledger.v1.PayeeReportRequest\x1a\x1e.ledger.v1.PayeeReportResponse\x12W\n" +
+	"\x13StartReconciliation\x12%.ledger.v1.StartReconciliationRequest\x1a\x19.ledger.v1.Reconciliation\x12U\n" +
+	"\x13ListReconciliations\x12\x16.google.protobuf.Empty\x1a&.ledger.v1.ListReconciliationsResponse\x12P\n" +
+	"\x11GetReconciliation\x12 .ledger.v1.ReconciliationRequest\x1a\x19.ledger.v1.Reconciliation\x12B\n" +
+	"\n" +
+	"SetCleared\x12\x1c.ledger.v1.SetClearedRequest\x1a\x16.google.protobuf.Empty\x12V\n" +
+	"\x14SetTransactionStatus\x12&.ledger.v1.SetTransactionStatusRequest\x1a\x16.google.protobuf.Empty\x12V\n" +
+	"\x11ListStatusChanges\x12\x1f.ledger.v1.StatusChangesRequest\x1a .ledger.v1.StatusChangesResponse\x12A\n" +
+	"\fCreateRefund\x12\x1e.ledger.v1.CreateRefundRequest\x1a\x11.ledger.v1.Refund\x12L\n" +
+	"\vListRefunds\x12\x1d.ledger.v1.ListRefundsRequest\x1a\x1e.ledger.v1.ListRefundsResponse\x12U\n" +
+	"\x16CompleteReconciliation\x12 .ledger.v1.ReconciliationRequest\x1a\x19.ledger.v1.Reconciliation\x12P\n" +
+	"\x12GetDuplicateGroups\x12\x16.google.protobuf.Empty\x1a\".ledger.v1.DuplicateGroupsResponse\x12L\n" +
+	"\x0fMergeDuplicates\x12!.ledger.v1.MergeDuplicatesRequest\x1a\x16.google.protobuf.Empty\x12P\n" +
+	"\x11DismissDuplicates\x12#.ledger.v1.DismissDuplicatesRequest\x1a\x16.google.protobuf.Empty\x12X\n" +
+	"\x0fSuggestCategory\x12!.ledger.v1.SuggestCategoryRequest\x1a\".ledger.v1.SuggestCategoryResponse\x12G\n" +
+	"\x0eCreateCategory\x12 .ledger.v1.CreateCategoryRequest\x1a\x13.ledger.v1.Category\x12K\n" +
+	"\x0eListCategories\x12\x16.google.protobuf.Empty\x1a!.ledger.v1.ListCategoriesResponse\x12G\n" +
+	"\x0eUpdateCategory\x12 .ledger.v1.UpdateCategoryRequest\x1a\x13.ledger.v1.Category\x12J\n" +
+	"\x0eDeleteCategory\x12 .ledger.v1.DeleteCategoryRequest\x1a\x16.google.protobuf.Empty\x12K\n" +
+	"\x10AddCategoryAlias\x12\".ledger.v1.AddCategoryAliasRequest\x1a\x13.ledger.v1.Category\x12G\n" +
+	"\x0eRenameCategory\x12 .ledger.v1.RenameCategoryRequest\x1a\x13.ledger.v1.Category\x12I\n" +
+	"\x0fMergeCategories\x12!.ledger.v1.MergeCategoriesRequest\x1a\x13.ledger.v1.Category\x12U\n" +
+	"\x0fCreateRecurring\x12!.ledger.v1.CreateRecurringRequest\x1a\x1f.ledger.v1.RecurringTransaction\x12I\n" +
+	"\rListRecurring\x12\x16.google.protobuf.Empty\x1a .ledger.v1.ListRecurringResponse\x12U\n" +
+	"\x0fUpdateRecurring\x12!.ledger.v1.UpdateRecurringRequest\x1a\x1f.ledger.v1.RecurringTransaction\x12L\n" +
+	"\x0fDeleteRecurring\x12!.ledger.v1.DeleteRecurringRequest\x1a\x16.google.protobuf.Empty\x12.\n" +
+	"\n" +
+	"CreateRule\x12\x0f.ledger.v1.Rule\x1a\x0f.ledger.v1.Rule\x12A\n" +
+	"\tListRules\x12\x16.google.protobuf.Empty\x1a\x1c.ledger.v1.ListRulesResponse\x12.\n" +
+	"\n" +
+	"UpdateRule\x12\x0f.ledger.v1.Rule\x1a\x0f.ledger.v1.Rule\x12B\n" +
+	"\n" +
+	"DeleteRule\x12\x1c.ledger.v1.DeleteRuleRequest\x1a\x16.google.protobuf.Empty\x12I\n" +
+	"\n" +
+	"ApplyRules\x12\x1c.ledger.v1.ApplyRulesRequest\x1a\x1d.ledger.v1.ApplyRulesResponse\x12>\n" +
+	"\vCreatePayee\x12\x1d.ledger.v1.CreatePayeeRequest\x1a\x10.ledger.v1.Payee\x12C\n" +
+	"\n" +
+	"ListPayees\x12\x16.google.protobuf.Empty\x1a\x1d.ledger.v1.ListPayeesResponse\x12M\n" +
+	"\x10UploadAttachment\x12\".ledger.v1.UploadAttachmentRequest\x1a\x15.ledger.v1.Attachment\x12X\n" +
+	"\x0fListAttachments\x12!.ledger.v1.ListAttachmentsRequest\x1a\".ledger.v1.ListAttachmentsResponse\x12N\n" +
+	"\rGetAttachment\x12\x1f.ledger.v1.GetAttachmentRequest\x1a\x1c.ledger.v1.AttachmentContent\x12D\n" +
+	"\rCreateWebhook\x12\x1f.ledger.v1.CreateWebhookRequest\x1a\x12.ledger.v1.Webhook\x12G\n" +
+	"\fListWebhooks\x12\x16.google.protobuf.Empty\x1a\x1f.ledger.v1.ListWebhooksResponse\x12H\n" +
+	"\rDeleteWebhook\x12\x1f.ledger.v1.DeleteWebhookRequest\x1a\x16.google.protobuf.Empty\x12b\n" +
+	"\x15ListWebhookDeliveries\x12#.ledger.v1.WebhookDeliveriesRequest\x1a$.ledger.v1.WebhookDeliveriesResponse\x12h\n" +
+	"\x18SetNotificationRecipient\x12*.ledger.v1.SetNotificationRecipientRequest\x1a .ledger.v1.NotificationRecipient\x12c\n" +
+	"\x1aListNotificationRecipients\x12\x16.google.protobuf.Empty\x1a-.ledger.v1.ListNotificationRecipientsResponse\x12d\n" +
+	"\x1bDeleteNotificationRecipient\x12-.ledger.v1.DeleteNotificationRecipientRequest\x1a\x16.google.protobuf.EmptyB-Z+internal/delivery/protos/ledger/v1;ledgerv1b\x06proto3"
 
 var (
 	file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescOnce sync.Once
@@ -683,48 +5960,260 @@ func file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescGZIP() []byte {
 	return file_internal_delivery_protos_ledger_v1_ledger_proto_rawDescData
 }
 
-var file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_internal_delivery_protos_ledger_v1_ledger_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
 var file_internal_delivery_protos_ledger_v1_ledger_proto_goTypes = []any{
-	(*Transaction)(nil),                    // 0: ledger.v1.Transaction
-	(*Budget)(nil),                         // 1: ledger.v1.Budget
-	(*CreateTransactionRequest)(nil),       // 2: ledger.v1.CreateTransactionRequest
-	(*CreateBudgetRequest)(nil),            // 3: ledger.v1.CreateBudgetRequest
-	(*ListTransactionsResponse)(nil),       // 4: ledger.v1.ListTransactionsResponse
-	(*ListBudgetsResponse)(nil),            // 5: ledger.v1.ListBudgetsResponse
-	(*ReportSummaryRequest)(nil),           // 6: ledger.v1.ReportSummaryRequest
-	(*ReportSummaryResponse)(nil),          // 7: ledger.v1.ReportSummaryResponse
-	(*BulkImportError)(nil),                // 8: ledger.v1.BulkImportError
-	(*BulkCreateTransactionsRequest)(nil),  // 9: ledger.v1.BulkCreateTransactionsRequest
-	(*BulkCreateTransactionsResponse)(nil), // 10: ledger.v1.BulkCreateTransactionsResponse
-	nil,                                    // 11: ledger.v1.ReportSummaryResponse.TotalsEntry
-	(*timestamppb.Timestamp)(nil),          // 12: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                  // 13: google.protobuf.Empty
+	(*Transaction)(nil),                        // 0: ledger.v1.Transaction
+	(*CategorySuggestion)(nil),                 // 1: ledger.v1.CategorySuggestion
+	(*SuggestCategoryRequest)(nil),             // 2: ledger.v1.SuggestCategoryRequest
+	(*SuggestCategoryResponse)(nil),            // 3: ledger.v1.SuggestCategoryResponse
+	(*Split)(nil),                              // 4: ledger.v1.Split
+	(*Anomaly)(nil),                            // 5: ledger.v1.Anomaly
+	(*Budget)(nil),                             // 6: ledger.v1.Budget
+	(*CreateTransactionRequest)(nil),           // 7: ledger.v1.CreateTransactionRequest
+	(*CreateBudgetRequest)(nil),                // 8: ledger.v1.CreateBudgetRequest
+	(*ListTransactionsRequest)(nil),            // 9: ledger.v1.ListTransactionsRequest
+	(*ListTransactionsResponse)(nil),           // 10: ledger.v1.ListTransactionsResponse
+	(*ListBudgetsResponse)(nil),                // 11: ledger.v1.ListBudgetsResponse
+	(*ReportSummaryRequest)(nil),               // 12: ledger.v1.ReportSummaryRequest
+	(*ReportSummaryResponse)(nil),              // 13: ledger.v1.ReportSummaryResponse
+	(*Category)(nil),                           // 14: ledger.v1.Category
+	(*CreateCategoryRequest)(nil),              // 15: ledger.v1.CreateCategoryRequest
+	(*UpdateCategoryRequest)(nil),              // 16: ledger.v1.UpdateCategoryRequest
+	(*DeleteCategoryRequest)(nil),              // 17: ledger.v1.DeleteCategoryRequest
+	(*AddCategoryAliasRequest)(nil),            // 18: ledger.v1.AddCategoryAliasRequest
+	(*RenameCategoryRequest)(nil),              // 19: ledger.v1.RenameCategoryRequest
+	(*MergeCategoriesRequest)(nil),             // 20: ledger.v1.MergeCategoriesRequest
+	(*RecurringTransaction)(nil),               // 21: ledger.v1.RecurringTransaction
+	(*CreateRecurringRequest)(nil),             // 22: ledger.v1.CreateRecurringRequest
+	(*UpdateRecurringRequest)(nil),             // 23: ledger.v1.UpdateRecurringRequest
+	(*DeleteRecurringRequest)(nil),             // 24: ledger.v1.DeleteRecurringRequest
+	(*ListRecurringResponse)(nil),              // 25: ledger.v1.ListRecurringResponse
+	(*Rule)(nil),                               // 26: ledger.v1.Rule
+	(*DeleteRuleRequest)(nil),                  // 27: ledger.v1.DeleteRuleRequest
+	(*ListRulesResponse)(nil),                  // 28: ledger.v1.ListRulesResponse
+	(*ApplyRulesRequest)(nil),                  // 29: ledger.v1.ApplyRulesRequest
+	(*RuleChange)(nil),                         // 30: ledger.v1.RuleChange
+	(*ApplyRulesResponse)(nil),                 // 31: ledger.v1.ApplyRulesResponse
+	(*Payee)(nil),                              // 32: ledger.v1.Payee
+	(*CreatePayeeRequest)(nil),                 // 33: ledger.v1.CreatePayeeRequest
+	(*ListPayeesResponse)(nil),                 // 34: ledger.v1.ListPayeesResponse
+	(*PayeeReportRequest)(nil),                 // 35: ledger.v1.PayeeReportRequest
+	(*PayeeSpend)(nil),                         // 36: ledger.v1.PayeeSpend
+	(*PayeeReportResponse)(nil),                // 37: ledger.v1.PayeeReportResponse
+	(*Attachment)(nil),                         // 38: ledger.v1.Attachment
+	(*UploadAttachmentRequest)(nil),            // 39: ledger.v1.UploadAttachmentRequest
+	(*ListAttachmentsRequest)(nil),             // 40: ledger.v1.ListAttachmentsRequest
+	(*ListAttachmentsResponse)(nil),            // 41: ledger.v1.ListAttachmentsResponse
+	(*GetAttachmentRequest)(nil),               // 42: ledger.v1.GetAttachmentRequest
+	(*AttachmentContent)(nil),                  // 43: ledger.v1.AttachmentContent
+	(*ListCategoriesResponse)(nil),             // 44: ledger.v1.ListCategoriesResponse
+	(*SpendingSeriesRequest)(nil),              // 45: ledger.v1.SpendingSeriesRequest
+	(*CategorySeries)(nil),                     // 46: ledger.v1.CategorySeries
+	(*SpendingSeriesResponse)(nil),             // 47: ledger.v1.SpendingSeriesResponse
+	(*BudgetReportRequest)(nil),                // 48: ledger.v1.BudgetReportRequest
+	(*BudgetReportLine)(nil),                   // 49: ledger.v1.BudgetReportLine
+	(*CategorySpend)(nil),                      // 50: ledger.v1.CategorySpend
+	(*BudgetReportResponse)(nil),               // 51: ledger.v1.BudgetReportResponse
+	(*ForecastRequest)(nil),                    // 52: ledger.v1.ForecastRequest
+	(*ForecastLine)(nil),                       // 53: ledger.v1.ForecastLine
+	(*ForecastResponse)(nil),                   // 54: ledger.v1.ForecastResponse
+	(*AnomaliesRequest)(nil),                   // 55: ledger.v1.AnomaliesRequest
+	(*DuplicateGroup)(nil),                     // 56: ledger.v1.DuplicateGroup
+	(*DuplicateGroupsResponse)(nil),            // 57: ledger.v1.DuplicateGroupsResponse
+	(*MergeDuplicatesRequest)(nil),             // 58: ledger.v1.MergeDuplicatesRequest
+	(*DismissDuplicatesRequest)(nil),           // 59: ledger.v1.DismissDuplicatesRequest
+	(*Reconciliation)(nil),                     // 60: ledger.v1.Reconciliation
+	(*StartReconciliationRequest)(nil),         // 61: ledger.v1.StartReconciliationRequest
+	(*ReconciliationRequest)(nil),              // 62: ledger.v1.ReconciliationRequest
+	(*ListReconciliationsResponse)(nil),        // 63: ledger.v1.ListReconciliationsResponse
+	(*SetClearedRequest)(nil),                  // 64: ledger.v1.SetClearedRequest
+	(*SetTransactionStatusRequest)(nil),        // 65: ledger.v1.SetTransactionStatusRequest
+	(*StatusChangesRequest)(nil),               // 66: ledger.v1.StatusChangesRequest
+	(*StatusChange)(nil),                       // 67: ledger.v1.StatusChange
+	(*StatusChangesResponse)(nil),              // 68: ledger.v1.StatusChangesResponse
+	(*Refund)(nil),                             // 69: ledger.v1.Refund
+	(*CreateRefundRequest)(nil),                // 70: ledger.v1.CreateRefundRequest
+	(*ListRefundsRequest)(nil),                 // 71: ledger.v1.ListRefundsRequest
+	(*ListRefundsResponse)(nil),                // 72: ledger.v1.ListRefundsResponse
+	(*Webhook)(nil),                            // 73: ledger.v1.Webhook
+	(*CreateWebhookRequest)(nil),               // 74: ledger.v1.CreateWebhookRequest
+	(*ListWebhooksResponse)(nil),               // 75: ledger.v1.ListWebhooksResponse
+	(*DeleteWebhookRequest)(nil),               // 76: ledger.v1.DeleteWebhookRequest
+	(*WebhookDeliveriesRequest)(nil),           // 77: ledger.v1.WebhookDeliveriesRequest
+	(*WebhookDelivery)(nil),                    // 78: ledger.v1.WebhookDelivery
+	(*WebhookDeliveriesResponse)(nil),          // 79: ledger.v1.WebhookDeliveriesResponse
+	(*NotificationRecipient)(nil),              // 80: ledger.v1.NotificationRecipient
+	(*SetNotificationRecipientRequest)(nil),    // 81: ledger.v1.SetNotificationRecipientRequest
+	(*ListNotificationRecipientsResponse)(nil), // 82: ledger.v1.ListNotificationRecipientsResponse
+	(*DeleteNotificationRecipientRequest)(nil), // 83: ledger.v1.DeleteNotificationRecipientRequest
+	(*BulkImportError)(nil),                    // 84: ledger.v1.BulkImportError
+	(*BulkCreateTransactionsRequest)(nil),      // 85: ledger.v1.BulkCreateTransactionsRequest
+	(*BulkCreateTransactionsResponse)(nil),     // 86: ledger.v1.BulkCreateTransactionsResponse
+	nil,                                        // 87: ledger.v1.ReportSummaryResponse.TotalsEntry
+	nil,                                        // 88: ledger.v1.ReportSummaryResponse.RollupEntry
+	nil,                                        // 89: ledger.v1.BulkCreateTransactionsResponse.CategorySpendEntry
+	(*timestamppb.Timestamp)(nil),              // 90: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                      // 91: google.protobuf.Empty
 }
 var file_internal_delivery_protos_ledger_v1_ledger_proto_depIdxs = []int32{
-	12, // 0: ledger.v1.Transaction.date:type_name -> google.protobuf.Timestamp
-	12, // 1: ledger.v1.CreateTransactionRequest.date:type_name -> google.protobuf.Timestamp
-	0,  // 2: ledger.v1.ListTransactionsResponse.transactions:type_name -> ledger.v1.Transaction
-	1,  // 3: ledger.v1.ListBudgetsResponse.budgets:type_name -> ledger.v1.Budget
-	11, // 4: ledger.v1.ReportSummaryResponse.totals:type_name -> ledger.v1.ReportSummaryResponse.TotalsEntry
-	2,  // 5: ledger.v1.BulkCreateTransactionsRequest.transactions:type_name -> ledger.v1.CreateTransactionRequest
-	8,  // 6: ledger.v1.BulkCreateTransactionsResponse.errors:type_name -> ledger.v1.BulkImportError
-	2,  // 7: ledger.v1.LedgerService.AddTransaction:input_type -> ledger.v1.CreateTransactionRequest
-	13, // 8: ledger.v1.LedgerService.ListTransactions:input_type -> google.protobuf.Empty
-	3,  // 9: ledger.v1.LedgerService.SetBudget:input_type -> ledger.v1.CreateBudgetRequest
-	13, // 10: ledger.v1.LedgerService.ListBudgets:input_type -> google.protobuf.Empty
-	6,  // 11: ledger.v1.LedgerService.GetReportSummary:input_type -> ledger.v1.ReportSummaryRequest
-	9,  // 12: ledger.v1.LedgerService.BulkAddTransactions:input_type -> ledger.v1.BulkCreateTransactionsRequest
-	0,  // 13: ledger.v1.LedgerService.AddTransaction:output_type -> ledger.v1.Transaction
-	4,  // 14: ledger.v1.LedgerService.ListTransactions:output_type -> ledger.v1.ListTransactionsResponse
-	1,  // 15: ledger.v1.LedgerService.SetBudget:output_type -> ledger.v1.Budget
-	5,  // 16: ledger.v1.LedgerService.ListBudgets:output_type -> ledger.v1.ListBudgetsResponse
-	7,  // 17: ledger.v1.LedgerService.GetReportSummary:output_type -> ledger.v1.ReportSummaryResponse
-	10, // 18: ledger.v1.LedgerService.BulkAddTransactions:output_type -> ledger.v1.BulkCreateTransactionsResponse
-	13, // [13:19] is the sub-list for method output_type
-	7,  // [7:13] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	90,  // 0: ledger.v1.Transaction.date:type_name -> google.protobuf.Timestamp
+	5,   // 1: ledger.v1.Transaction.anomaly:type_name -> ledger.v1.Anomaly
+	4,   // 2: ledger.v1.Transaction.splits:type_name -> ledger.v1.Split
+	1,   // 3: ledger.v1.Transaction.suggestion:type_name -> ledger.v1.CategorySuggestion
+	90,  // 4: ledger.v1.Transaction.status_changed_at:type_name -> google.protobuf.Timestamp
+	1,   // 5: ledger.v1.SuggestCategoryResponse.suggestions:type_name -> ledger.v1.CategorySuggestion
+	90,  // 6: ledger.v1.CreateTransactionRequest.date:type_name -> google.protobuf.Timestamp
+	4,   // 7: ledger.v1.CreateTransactionRequest.splits:type_name -> ledger.v1.Split
+	0,   // 8: ledger.v1.ListTransactionsResponse.transactions:type_name -> ledger.v1.Transaction
+	6,   // 9: ledger.v1.ListBudgetsResponse.budgets:type_name -> ledger.v1.Budget
+	87,  // 10: ledger.v1.ReportSummaryResponse.totals:type_name -> ledger.v1.ReportSummaryResponse.TotalsEntry
+	88,  // 11: ledger.v1.ReportSummaryResponse.rollup:type_name -> ledger.v1.ReportSummaryResponse.RollupEntry
+	21,  // 12: ledger.v1.ListRecurringResponse.recurring:type_name -> ledger.v1.RecurringTransaction
+	26,  // 13: ledger.v1.ListRulesResponse.rules:type_name -> ledger.v1.Rule
+	0,   // 14: ledger.v1.RuleChange.before:type_name -> ledger.v1.Transaction
+	0,   // 15: ledger.v1.RuleChange.after:type_name -> ledger.v1.Transaction
+	30,  // 16: ledger.v1.ApplyRulesResponse.changes:type_name -> ledger.v1.RuleChange
+	32,  // 17: ledger.v1.ListPayeesResponse.payees:type_name -> ledger.v1.Payee
+	36,  // 18: ledger.v1.PayeeReportResponse.payees:type_name -> ledger.v1.PayeeSpend
+	90,  // 19: ledger.v1.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	38,  // 20: ledger.v1.ListAttachmentsResponse.attachments:type_name -> ledger.v1.Attachment
+	38,  // 21: ledger.v1.AttachmentContent.attachment:type_name -> ledger.v1.Attachment
+	14,  // 22: ledger.v1.ListCategoriesResponse.categories:type_name -> ledger.v1.Category
+	46,  // 23: ledger.v1.SpendingSeriesResponse.series:type_name -> ledger.v1.CategorySeries
+	49,  // 24: ledger.v1.BudgetReportResponse.budgets:type_name -> ledger.v1.BudgetReportLine
+	50,  // 25: ledger.v1.BudgetReportResponse.unbudgeted:type_name -> ledger.v1.CategorySpend
+	53,  // 26: ledger.v1.ForecastResponse.lines:type_name -> ledger.v1.ForecastLine
+	0,   // 27: ledger.v1.DuplicateGroup.transactions:type_name -> ledger.v1.Transaction
+	56,  // 28: ledger.v1.DuplicateGroupsResponse.groups:type_name -> ledger.v1.DuplicateGroup
+	90,  // 29: ledger.v1.Reconciliation.completed_at:type_name -> google.protobuf.Timestamp
+	0,   // 30: ledger.v1.Reconciliation.transactions:type_name -> ledger.v1.Transaction
+	60,  // 31: ledger.v1.ListReconciliationsResponse.reconciliations:type_name -> ledger.v1.Reconciliation
+	90,  // 32: ledger.v1.StatusChange.changed_at:type_name -> google.protobuf.Timestamp
+	67,  // 33: ledger.v1.StatusChangesResponse.changes:type_name -> ledger.v1.StatusChange
+	90,  // 34: ledger.v1.Refund.date:type_name -> google.protobuf.Timestamp
+	90,  // 35: ledger.v1.Refund.original_date:type_name -> google.protobuf.Timestamp
+	90,  // 36: ledger.v1.CreateRefundRequest.date:type_name -> google.protobuf.Timestamp
+	69,  // 37: ledger.v1.ListRefundsResponse.refunds:type_name -> ledger.v1.Refund
+	90,  // 38: ledger.v1.Webhook.created_at:type_name -> google.protobuf.Timestamp
+	73,  // 39: ledger.v1.ListWebhooksResponse.webhooks:type_name -> ledger.v1.Webhook
+	90,  // 40: ledger.v1.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	78,  // 41: ledger.v1.WebhookDeliveriesResponse.deliveries:type_name -> ledger.v1.WebhookDelivery
+	90,  // 42: ledger.v1.NotificationRecipient.created_at:type_name -> google.protobuf.Timestamp
+	80,  // 43: ledger.v1.ListNotificationRecipientsResponse.recipients:type_name -> ledger.v1.NotificationRecipient
+	7,   // 44: ledger.v1.BulkCreateTransactionsRequest.transactions:type_name -> ledger.v1.CreateTransactionRequest
+	84,  // 45: ledger.v1.BulkCreateTransactionsResponse.errors:type_name -> ledger.v1.BulkImportError
+	89,  // 46: ledger.v1.BulkCreateTransactionsResponse.category_spend:type_name -> ledger.v1.BulkCreateTransactionsResponse.CategorySpendEntry
+	7,   // 47: ledger.v1.LedgerService.AddTransaction:input_type -> ledger.v1.CreateTransactionRequest
+	9,   // 48: ledger.v1.LedgerService.ListTransactions:input_type -> ledger.v1.ListTransactionsRequest
+	8,   // 49: ledger.v1.LedgerService.SetBudget:input_type -> ledger.v1.CreateBudgetRequest
+	91,  // 50: ledger.v1.LedgerService.ListBudgets:input_type -> google.protobuf.Empty
+	12,  // 51: ledger.v1.LedgerService.GetReportSummary:input_type -> ledger.v1.ReportSummaryRequest
+	85,  // 52: ledger.v1.LedgerService.BulkAddTransactions:input_type -> ledger.v1.BulkCreateTransactionsRequest
+	45,  // 53: ledger.v1.LedgerService.GetSpendingSeries:input_type -> ledger.v1.SpendingSeriesRequest
+	48,  // 54: ledger.v1.LedgerService.GetBudgetReport:input_type -> ledger.v1.BudgetReportRequest
+	52,  // 55: ledger.v1.LedgerService.GetForecast:input_type -> ledger.v1.ForecastRequest
+	55,  // 56: ledger.v1.LedgerService.GetAnomalies:input_type -> ledger.v1.AnomaliesRequest
+	12,  // 57: ledger.v1.LedgerService.GetTagSummary:input_type -> ledger.v1.ReportSummaryRequest
+	35,  // 58: ledger.v1.LedgerService.GetPayeeReport:input_type -> ledger.v1.PayeeReportRequest
+	61,  // 59: ledger.v1.LedgerService.StartReconciliation:input_type -> ledger.v1.StartReconciliationRequest
+	91,  // 60: ledger.v1.LedgerService.ListReconciliations:input_type -> google.protobuf.Empty
+	62,  // 61: ledger.v1.LedgerService.GetReconciliation:input_type -> ledger.v1.ReconciliationRequest
+	64,  // 62: ledger.v1.LedgerService.SetCleared:input_type -> ledger.v1.SetClearedRequest
+	65,  // 63: ledger.v1.LedgerService.SetTransactionStatus:input_type -> ledger.v1.SetTransactionStatusRequest
+	66,  // 64: ledger.v1.LedgerService.ListStatusChanges:input_type -> ledger.v1.StatusChangesRequest
+	70,  // 65: ledger.v1.LedgerService.CreateRefund:input_type -> ledger.v1.CreateRefundRequest
+	71,  // 66: ledger.v1.LedgerService.ListRefunds:input_type -> ledger.v1.ListRefundsRequest
+	62,  // 67: ledger.v1.LedgerService.CompleteReconciliation:input_type -> ledger.v1.ReconciliationRequest
+	91,  // 68: ledger.v1.LedgerService.GetDuplicateGroups:input_type -> google.protobuf.Empty
+	58,  // 69: ledger.v1.LedgerService.MergeDuplicates:input_type -> ledger.v1.MergeDuplicatesRequest
+	59,  // 70: ledger.v1.LedgerService.DismissDuplicates:input_type -> ledger.v1.DismissDuplicatesRequest
+	2,   // 71: ledger.v1.LedgerService.SuggestCategory:input_type -> ledger.v1.SuggestCategoryRequest
+	15,  // 72: ledger.v1.LedgerService.CreateCategory:input_type -> ledger.v1.CreateCategoryRequest
+	91,  // 73: ledger.v1.LedgerService.ListCategories:input_type -> google.protobuf.Empty
+	16,  // 74: ledger.v1.LedgerService.UpdateCategory:input_type -> ledger.v1.UpdateCategoryRequest
+	17,  // 75: ledger.v1.LedgerService.DeleteCategory:input_type -> ledger.v1.DeleteCategoryRequest
+	18,  // 76: ledger.v1.LedgerService.AddCategoryAlias:input_type -> ledger.v1.AddCategoryAliasRequest
+	19,  // 77: ledger.v1.LedgerService.RenameCategory:input_type -> ledger.v1.RenameCategoryRequest
+	20,  // 78: ledger.v1.LedgerService.MergeCategories:input_type -> ledger.v1.MergeCategoriesRequest
+	22,  // 79: ledger.v1.LedgerService.CreateRecurring:input_type -> ledger.v1.CreateRecurringRequest
+	91,  // 80: ledger.v1.LedgerService.ListRecurring:input_type -> google.protobuf.Empty
+	23,  // 81: ledger.v1.LedgerService.UpdateRecurring:input_type -> ledger.v1.UpdateRecurringRequest
+	24,  // 82: ledger.v1.LedgerService.DeleteRecurring:input_type -> ledger.v1.DeleteRecurringRequest
+	26,  // 83: ledger.v1.LedgerService.CreateRule:input_type -> ledger.v1.Rule
+	91,  // 84: ledger.v1.LedgerService.ListRules:input_type -> google.protobuf.Empty
+	26,  // 85: ledger.v1.LedgerService.UpdateRule:input_type -> ledger.v1.Rule
+	27,  // 86: ledger.v1.LedgerService.DeleteRule:input_type -> ledger.v1.DeleteRuleRequest
+	29,  // 87: ledger.v1.LedgerService.ApplyRules:input_type -> ledger.v1.ApplyRulesRequest
+	33,  // 88: ledger.v1.LedgerService.CreatePayee:input_type -> ledger.v1.CreatePayeeRequest
+	91,  // 89: ledger.v1.LedgerService.ListPayees:input_type -> google.protobuf.Empty
+	39,  // 90: ledger.v1.LedgerService.UploadAttachment:input_type -> ledger.v1.UploadAttachmentRequest
+	40,  // 91: ledger.v1.LedgerService.ListAttachments:input_type -> ledger.v1.ListAttachmentsRequest
+	42,  // 92: ledger.v1.LedgerService.GetAttachment:input_type -> ledger.v1.GetAttachmentRequest
+	74,  // 93: ledger.v1.LedgerService.CreateWebhook:input_type -> ledger.v1.CreateWebhookRequest
+	91,  // 94: ledger.v1.LedgerService.ListWebhooks:input_type -> google.protobuf.Empty
+	76,  // 95: ledger.v1.LedgerService.DeleteWebhook:input_type -> ledger.v1.DeleteWebhookRequest
+	77,  // 96: ledger.v1.LedgerService.ListWebhookDeliveries:input_type -> ledger.v1.WebhookDeliveriesRequest
+	81,  // 97: ledger.v1.LedgerService.SetNotificationRecipient:input_type -> ledger.v1.SetNotificationRecipientRequest
+	91,  // 98: ledger.v1.LedgerService.ListNotificationRecipients:input_type -> google.protobuf.Empty
+	83,  // 99: ledger.v1.LedgerService.DeleteNotificationRecipient:input_type -> ledger.v1.DeleteNotificationRecipientRequest
+	0,   // 100: ledger.v1.LedgerService.AddTransaction:output_type -> ledger.v1.Transaction
+	10,  // 101: ledger.v1.LedgerService.ListTransactions:output_type -> ledger.v1.ListTransactionsResponse
+	6,   // 102: ledger.v1.LedgerService.SetBudget:output_type -> ledger.v1.Budget
+	11,  // 103: ledger.v1.LedgerService.ListBudgets:output_type -> ledger.v1.ListBudgetsResponse
+	13,  // 104: ledger.v1.LedgerService.GetReportSummary:output_type -> ledger.v1.ReportSummaryResponse
+	86,  // 105: ledger.v1.LedgerService.BulkAddTransactions:output_type -> ledger.v1.BulkCreateTransactionsResponse
+	47,  // 106: ledger.v1.LedgerService.GetSpendingSeries:output_type -> ledger.v1.SpendingSeriesResponse
+	51,  // 107: ledger.v1.LedgerService.GetBudgetReport:output_type -> ledger.v1.BudgetReportResponse
+	54,  // 108: ledger.v1.LedgerService.GetForecast:output_type -> ledger.v1.ForecastResponse
+	10,  // 109: ledger.v1.LedgerService.GetAnomalies:output_type -> ledger.v1.ListTransactionsResponse
+	13,  // 110: ledger.v1.LedgerService.GetTagSummary:output_type -> ledger.v1.ReportSummaryResponse
+	37,  // 111: ledger.v1.LedgerService.GetPayeeReport:output_type -> ledger.v1.PayeeReportResponse
+	60,  // 112: ledger.v1.LedgerService.StartReconciliation:output_type -> ledger.v1.Reconciliation
+	63,  // 113: ledger.v1.LedgerService.ListReconciliations:output_type -> ledger.v1.ListReconciliationsResponse
+	60,  // 114: ledger.v1.LedgerService.GetReconciliation:output_type -> ledger.v1.Reconciliation
+	91,  // 115: ledger.v1.LedgerService.SetCleared:output_type -> google.protobuf.Empty
+	91,  // 116: ledger.v1.LedgerService.SetTransactionStatus:output_type -> google.protobuf.Empty
+	68,  // 117: ledger.v1.LedgerService.ListStatusChanges:output_type -> ledger.v1.StatusChangesResponse
+	69,  // 118: ledger.v1.LedgerService.CreateRefund:output_type -> ledger.v1.Refund
+	72,  // 119: ledger.v1.LedgerService.ListRefunds:output_type -> ledger.v1.ListRefundsResponse
+	60,  // 120: ledger.v1.LedgerService.CompleteReconciliation:output_type -> ledger.v1.Reconciliation
+	57,  // 121: ledger.v1.LedgerService.GetDuplicateGroups:output_type -> ledger.v1.DuplicateGroupsResponse
+	91,  // 122: ledger.v1.LedgerService.MergeDuplicates:output_type -> google.protobuf.Empty
+	91,  // 123: ledger.v1.LedgerService.DismissDuplicates:output_type -> google.protobuf.Empty
+	3,   // 124: ledger.v1.LedgerService.SuggestCategory:output_type -> ledger.v1.SuggestCategoryResponse
+	14,  // 125: ledger.v1.LedgerService.CreateCategory:output_type -> ledger.v1.Category
+	44,  // 126: ledger.v1.LedgerService.ListCategories:output_type -> ledger.v1.ListCategoriesResponse
+	14,  // 127: ledger.v1.LedgerService.UpdateCategory:output_type -> ledger.v1.Category
+	91,  // 128: ledger.v1.LedgerService.DeleteCategory:output_type -> google.protobuf.Empty
+	14,  // 129: ledger.v1.LedgerService.AddCategoryAlias:output_type -> ledger.v1.Category
+	14,  // 130: ledger.v1.LedgerService.RenameCategory:output_type -> ledger.v1.Category
+	14,  // 131: ledger.v1.LedgerService.MergeCategories:output_type -> ledger.v1.Category
+	21,  // 132: ledger.v1.LedgerService.CreateRecurring:output_type -> ledger.v1.RecurringTransaction
+	25,  // 133: ledger.v1.LedgerService.ListRecurring:output_type -> ledger.v1.ListRecurringResponse
+	21,  // 134: ledger.v1.LedgerService.UpdateRecurring:output_type -> ledger.v1.RecurringTransaction
+	91,  // 135: ledger.v1.LedgerService.DeleteRecurring:output_type -> google.protobuf.Empty
+	26,  // 136: ledger.v1.LedgerService.CreateRule:output_type -> ledger.v1.Rule
+	28,  // 137: ledger.v1.LedgerService.ListRules:output_type -> ledger.v1.ListRulesResponse
+	26,  // 138: ledger.v1.LedgerService.UpdateRule:output_type -> ledger.v1.Rule
+	91,  // 139: ledger.v1.LedgerService.DeleteRule:output_type -> google.protobuf.Empty
+	31,  // 140: ledger.v1.LedgerService.ApplyRules:output_type -> ledger.v1.ApplyRulesResponse
+	32,  // 141: ledger.v1.LedgerService.CreatePayee:output_type -> ledger.v1.Payee
+	34,  // 142: ledger.v1.LedgerService.ListPayees:output_type -> ledger.v1.ListPayeesResponse
+	38,  // 143: ledger.v1.LedgerService.UploadAttachment:output_type -> ledger.v1.Attachment
+	41,  // 144: ledger.v1.LedgerService.ListAttachments:output_type -> ledger.v1.ListAttachmentsResponse
+	43,  // 145: ledger.v1.LedgerService.GetAttachment:output_type -> ledger.v1.AttachmentContent
+	73,  // 146: ledger.v1.LedgerService.CreateWebhook:output_type -> ledger.v1.Webhook
+	75,  // 147: ledger.v1.LedgerService.ListWebhooks:output_type -> ledger.v1.ListWebhooksResponse
+	91,  // 148: ledger.v1.LedgerService.DeleteWebhook:output_type -> google.protobuf.Empty
+	79,  // 149: ledger.v1.LedgerService.ListWebhookDeliveries:output_type -> ledger.v1.WebhookDeliveriesResponse
+	80,  // 150: ledger.v1.LedgerService.SetNotificationRecipient:output_type -> ledger.v1.NotificationRecipient
+	82,  // 151: ledger.v1.LedgerService.ListNotificationRecipients:output_type -> ledger.v1.ListNotificationRecipientsResponse
+	91,  // 152: ledger.v1.LedgerService.DeleteNotificationRecipient:output_type -> google.protobuf.Empty
+	100, // [100:153] is the sub-list for method output_type
+	47,  // [47:100] is the sub-list for method input_type
+	47,  // [47:47] is the sub-list for extension type_name
+	47,  // [47:47] is the sub-list for extension extendee
+	0,   // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_internal_delivery_protos_ledger_v1_ledger_proto_init() }
@@ -738,7 +6227,7 @@ func file_internal_delivery_protos_ledger_v1_ledger_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_delivery_protos_ledger_v1_ledger_proto_rawDesc), len(file_internal_delivery_protos_ledger_v1_ledger_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   90,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -3,6 +3,7 @@ package server
 import (
 	"errors"
 	"strings"
+	"time"
 
 	ledgerv1 "github.com/lyagu5h/finScope/ledger/internal/delivery/protos/ledger/v1"
 	"github.com/lyagu5h/finScope/ledger/internal/domain"
@@ -14,9 +15,32 @@ import (
 
 func mapError(err error) error {
 	switch {
-	case errors.Is(err, service.ErrBudgetExceeded):
+	case errors.Is(err, service.ErrBudgetExceeded),
+		errors.Is(err, domain.ErrTransactionLocked),
+		errors.Is(err, domain.ErrStatusChange),
+		errors.Is(err, domain.ErrReconciliationCompleted),
+		errors.Is(err, domain.ErrReconciliationUnbalanced),
+		errors.Is(err, domain.ErrRefundExceedsOriginal),
+		errors.Is(err, domain.ErrTransactionVoided):
 		return status.Error(codes.FailedPrecondition, err.Error())
 
+	case errors.Is(err, service.ErrCategoryNotFound),
+		errors.Is(err, service.ErrRecurringNotFound),
+		errors.Is(err, service.ErrAttachmentNotFound),
+		errors.Is(err, service.ErrPayeeNotFound),
+		errors.Is(err, service.ErrRuleNotFound),
+		errors.Is(err, service.ErrReconciliationNotFound),
+		errors.Is(err, service.ErrWebhookNotFound),
+		errors.Is(err, service.ErrRecipientNotFound),
+		errors.Is(err, domain.ErrTransactionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+
+	case errors.Is(err, service.ErrCategoryExists),
+		errors.Is(err, service.ErrPayeeExists),
+		errors.Is(err, service.ErrDuplicate),
+		errors.Is(err, domain.ErrReconciliationOpen):
+		return status.Error(codes.AlreadyExists, err.Error())
+
 	case strings.Contains(err.Error(), "validation failed"): 
 		return status.Error(codes.InvalidArgument, err.Error())
 
@@ -25,13 +49,121 @@ func mapError(err error) error {
 	}
 }
 
+// parsePeriod parses the YYYY-MM-DD bounds of a report request.
+func parsePeriod(from, to string) (time.Time, time.Time, error) {
+	f, err := time.Parse("2006-01-02", from)
+	if err != nil {
+		return time.Time{}, time.Time{}, status.Error(codes.InvalidArgument, "invalid from date")
+	}
+
+	t, err := time.Parse("2006-01-02", to)
+	if err != nil {
+		return time.Time{}, time.Time{}, status.Error(codes.InvalidArgument, "invalid to date")
+	}
+
+	return f, t, nil
+}
+
+// duplicatePolicyFromProto returns "" for an empty policy, which leaves
+// the choice to the ledger.
+func duplicatePolicyFromProto(policy string) (domain.DuplicatePolicy, error) {
+	if policy == "" {
+		return "", nil
+	}
+
+	p, err := domain.ParseDuplicatePolicy(policy)
+	if err != nil {
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return p, nil
+}
+
+func statusesFromProto(statuses []string) ([]domain.TransactionStatus, error) {
+	out := make([]domain.TransactionStatus, 0, len(statuses))
+	for _, s := range statuses {
+		st, err := domain.ParseTransactionStatus(s)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		out = append(out, st)
+	}
+	return out, nil
+}
+
+func idsFromProto(ids []int64) []int {
+	out := make([]int, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, int(id))
+	}
+	return out
+}
+
 func transactionToProto(tx domain.Transaction) *ledgerv1.Transaction {
-	return &ledgerv1.Transaction{
+	out := &ledgerv1.Transaction{
 		Id:          int64(tx.ID),
 		Amount:      tx.Amount,
 		Category:    tx.Category,
 		Description: tx.Description,
 		Date:        timestamppb.New(tx.Date),
+		Tags:        tx.Tags,
+		RecurringId: int64(tx.RecurringID),
+		Splits:      splitsToProto(tx.Splits),
+		PayeeId:     int64(tx.PayeeID),
+		Payee:       tx.Payee,
+		DuplicateOf: int64(tx.DuplicateOf),
+		Account:     tx.Account,
+		Cleared:     tx.Status == domain.StatusCleared || tx.Status == domain.StatusReconciled,
+		Status:      string(tx.Status),
+		Refunded:    tx.Refunded,
+
+		ReconciliationId: int64(tx.ReconciliationID),
+	}
+
+	if !tx.StatusChangedAt.IsZero() {
+		out.StatusChangedAt = timestamppb.New(tx.StatusChangedAt)
+	}
+
+	if tx.Anomaly != nil {
+		out.Anomaly = &ledgerv1.Anomaly{
+			Score:   tx.Anomaly.Score,
+			Median:  tx.Anomaly.Median,
+			Samples: uint32(tx.Anomaly.Samples),
+		}
+	}
+
+	if tx.Suggestion != nil {
+		out.Suggestion = suggestionToProto(*tx.Suggestion)
+	}
+
+	return out
+}
+
+func statusChangeToProto(c domain.StatusChange) *ledgerv1.StatusChange {
+	return &ledgerv1.StatusChange{
+		TransactionId: int64(c.TransactionID),
+		FromStatus:    string(c.From),
+		ToStatus:      string(c.To),
+		ChangedAt:     timestamppb.New(c.ChangedAt),
+	}
+}
+
+func refundToProto(r domain.Refund) *ledgerv1.Refund {
+	return &ledgerv1.Refund{
+		Id:            int64(r.ID),
+		TransactionId: int64(r.TransactionID),
+		Category:      r.Category,
+		Amount:        r.Amount,
+		Description:   r.Description,
+		Date:          timestamppb.New(r.Date),
+		OriginalDate:  timestamppb.New(r.OriginalDate),
+	}
+}
+
+func suggestionToProto(s domain.CategorySuggestion) *ledgerv1.CategorySuggestion {
+	return &ledgerv1.CategorySuggestion{
+		Category:   s.Category,
+		Confidence: s.Confidence,
 	}
 }
 
@@ -40,4 +172,271 @@ func budgetToProto(b domain.Budget) *ledgerv1.Budget {
 		Category: b.Category,
 		Limit:    b.Limit,
 	}
-}
\ No newline at end of file
+}
+
+func seriesToProto(series domain.SpendingSeries) *ledgerv1.SpendingSeriesResponse {
+	buckets := make([]string, 0, len(series.Buckets))
+	for _, b := range series.Buckets {
+		buckets = append(buckets, b.Format("2006-01-02"))
+	}
+
+	out := make([]*ledgerv1.CategorySeries, 0, len(series.Categories))
+	for _, c := range series.Categories {
+		out = append(out, &ledgerv1.CategorySeries{
+			Category: c.Category,
+			Amounts:  c.Amounts,
+		})
+	}
+
+	return &ledgerv1.SpendingSeriesResponse{
+		Granularity: string(series.Granularity),
+		Buckets:     buckets,
+		Series:      out,
+	}
+}
+
+func budgetReportToProto(report domain.BudgetReport) *ledgerv1.BudgetReportResponse {
+	lines := make([]*ledgerv1.BudgetReportLine, 0, len(report.Budgets))
+	for _, l := range report.Budgets {
+		lines = append(lines, &ledgerv1.BudgetReportLine{
+			Category:    l.Category,
+			Limit:       l.Limit,
+			Spent:       l.Spent,
+			Remaining:   l.Remaining,
+			PercentUsed: l.PercentUsed,
+			Status:      string(l.Status),
+		})
+	}
+
+	unbudgeted := make([]*ledgerv1.CategorySpend, 0, len(report.Unbudgeted))
+	for _, c := range report.Unbudgeted {
+		unbudgeted = append(unbudgeted, &ledgerv1.CategorySpend{
+			Category: c.Category,
+			Spent:    c.Spent,
+		})
+	}
+
+	return &ledgerv1.BudgetReportResponse{
+		Budgets:    lines,
+		Unbudgeted: unbudgeted,
+	}
+}
+
+func forecastToProto(f domain.Forecast) *ledgerv1.ForecastResponse {
+	lines := make([]*ledgerv1.ForecastLine, 0, len(f.Lines))
+	for _, l := range f.Lines {
+		lines = append(lines, &ledgerv1.ForecastLine{
+			Category:          l.Category,
+			Spent:             l.Spent,
+			PaceProjection:    l.PaceProjection,
+			HistoryProjection: l.HistoryProjection,
+			HasHistory:        l.HasHistory,
+			RecurringPending:  l.RecurringPending,
+			Projected:         l.Projected,
+			Limit:             l.Limit,
+			HasBudget:         l.HasBudget,
+			OverBudget:        l.OverBudget,
+		})
+	}
+
+	return &ledgerv1.ForecastResponse{
+		Period:      string(f.Period),
+		PeriodStart: f.PeriodStart.Format("2006-01-02"),
+		PeriodEnd:   f.PeriodEnd.Format("2006-01-02"),
+		AsOf:        f.AsOf.Format("2006-01-02"),
+		Lines:       lines,
+	}
+}
+
+func categoryToProto(c domain.Category) *ledgerv1.Category {
+	return &ledgerv1.Category{
+		Id:      int64(c.ID),
+		Name:    c.Name,
+		Parent:  c.Parent,
+		Aliases: c.Aliases,
+	}
+}
+
+func recurringToProto(r domain.RecurringTransaction) *ledgerv1.RecurringTransaction {
+	out := &ledgerv1.RecurringTransaction{
+		Id:          int64(r.ID),
+		Amount:      r.Amount,
+		Category:    r.Category,
+		Description: r.Description,
+		Schedule:    r.Schedule.String(),
+		StartDate:   r.Start.Format("2006-01-02"),
+		LastError:   r.LastError,
+	}
+	if !r.End.IsZero() {
+		out.EndDate = r.End.Format("2006-01-02")
+	}
+	if !r.Next.IsZero() {
+		out.NextDate = r.Next.Format("2006-01-02")
+	}
+	return out
+}
+
+func ruleToProto(r domain.Rule) *ledgerv1.Rule {
+	return &ledgerv1.Rule{
+		Id:                  int64(r.ID),
+		Name:                r.Name,
+		Priority:            int32(r.Priority),
+		DescriptionContains: r.DescriptionContains,
+		DescriptionRegex:    r.DescriptionRegex,
+		MinAmount:           r.MinAmount,
+		MaxAmount:           r.MaxAmount,
+		Payee:               r.Payee,
+		SetCategory:         r.SetCategory,
+		SetTags:             r.SetTags,
+		SetDescription:      r.SetDescription,
+	}
+}
+
+func ruleFromProto(r *ledgerv1.Rule) domain.Rule {
+	return domain.Rule{
+		ID:                  int(r.Id),
+		Name:                r.Name,
+		Priority:            int(r.Priority),
+		DescriptionContains: r.DescriptionContains,
+		DescriptionRegex:    r.DescriptionRegex,
+		MinAmount:           r.MinAmount,
+		MaxAmount:           r.MaxAmount,
+		Payee:               r.Payee,
+		SetCategory:         r.SetCategory,
+		SetTags:             r.SetTags,
+		SetDescription:      r.SetDescription,
+	}
+}
+
+func payeeToProto(p domain.Payee) *ledgerv1.Payee {
+	return &ledgerv1.Payee{
+		Id:   int64(p.ID),
+		Name: p.Name,
+	}
+}
+
+func attachmentToProto(a domain.Attachment) *ledgerv1.Attachment {
+	return &ledgerv1.Attachment{
+		Id:            int64(a.ID),
+		TransactionId: int64(a.TransactionID),
+		Filename:      a.Filename,
+		ContentType:   a.ContentType,
+		Size:          a.Size,
+		CreatedAt:     timestamppb.New(a.CreatedAt),
+	}
+}
+
+// recurringFromProto parses the fields shared by recurring create and
+// update requests.
+func reconciliationToProto(r domain.Reconciliation) *ledgerv1.Reconciliation {
+	out := &ledgerv1.Reconciliation{
+		Id:               int64(r.ID),
+		Account:          r.Account,
+		StatementDate:    r.StatementDate.Format("2006-01-02"),
+		StatementBalance: r.StatementBalance,
+		OpeningBalance:   r.OpeningBalance,
+		ClearedTotal:     r.ClearedTotal,
+		ClearedBalance:   r.ClearedBalance(),
+		Difference:       r.Difference(),
+	}
+	if !r.CompletedAt.IsZero() {
+		out.CompletedAt = timestamppb.New(r.CompletedAt)
+	}
+	for _, tx := range r.Transactions {
+		out.Transactions = append(out.Transactions, transactionToProto(tx))
+	}
+	return out
+}
+
+func recurringFromProto(
+	amount float64,
+	category, description, schedule, start, end string,
+) (domain.RecurringTransaction, error) {
+	r := domain.RecurringTransaction{
+		Amount:      amount,
+		Category:    category,
+		Description: description,
+	}
+
+	s, err := domain.ParseSchedule(schedule)
+	if err != nil {
+		return r, status.Error(codes.InvalidArgument, err.Error())
+	}
+	r.Schedule = s
+
+	if r.Start, err = time.Parse("2006-01-02", start); err != nil {
+		return r, status.Error(codes.InvalidArgument, "invalid start_date")
+	}
+	if end != "" {
+		if r.End, err = time.Parse("2006-01-02", end); err != nil {
+			return r, status.Error(codes.InvalidArgument, "invalid end_date")
+		}
+	}
+
+	return r, nil
+}
+
+func splitsToProto(splits []domain.Split) []*ledgerv1.Split {
+	if len(splits) == 0 {
+		return nil
+	}
+	out := make([]*ledgerv1.Split, 0, len(splits))
+	for _, s := range splits {
+		out = append(out, &ledgerv1.Split{Category: s.Category, Amount: s.Amount})
+	}
+	return out
+}
+
+func splitsFromProto(splits []*ledgerv1.Split) []domain.Split {
+	if len(splits) == 0 {
+		return nil
+	}
+	out := make([]domain.Split, 0, len(splits))
+	for _, s := range splits {
+		out = append(out, domain.Split{Category: s.Category, Amount: s.Amount})
+	}
+	return out
+}
+
+func webhookToProto(s domain.WebhookSubscription) *ledgerv1.Webhook {
+	events := make([]string, 0, len(s.Events))
+	for _, e := range s.Events {
+		events = append(events, string(e))
+	}
+
+	return &ledgerv1.Webhook{
+		Id:        int64(s.ID),
+		Url:       s.URL,
+		Secret:    s.Secret,
+		Events:    events,
+		MinAmount: s.MinAmount,
+		Threshold: s.Threshold,
+		CreatedAt: timestamppb.New(s.CreatedAt),
+	}
+}
+
+func webhookDeliveryToProto(d domain.WebhookDelivery) *ledgerv1.WebhookDelivery {
+	return &ledgerv1.WebhookDelivery{
+		Id:         int64(d.ID),
+		WebhookId:  int64(d.SubscriptionID),
+		EventId:    d.EventID,
+		EventType:  string(d.EventType),
+		Attempt:    uint32(d.Attempt),
+		StatusCode: uint32(d.StatusCode),
+		Error:      d.Error,
+		Succeeded:  d.Succeeded,
+		DurationMs: d.Duration.Milliseconds(),
+		CreatedAt:  timestamppb.New(d.CreatedAt),
+	}
+}
+
+func recipientToProto(r domain.NotificationRecipient) *ledgerv1.NotificationRecipient {
+	return &ledgerv1.NotificationRecipient{
+		Id:           int64(r.ID),
+		Name:         r.Name,
+		Email:        r.Email,
+		BudgetAlerts: r.BudgetAlerts,
+		WeeklyDigest: r.WeeklyDigest,
+		CreatedAt:    timestamppb.New(r.CreatedAt),
+	}
+}
@@ -37,9 +37,26 @@ func (s *Server) AddTransaction(
 		Category:    req.Category,
 		Description: req.Description,
 		Date:        date,
+		Tags:        req.Tags,
+		Splits:      splitsFromProto(req.Splits),
+		PayeeID:     int(req.PayeeId),
+		Payee:       req.Payee,
+		Account:     req.Account,
+		Status:      domain.TransactionStatus(req.Status),
 	}
 
-	res, err := s.svc.AddTransaction(ctx, tx)
+	policy, err := duplicatePolicyFromProto(req.DuplicatePolicy)
+	if err != nil {
+		return nil, err
+	}
+	tx.DuplicatePolicy = policy
+
+	addFn := s.svc.AddTransaction
+	if req.DryRun {
+		addFn = s.svc.DryRunTransaction
+	}
+
+	res, err := addFn(ctx, tx)
 	if err != nil {
 		return nil, mapError(err)
 	}
@@ -49,10 +66,18 @@ func (s *Server) AddTransaction(
 
 func (s *Server) ListTransactions(
 	ctx context.Context,
-	_ *emptypb.Empty,
+	req *ledgerv1.ListTransactionsRequest,
 ) (*ledgerv1.ListTransactionsResponse, error) {
 
-	txs, err := s.svc.ListTransactions(ctx)
+	statuses, err := statusesFromProto(req.Statuses)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := s.svc.ListTransactions(ctx, domain.TransactionFilter{
+		Tags:     req.Tags,
+		Statuses: statuses,
+	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -77,7 +102,8 @@ func (s *Server) SetBudget(
 		Limit:    req.Limit,
 	}
 
-	if err := s.svc.SetBudget(ctx, b); err != nil {
+	b, err := s.svc.SetBudget(ctx, b)
+	if err != nil {
 		return nil, mapError(err)
 	}
 
@@ -119,7 +145,338 @@ func (s *Server) GetReportSummary(
 		return nil, status.Error(codes.InvalidArgument, "invalid to date")
 	}
 
-	summary, err := s.svc.GetReportSummary(ctx, from, to)
+	statuses, err := statusesFromProto(req.Statuses)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := s.svc.GetRollUpSummary(ctx, from, to, statuses)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &ledgerv1.ReportSummaryResponse{
+		Totals: summary.Totals,
+		Rollup: summary.RollUp,
+	}, nil
+}
+
+func (s *Server) GetSpendingSeries(
+	ctx context.Context,
+	req *ledgerv1.SpendingSeriesRequest,
+) (*ledgerv1.SpendingSeriesResponse, error) {
+
+	from, to, err := parsePeriod(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	granularity := domain.GranularityMonth
+	if req.Granularity != "" {
+		granularity, err = domain.ParseGranularity(req.Granularity)
+		if err != nil {
+			return nil, mapError(err)
+		}
+	}
+
+	series, err := s.svc.GetSpendingSeries(ctx, domain.SeriesQuery{
+		From:        from,
+		To:          to,
+		Granularity: granularity,
+		Categories:  req.Categories,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return seriesToProto(series), nil
+}
+
+func (s *Server) GetBudgetReport(
+	ctx context.Context,
+	req *ledgerv1.BudgetReportRequest,
+) (*ledgerv1.BudgetReportResponse, error) {
+
+	from, to, err := parsePeriod(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.svc.GetBudgetReport(ctx, from, to)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return budgetReportToProto(report), nil
+}
+
+func (s *Server) GetForecast(
+	ctx context.Context,
+	req *ledgerv1.ForecastRequest,
+) (*ledgerv1.ForecastResponse, error) {
+
+	q := domain.ForecastQuery{
+		Period: domain.Granularity(req.Period),
+	}
+
+	if req.AsOf != "" {
+		asOf, err := time.Parse("2006-01-02", req.AsOf)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid as_of date")
+		}
+		q.AsOf = asOf
+	}
+
+	forecast, err := s.svc.GetForecast(ctx, q)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return forecastToProto(forecast), nil
+}
+
+func (s *Server) GetAnomalies(
+	ctx context.Context,
+	req *ledgerv1.AnomaliesRequest,
+) (*ledgerv1.ListTransactionsResponse, error) {
+
+	from, to, err := parsePeriod(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := s.svc.GetAnomalies(ctx, from, to)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]*ledgerv1.Transaction, 0, len(txs))
+	for _, tx := range txs {
+		out = append(out, transactionToProto(tx))
+	}
+
+	return &ledgerv1.ListTransactionsResponse{
+		Transactions: out,
+	}, nil
+}
+
+func (s *Server) StartReconciliation(
+	ctx context.Context,
+	req *ledgerv1.StartReconciliationRequest,
+) (*ledgerv1.Reconciliation, error) {
+
+	date, err := time.Parse("2006-01-02", req.StatementDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid statement_date")
+	}
+
+	res, err := s.svc.StartReconciliation(ctx, domain.Reconciliation{
+		Account:          req.Account,
+		StatementDate:    date,
+		StatementBalance: req.StatementBalance,
+		OpeningBalance:   req.OpeningBalance,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return reconciliationToProto(res), nil
+}
+
+func (s *Server) ListReconciliations(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*ledgerv1.ListReconciliationsResponse, error) {
+
+	recs, err := s.svc.ListReconciliations(ctx)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]*ledgerv1.Reconciliation, 0, len(recs))
+	for _, r := range recs {
+		out = append(out, reconciliationToProto(r))
+	}
+
+	return &ledgerv1.ListReconciliationsResponse{
+		Reconciliations: out,
+	}, nil
+}
+
+func (s *Server) GetReconciliation(
+	ctx context.Context,
+	req *ledgerv1.ReconciliationRequest,
+) (*ledgerv1.Reconciliation, error) {
+
+	res, err := s.svc.GetReconciliation(ctx, int(req.Id))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return reconciliationToProto(res), nil
+}
+
+func (s *Server) SetCleared(
+	ctx context.Context,
+	req *ledgerv1.SetClearedRequest,
+) (*emptypb.Empty, error) {
+
+	if err := s.svc.SetCleared(ctx, idsFromProto(req.TransactionIds), req.Cleared); err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) SetTransactionStatus(
+	ctx context.Context,
+	req *ledgerv1.SetTransactionStatusRequest,
+) (*emptypb.Empty, error) {
+
+	st, err := domain.ParseTransactionStatus(req.Status)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.svc.SetTransactionStatus(ctx, idsFromProto(req.TransactionIds), st); err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ListStatusChanges(
+	ctx context.Context,
+	req *ledgerv1.StatusChangesRequest,
+) (*ledgerv1.StatusChangesResponse, error) {
+
+	changes, err := s.svc.ListStatusChanges(ctx, int(req.TransactionId))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]*ledgerv1.StatusChange, 0, len(changes))
+	for _, c := range changes {
+		out = append(out, statusChangeToProto(c))
+	}
+
+	return &ledgerv1.StatusChangesResponse{Changes: out}, nil
+}
+
+func (s *Server) CreateRefund(
+	ctx context.Context,
+	req *ledgerv1.CreateRefundRequest,
+) (*ledgerv1.Refund, error) {
+
+	var date time.Time
+	if req.Date != nil {
+		date = req.Date.AsTime()
+	}
+
+	refund, err := s.svc.CreateRefund(ctx, domain.Refund{
+		TransactionID: int(req.TransactionId),
+		Category:      req.Category,
+		Amount:        req.Amount,
+		Description:   req.Description,
+		Date:          date,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return refundToProto(refund), nil
+}
+
+func (s *Server) ListRefunds(
+	ctx context.Context,
+	req *ledgerv1.ListRefundsRequest,
+) (*ledgerv1.ListRefundsResponse, error) {
+
+	refunds, err := s.svc.ListRefunds(ctx, int(req.TransactionId))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]*ledgerv1.Refund, 0, len(refunds))
+	for _, r := range refunds {
+		out = append(out, refundToProto(r))
+	}
+
+	return &ledgerv1.ListRefundsResponse{Refunds: out}, nil
+}
+
+func (s *Server) CompleteReconciliation(
+	ctx context.Context,
+	req *ledgerv1.ReconciliationRequest,
+) (*ledgerv1.Reconciliation, error) {
+
+	res, err := s.svc.CompleteReconciliation(ctx, int(req.Id))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return reconciliationToProto(res), nil
+}
+
+func (s *Server) GetDuplicateGroups(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*ledgerv1.DuplicateGroupsResponse, error) {
+
+	groups, err := s.svc.GetDuplicateGroups(ctx)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]*ledgerv1.DuplicateGroup, 0, len(groups))
+	for _, g := range groups {
+		txs := make([]*ledgerv1.Transaction, 0, len(g))
+		for _, tx := range g {
+			txs = append(txs, transactionToProto(tx))
+		}
+		out = append(out, &ledgerv1.DuplicateGroup{Transactions: txs})
+	}
+
+	return &ledgerv1.DuplicateGroupsResponse{
+		Groups: out,
+	}, nil
+}
+
+func (s *Server) MergeDuplicates(
+	ctx context.Context,
+	req *ledgerv1.MergeDuplicatesRequest,
+) (*emptypb.Empty, error) {
+
+	if err := s.svc.MergeDuplicates(ctx, int(req.KeepId), idsFromProto(req.DuplicateIds)); err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) DismissDuplicates(
+	ctx context.Context,
+	req *ledgerv1.DismissDuplicatesRequest,
+) (*emptypb.Empty, error) {
+
+	if err := s.svc.DismissDuplicates(ctx, idsFromProto(req.TransactionIds)); err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) GetTagSummary(
+	ctx context.Context,
+	req *ledgerv1.ReportSummaryRequest,
+) (*ledgerv1.ReportSummaryResponse, error) {
+
+	from, to, err := parsePeriod(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := s.svc.GetTagSummary(ctx, from, to)
 	if err != nil {
 		return nil, mapError(err)
 	}
@@ -129,6 +486,36 @@ func (s *Server) GetReportSummary(
 	}, nil
 }
 
+func (s *Server) GetPayeeReport(
+	ctx context.Context,
+	req *ledgerv1.PayeeReportRequest,
+) (*ledgerv1.PayeeReportResponse, error) {
+
+	from, to, err := parsePeriod(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	lines, err := s.svc.GetPayeeReport(ctx, from, to, int(req.Top))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]*ledgerv1.PayeeSpend, 0, len(lines))
+	for _, l := range lines {
+		out = append(out, &ledgerv1.PayeeSpend{
+			PayeeId: int64(l.PayeeID),
+			Payee:   l.Payee,
+			Spent:   l.Spent,
+			Count:   uint32(l.Count),
+		})
+	}
+
+	return &ledgerv1.PayeeReportResponse{
+		Payees: out,
+	}, nil
+}
+
 func (s *Server) BulkAddTransactions(
 	ctx context.Context,
 	req *ledgerv1.BulkCreateTransactionsRequest,
@@ -146,6 +533,11 @@ func (s *Server) BulkAddTransactions(
 		workers = runtime.NumCPU()
 	}
 
+	policy, err := duplicatePolicyFromProto(req.DuplicatePolicy)
+	if err != nil {
+		return nil, err
+	}
+
 	txs := make([]domain.Transaction, 0, len(req.Transactions))
 	for _, t := range req.Transactions {
 		var date time.Time
@@ -157,10 +549,23 @@ func (s *Server) BulkAddTransactions(
 			Category:    t.Category,
 			Description: t.Description,
 			Date:        date,
+			Tags:        t.Tags,
+			Splits:      splitsFromProto(t.Splits),
+			PayeeID:     int(t.PayeeId),
+			Payee:       t.Payee,
+			Account:     t.Account,
+			Status:      domain.TransactionStatus(t.Status),
+
+			DuplicatePolicy: policy,
 		})
 	}
 
-	result, err := s.svc.ImportTransactions(ctx, txs, workers)
+	var result service.BulkImportResult
+	if req.DryRun {
+		result, err = s.svc.DryRunImport(ctx, txs)
+	} else {
+		result, err = s.svc.ImportTransactions(ctx, txs, workers)
+	}
 	if err != nil {
 		return nil, mapError(err)
 	}
@@ -174,8 +579,513 @@ func (s *Server) BulkAddTransactions(
 	}
 
 	return &ledgerv1.BulkCreateTransactionsResponse{
-		Accepted: uint32(result.Accepted),
-		Rejected: uint32(result.Rejected),
-		Errors:   errs,
+		Accepted:      uint32(result.Accepted),
+		Rejected:      uint32(result.Rejected),
+		Errors:        errs,
+		CategorySpend: result.CategorySpend,
+	}, nil
+}
+
+func (s *Server) CreateCategory(
+	ctx context.Context,
+	req *ledgerv1.CreateCategoryRequest,
+) (*ledgerv1.Category, error) {
+
+	c, err := s.svc.CreateCategory(ctx, domain.Category{
+		Name:   req.Name,
+		Parent: req.Parent,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return categoryToProto(c), nil
+}
+
+func (s *Server) ListCategories(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*ledgerv1.ListCategoriesResponse, error) {
+
+	categories, err := s.svc.ListCategories(ctx)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]*ledgerv1.Category, 0, len(categories))
+	for _, c := range categories {
+		out = append(out, categoryToProto(c))
+	}
+
+	return &ledgerv1.ListCategoriesResponse{
+		Categories: out,
+	}, nil
+}
+
+func (s *Server) UpdateCategory(
+	ctx context.Context,
+	req *ledgerv1.UpdateCategoryRequest,
+) (*ledgerv1.Category, error) {
+
+	c, err := s.svc.UpdateCategory(ctx, domain.Category{
+		Name:   req.Name,
+		Parent: req.Parent,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return categoryToProto(c), nil
+}
+
+func (s *Server) DeleteCategory(
+	ctx context.Context,
+	req *ledgerv1.DeleteCategoryRequest,
+) (*emptypb.Empty, error) {
+
+	if err := s.svc.DeleteCategory(ctx, req.Name); err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) AddCategoryAlias(
+	ctx context.Context,
+	req *ledgerv1.AddCategoryAliasRequest,
+) (*ledgerv1.Category, error) {
+
+	c, err := s.svc.AddCategoryAlias(ctx, req.Alias, req.Category)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return categoryToProto(c), nil
+}
+
+func (s *Server) RenameCategory(
+	ctx context.Context,
+	req *ledgerv1.RenameCategoryRequest,
+) (*ledgerv1.Category, error) {
+
+	c, err := s.svc.RenameCategory(ctx, req.From, req.To)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return categoryToProto(c), nil
+}
+
+func (s *Server) MergeCategories(
+	ctx context.Context,
+	req *ledgerv1.MergeCategoriesRequest,
+) (*ledgerv1.Category, error) {
+
+	c, err := s.svc.MergeCategories(ctx, req.Sources, req.Target)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return categoryToProto(c), nil
+}
+
+func (s *Server) CreateRecurring(
+	ctx context.Context,
+	req *ledgerv1.CreateRecurringRequest,
+) (*ledgerv1.RecurringTransaction, error) {
+
+	r, err := recurringFromProto(
+		req.Amount, req.Category, req.Description,
+		req.Schedule, req.StartDate, req.EndDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err = s.svc.CreateRecurring(ctx, r)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return recurringToProto(r), nil
+}
+
+func (s *Server) ListRecurring(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*ledgerv1.ListRecurringResponse, error) {
+
+	recurring, err := s.svc.ListRecurring(ctx)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]*ledgerv1.RecurringTransaction, 0, len(recurring))
+	for _, r := range recurring {
+		out = append(out, recurringToProto(r))
+	}
+
+	return &ledgerv1.ListRecurringResponse{
+		Recurring: out,
 	}, nil
 }
+
+func (s *Server) UpdateRecurring(
+	ctx context.Context,
+	req *ledgerv1.UpdateRecurringRequest,
+) (*ledgerv1.RecurringTransaction, error) {
+
+	r, err := recurringFromProto(
+		req.Amount, req.Category, req.Description,
+		req.Schedule, req.StartDate, req.EndDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+	r.ID = int(req.Id)
+
+	r, err = s.svc.UpdateRecurring(ctx, r)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return recurringToProto(r), nil
+}
+
+func (s *Server) DeleteRecurring(
+	ctx context.Context,
+	req *ledgerv1.DeleteRecurringRequest,
+) (*emptypb.Empty, error) {
+
+	if err := s.svc.DeleteRecurring(ctx, int(req.Id)); err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) SuggestCategory(
+	ctx context.Context,
+	req *ledgerv1.SuggestCategoryRequest,
+) (*ledgerv1.SuggestCategoryResponse, error) {
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = 3
+	}
+
+	suggestions, err := s.svc.SuggestCategory(ctx, domain.Transaction{
+		Description: req.Description,
+		Amount:      req.Amount,
+		Payee:       req.Payee,
+	}, limit)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]*ledgerv1.CategorySuggestion, 0, len(suggestions))
+	for _, sg := range suggestions {
+		out = append(out, suggestionToProto(sg))
+	}
+
+	return &ledgerv1.SuggestCategoryResponse{
+		Suggestions: out,
+	}, nil
+}
+
+func (s *Server) CreateRule(
+	ctx context.Context,
+	req *ledgerv1.Rule,
+) (*ledgerv1.Rule, error) {
+
+	r := ruleFromProto(req)
+	r.ID = 0
+
+	r, err := s.svc.CreateRule(ctx, r)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return ruleToProto(r), nil
+}
+
+func (s *Server) ListRules(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*ledgerv1.ListRulesResponse, error) {
+
+	rules, err := s.svc.ListRules(ctx)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]*ledgerv1.Rule, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, ruleToProto(r))
+	}
+
+	return &ledgerv1.ListRulesResponse{
+		Rules: out,
+	}, nil
+}
+
+func (s *Server) UpdateRule(
+	ctx context.Context,
+	req *ledgerv1.Rule,
+) (*ledgerv1.Rule, error) {
+
+	r, err := s.svc.UpdateRule(ctx, ruleFromProto(req))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return ruleToProto(r), nil
+}
+
+func (s *Server) DeleteRule(
+	ctx context.Context,
+	req *ledgerv1.DeleteRuleRequest,
+) (*emptypb.Empty, error) {
+
+	if err := s.svc.DeleteRule(ctx, int(req.Id)); err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ApplyRules(
+	ctx context.Context,
+	req *ledgerv1.ApplyRulesRequest,
+) (*ledgerv1.ApplyRulesResponse, error) {
+
+	changes, err := s.svc.ApplyRules(ctx, req.DryRun)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]*ledgerv1.RuleChange, 0, len(changes))
+	for _, c := range changes {
+		ids := make([]int64, 0, len(c.RuleIDs))
+		for _, id := range c.RuleIDs {
+			ids = append(ids, int64(id))
+		}
+		out = append(out, &ledgerv1.RuleChange{
+			TransactionId: int64(c.TransactionID),
+			RuleIds:       ids,
+			Before:        transactionToProto(c.Before),
+			After:         transactionToProto(c.After),
+			CategoryKept:  c.CategoryKept,
+		})
+	}
+
+	return &ledgerv1.ApplyRulesResponse{
+		Changes: out,
+	}, nil
+}
+
+func (s *Server) CreatePayee(
+	ctx context.Context,
+	req *ledgerv1.CreatePayeeRequest,
+) (*ledgerv1.Payee, error) {
+
+	p, err := s.svc.CreatePayee(ctx, domain.Payee{Name: req.Name})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return payeeToProto(p), nil
+}
+
+func (s *Server) ListPayees(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*ledgerv1.ListPayeesResponse, error) {
+
+	payees, err := s.svc.ListPayees(ctx)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]*ledgerv1.Payee, 0, len(payees))
+	for _, p := range payees {
+		out = append(out, payeeToProto(p))
+	}
+
+	return &ledgerv1.ListPayeesResponse{
+		Payees: out,
+	}, nil
+}
+
+func (s *Server) UploadAttachment(
+	ctx context.Context,
+	req *ledgerv1.UploadAttachmentRequest,
+) (*ledgerv1.Attachment, error) {
+
+	a, err := s.svc.AddAttachment(ctx, domain.Attachment{
+		TransactionID: int(req.TransactionId),
+		Filename:      req.Filename,
+		ContentType:   req.ContentType,
+	}, req.Data)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return attachmentToProto(a), nil
+}
+
+func (s *Server) ListAttachments(
+	ctx context.Context,
+	req *ledgerv1.ListAttachmentsRequest,
+) (*ledgerv1.ListAttachmentsResponse, error) {
+
+	attachments, err := s.svc.ListAttachments(ctx, int(req.TransactionId))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]*ledgerv1.Attachment, 0, len(attachments))
+	for _, a := range attachments {
+		out = append(out, attachmentToProto(a))
+	}
+
+	return &ledgerv1.ListAttachmentsResponse{
+		Attachments: out,
+	}, nil
+}
+
+func (s *Server) GetAttachment(
+	ctx context.Context,
+	req *ledgerv1.GetAttachmentRequest,
+) (*ledgerv1.AttachmentContent, error) {
+
+	a, data, err := s.svc.GetAttachment(ctx, int(req.Id))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &ledgerv1.AttachmentContent{
+		Attachment: attachmentToProto(a),
+		Data:       data,
+	}, nil
+}
+
+func (s *Server) CreateWebhook(
+	ctx context.Context,
+	req *ledgerv1.CreateWebhookRequest,
+) (*ledgerv1.Webhook, error) {
+
+	events := make([]domain.EventType, 0, len(req.Events))
+	for _, e := range req.Events {
+		events = append(events, domain.EventType(e))
+	}
+
+	hook, err := s.svc.CreateWebhook(ctx, domain.WebhookSubscription{
+		URL:       req.Url,
+		Secret:    req.Secret,
+		Events:    events,
+		MinAmount: req.MinAmount,
+		Threshold: req.Threshold,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return webhookToProto(hook), nil
+}
+
+func (s *Server) ListWebhooks(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*ledgerv1.ListWebhooksResponse, error) {
+
+	hooks, err := s.svc.ListWebhooks(ctx)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]*ledgerv1.Webhook, 0, len(hooks))
+	for _, h := range hooks {
+		out = append(out, webhookToProto(h))
+	}
+
+	return &ledgerv1.ListWebhooksResponse{Webhooks: out}, nil
+}
+
+func (s *Server) DeleteWebhook(
+	ctx context.Context,
+	req *ledgerv1.DeleteWebhookRequest,
+) (*emptypb.Empty, error) {
+
+	if err := s.svc.DeleteWebhook(ctx, int(req.Id)); err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ListWebhookDeliveries(
+	ctx context.Context,
+	req *ledgerv1.WebhookDeliveriesRequest,
+) (*ledgerv1.WebhookDeliveriesResponse, error) {
+
+	deliveries, err := s.svc.ListWebhookDeliveries(ctx, int(req.WebhookId), int(req.Limit))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]*ledgerv1.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		out = append(out, webhookDeliveryToProto(d))
+	}
+
+	return &ledgerv1.WebhookDeliveriesResponse{Deliveries: out}, nil
+}
+
+func (s *Server) SetNotificationRecipient(
+	ctx context.Context,
+	req *ledgerv1.SetNotificationRecipientRequest,
+) (*ledgerv1.NotificationRecipient, error) {
+
+	r, err := s.svc.SetNotificationRecipient(ctx, domain.NotificationRecipient{
+		Name:         req.Name,
+		Email:        req.Email,
+		BudgetAlerts: req.BudgetAlerts,
+		WeeklyDigest: req.WeeklyDigest,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return recipientToProto(r), nil
+}
+
+func (s *Server) ListNotificationRecipients(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*ledgerv1.ListNotificationRecipientsResponse, error) {
+
+	recipients, err := s.svc.ListNotificationRecipients(ctx)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]*ledgerv1.NotificationRecipient, 0, len(recipients))
+	for _, r := range recipients {
+		out = append(out, recipientToProto(r))
+	}
+
+	return &ledgerv1.ListNotificationRecipientsResponse{Recipients: out}, nil
+}
+
+func (s *Server) DeleteNotificationRecipient(
+	ctx context.Context,
+	req *ledgerv1.DeleteNotificationRecipientRequest,
+) (*emptypb.Empty, error) {
+
+	if err := s.svc.DeleteNotificationRecipient(ctx, int(req.Id)); err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
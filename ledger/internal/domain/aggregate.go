@@ -0,0 +1,12 @@
+package domain
+
+import "time"
+
+// AggregateMismatch is a day and category where the stored daily total
+// differs from the sum of the transactions.
+type AggregateMismatch struct {
+	Day      time.Time
+	Category string
+	Stored   float64
+	Actual   float64
+}
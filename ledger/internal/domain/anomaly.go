@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"math"
+	"sort"
+)
+
+// Anomaly marks a transaction whose amount is far from the usual amounts
+// of its category.
+type Anomaly struct {
+	// Score is the robust z-score of the amount: how many (MAD-estimated)
+	// standard deviations it is away from the median.
+	Score   float64
+	Median  float64
+	Samples int
+}
+
+// AnomalyDetector scores amounts against a category's history with the
+// modified z-score of Iglewicz and Hoaglin.
+type AnomalyDetector struct {
+	// Threshold is the absolute score above which an amount is anomalous.
+	Threshold float64
+	// MinSamples is the history size below which nothing is flagged.
+	MinSamples int
+	// MinSpread is the smallest spread, as a fraction of the median, the
+	// score is computed with. It keeps categories with near-constant
+	// amounts (a subscription, the same coffee) from flagging every cent.
+	MinSpread float64
+}
+
+func DefaultAnomalyDetector() AnomalyDetector {
+	return AnomalyDetector{
+		Threshold:  3.5,
+		MinSamples: 5,
+		MinSpread:  0.1,
+	}
+}
+
+// Check scores amount against history and returns nil when it is normal
+// or there is not enough history to tell.
+func (d AnomalyDetector) Check(amount float64, history []float64) *Anomaly {
+	if len(history) < d.MinSamples {
+		return nil
+	}
+
+	median := Median(history)
+
+	deviations := make([]float64, len(history))
+	for i, v := range history {
+		deviations[i] = math.Abs(v - median)
+	}
+
+	// 1.4826 * MAD estimates the standard deviation of normal data.
+	spread := 1.4826 * Median(deviations)
+	if floor := d.MinSpread * math.Abs(median); spread < floor {
+		spread = floor
+	}
+	if spread == 0 {
+		return nil
+	}
+
+	score := (amount - median) / spread
+	if math.Abs(score) <= d.Threshold {
+		return nil
+	}
+
+	return &Anomaly{
+		Score:   score,
+		Median:  median,
+		Samples: len(history),
+	}
+}
+
+func Median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return (sorted[mid-1] + sorted[mid]) / 2
+}
@@ -0,0 +1,55 @@
+package domain
+
+import "testing"
+
+func TestAnomalyDetector_Check(t *testing.T) {
+	coffee := []float64{3.5, 3.8, 3.5, 4.2, 3.9, 3.6, 4.0}
+
+	tests := []struct {
+		name        string
+		amount      float64
+		history     []float64
+		wantAnomaly bool
+	}{
+		{
+			name:        "usual amount",
+			amount:      3.7,
+			history:     coffee,
+			wantAnomaly: false,
+		},
+		{
+			name:        "ten times the usual",
+			amount:      38,
+			history:     coffee,
+			wantAnomaly: true,
+		},
+		{
+			name:        "not enough history",
+			amount:      38,
+			history:     coffee[:3],
+			wantAnomaly: false,
+		},
+		{
+			name:        "constant history tolerates small changes",
+			amount:      10.5,
+			history:     []float64{9.99, 9.99, 9.99, 9.99, 9.99},
+			wantAnomaly: false,
+		},
+		{
+			name:        "constant history flags large changes",
+			amount:      99.9,
+			history:     []float64{9.99, 9.99, 9.99, 9.99, 9.99},
+			wantAnomaly: true,
+		},
+	}
+
+	d := DefaultAnomalyDetector()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := d.Check(tt.amount, tt.history)
+			if (got != nil) != tt.wantAnomaly {
+				t.Fatalf("expected anomaly=%v, got %+v", tt.wantAnomaly, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrTransactionNotFound is returned when an attachment is added to a
+// transaction that does not exist.
+var ErrTransactionNotFound = errors.New("transaction not found")
+
+// MaxAttachmentSize is the largest receipt the ledger stores, in bytes.
+const MaxAttachmentSize = 10 << 20
+
+// AttachmentTypes are the MIME types receipts can be stored as.
+var AttachmentTypes = []string{
+	"application/pdf",
+	"image/jpeg",
+	"image/png",
+	"image/webp",
+}
+
+// Attachment describes a file stored with a transaction. The content
+// lives in a blob store under Key.
+type Attachment struct {
+	ID            int
+	TransactionID int
+	Filename      string
+	ContentType   string
+	Size          int64
+	Key           string
+	CreatedAt     time.Time
+}
+
+func (a Attachment) Validate() error {
+	if a.TransactionID <= 0 {
+		return errors.New("validation failed: attachment transaction id should be > 0")
+	}
+	if strings.TrimSpace(a.Filename) == "" {
+		return errors.New("validation failed: attachment filename cannot be empty")
+	}
+	if a.Size <= 0 {
+		return errors.New("validation failed: attachment is empty")
+	}
+	if a.Size > MaxAttachmentSize {
+		return fmt.Errorf("validation failed: attachment is larger than %d bytes", MaxAttachmentSize)
+	}
+	if !AllowedAttachmentType(a.ContentType) {
+		return fmt.Errorf("validation failed: attachment type %q is not allowed", a.ContentType)
+	}
+
+	return nil
+}
+
+// AllowedAttachmentType reports whether contentType, without parameters,
+// is one of AttachmentTypes.
+func AllowedAttachmentType(contentType string) bool {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+
+	for _, t := range AttachmentTypes {
+		if mediaType == t {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,53 @@
+package domain
+
+import "time"
+
+type BudgetStatus string
+
+const (
+	BudgetStatusUnder BudgetStatus = "under"
+	BudgetStatusOver  BudgetStatus = "over"
+)
+
+// BudgetLine compares one budget with the spend of its category.
+type BudgetLine struct {
+	Category    string
+	Limit       float64
+	Spent       float64
+	Remaining   float64
+	PercentUsed float64
+	Status      BudgetStatus
+}
+
+// NewBudgetLine uses the same rule as AddTransaction: spending exactly the
+// limit is still within budget.
+func NewBudgetLine(b Budget, spent float64) BudgetLine {
+	line := BudgetLine{
+		Category:  b.Category,
+		Limit:     b.Limit,
+		Spent:     spent,
+		Remaining: b.Limit - spent,
+		Status:    BudgetStatusUnder,
+	}
+
+	if b.Limit > 0 {
+		line.PercentUsed = spent / b.Limit * 100
+	}
+	if spent > b.Limit {
+		line.Status = BudgetStatusOver
+	}
+
+	return line
+}
+
+type CategorySpend struct {
+	Category string
+	Spent    float64
+}
+
+type BudgetReport struct {
+	From       time.Time
+	To         time.Time
+	Budgets    []BudgetLine
+	Unbudgeted []CategorySpend
+}
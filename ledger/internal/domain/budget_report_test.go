@@ -0,0 +1,45 @@
+package domain
+
+import "testing"
+
+func TestNewBudgetLine(t *testing.T) {
+	tests := []struct {
+		name  string
+		spent float64
+		want  BudgetLine
+	}{
+		{
+			name:  "under budget",
+			spent: 25,
+			want: BudgetLine{
+				Category: "food", Limit: 100, Spent: 25,
+				Remaining: 75, PercentUsed: 25, Status: BudgetStatusUnder,
+			},
+		},
+		{
+			name:  "exactly at limit",
+			spent: 100,
+			want: BudgetLine{
+				Category: "food", Limit: 100, Spent: 100,
+				Remaining: 0, PercentUsed: 100, Status: BudgetStatusUnder,
+			},
+		},
+		{
+			name:  "over budget",
+			spent: 150,
+			want: BudgetLine{
+				Category: "food", Limit: 100, Spent: 150,
+				Remaining: -50, PercentUsed: 150, Status: BudgetStatusOver,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewBudgetLine(Budget{Category: "food", Limit: 100}, tt.spent)
+			if got != tt.want {
+				t.Fatalf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+// Category is a node of the category tree. Transactions and budgets refer
+// to categories by their canonical name; Parent is empty for top-level
+// categories. Aliases are other, lower-cased names that resolve to it.
+type Category struct {
+	ID      int
+	Name    string
+	Parent  string
+	Aliases []string
+}
+
+// NormalizeCategory trims a category name and collapses inner runs of
+// whitespace. Case is kept: it is resolved against the canonical names.
+func NormalizeCategory(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+func (c Category) Validate() error {
+	if c.Name == "" {
+		return errors.New("validation failed: category name cannot be empty")
+	}
+	if c.Parent == c.Name {
+		return errors.New("validation failed: category cannot be its own parent")
+	}
+	return nil
+}
+
+// CategoryTree answers ancestry questions about a set of categories.
+// Names that are not in the tree behave as top-level leaves.
+type CategoryTree struct {
+	parent   map[string]string
+	children map[string][]string
+}
+
+func NewCategoryTree(categories []Category) CategoryTree {
+	t := CategoryTree{
+		parent:   make(map[string]string, len(categories)),
+		children: make(map[string][]string),
+	}
+
+	for _, c := range categories {
+		if c.Parent == "" {
+			continue
+		}
+		t.parent[c.Name] = c.Parent
+		t.children[c.Parent] = append(t.children[c.Parent], c.Name)
+	}
+
+	for _, names := range t.children {
+		sort.Strings(names)
+	}
+
+	return t
+}
+
+// Ancestors returns the parents of name, nearest first.
+func (t CategoryTree) Ancestors(name string) []string {
+	var res []string
+	seen := map[string]bool{name: true}
+	for p, ok := t.parent[name]; ok && !seen[p]; p, ok = t.parent[p] {
+		seen[p] = true
+		res = append(res, p)
+	}
+	return res
+}
+
+// Subtree returns name followed by all of its descendants.
+func (t CategoryTree) Subtree(name string) []string {
+	res := []string{name}
+	seen := map[string]bool{name: true}
+	for i := 0; i < len(res); i++ {
+		for _, child := range t.children[res[i]] {
+			if !seen[child] {
+				seen[child] = true
+				res = append(res, child)
+			}
+		}
+	}
+	return res
+}
+
+func (t CategoryTree) HasChildren(name string) bool {
+	return len(t.children[name]) > 0
+}
+
+// RollUp adds the total of every category to all of its ancestors. The
+// result has an entry for every category with spend in its subtree.
+func (t CategoryTree) RollUp(totals map[string]float64) map[string]float64 {
+	res := make(map[string]float64, len(totals))
+	for name, sum := range totals {
+		res[name] += sum
+		for _, a := range t.Ancestors(name) {
+			res[a] += sum
+		}
+	}
+	return res
+}
+
+// ReportSummary holds the per-category totals of a period twice: as
+// booked, and rolled up the category tree.
+type ReportSummary struct {
+	Totals map[string]float64
+	RollUp map[string]float64
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func testTree() CategoryTree {
+	return NewCategoryTree([]Category{
+		{Name: "Food"},
+		{Name: "Food/Groceries", Parent: "Food"},
+		{Name: "Food/Restaurants", Parent: "Food"},
+		{Name: "Food/Restaurants/Coffee", Parent: "Food/Restaurants"},
+		{Name: "Transport"},
+	})
+}
+
+func TestCategoryTree_Ancestors(t *testing.T) {
+	got := testTree().Ancestors("Food/Restaurants/Coffee")
+	want := []string{"Food/Restaurants", "Food"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestCategoryTree_Subtree(t *testing.T) {
+	got := testTree().Subtree("Food")
+	want := []string{"Food", "Food/Groceries", "Food/Restaurants", "Food/Restaurants/Coffee"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestCategoryTree_RollUp(t *testing.T) {
+	got := testTree().RollUp(map[string]float64{
+		"Food/Groceries":          100,
+		"Food/Restaurants/Coffee": 20,
+		"Food":                    5,
+		"Gifts":                   30,
+	})
+	want := map[string]float64{
+		"Food":                    125,
+		"Food/Groceries":          100,
+		"Food/Restaurants":        20,
+		"Food/Restaurants/Coffee": 20,
+		"Gifts":                   30,
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestNormalizeCategory(t *testing.T) {
+	if got := NormalizeCategory("  Eating   Out "); got != "Eating Out" {
+		t.Fatalf("expected %q, got %q", "Eating Out", got)
+	}
+}
@@ -0,0 +1,149 @@
+package domain
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// CategorySuggestion is a category the classifier proposes for a
+// transaction. Confidence is its posterior probability, from 0 to 1.
+type CategorySuggestion struct {
+	Category   string
+	Confidence float64
+}
+
+// Classifier is a multinomial naive Bayes model that learns categories
+// from description words, the payee and the order of magnitude of the
+// amount. It is safe for concurrent use and learns one transaction at a
+// time, so it can be kept up to date as transactions are added.
+type Classifier struct {
+	mu sync.RWMutex
+
+	docs        int
+	classDocs   map[string]int
+	tokenCounts map[string]map[string]int
+	classTokens map[string]int
+	vocabulary  map[string]struct{}
+}
+
+func NewClassifier() *Classifier {
+	return &Classifier{
+		classDocs:   make(map[string]int),
+		tokenCounts: make(map[string]map[string]int),
+		classTokens: make(map[string]int),
+		vocabulary:  make(map[string]struct{}),
+	}
+}
+
+// Learn adds tx, filed under its category, to the model.
+func (c *Classifier) Learn(tx Transaction) {
+	if tx.Category == "" {
+		return
+	}
+	tokens := classifierTokens(tx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.docs++
+	c.classDocs[tx.Category]++
+
+	counts, ok := c.tokenCounts[tx.Category]
+	if !ok {
+		counts = make(map[string]int)
+		c.tokenCounts[tx.Category] = counts
+	}
+	for _, t := range tokens {
+		counts[t]++
+		c.classTokens[tx.Category]++
+		c.vocabulary[t] = struct{}{}
+	}
+}
+
+// Suggest returns up to n categories for tx, most likely first. It
+// returns nothing when the model has not seen any of tx's tokens.
+func (c *Classifier) Suggest(tx Transaction, n int) []CategorySuggestion {
+	tokens := classifierTokens(tx)
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	known := tokens[:0:0]
+	for _, t := range tokens {
+		if _, ok := c.vocabulary[t]; ok {
+			known = append(known, t)
+		}
+	}
+	if len(known) == 0 || c.docs == 0 {
+		return nil
+	}
+
+	// Log-probabilities with Laplace smoothing.
+	vocab := float64(len(c.vocabulary))
+	scores := make([]CategorySuggestion, 0, len(c.classDocs))
+	for class, docs := range c.classDocs {
+		score := math.Log(float64(docs) / float64(c.docs))
+		denominator := float64(c.classTokens[class]) + vocab
+		for _, t := range known {
+			score += math.Log((float64(c.tokenCounts[class][t]) + 1) / denominator)
+		}
+		scores = append(scores, CategorySuggestion{Category: class, Confidence: score})
+	}
+
+	// Softmax turns the log scores into probabilities.
+	maxScore := math.Inf(-1)
+	for _, s := range scores {
+		maxScore = math.Max(maxScore, s.Confidence)
+	}
+	var sum float64
+	for i := range scores {
+		scores[i].Confidence = math.Exp(scores[i].Confidence - maxScore)
+		sum += scores[i].Confidence
+	}
+	for i := range scores {
+		scores[i].Confidence /= sum
+	}
+
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Confidence != scores[j].Confidence {
+			return scores[i].Confidence > scores[j].Confidence
+		}
+		return scores[i].Category < scores[j].Category
+	})
+	if n > 0 && len(scores) > n {
+		scores = scores[:n]
+	}
+
+	return scores
+}
+
+// classifierTokens returns the lower-cased words of the description, the
+// payee and a power-of-two amount bucket. Numbers and one-letter words
+// are dropped: they are mostly store numbers and dates.
+func classifierTokens(tx Transaction) []string {
+	words := strings.FieldsFunc(strings.ToLower(tx.Description), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	tokens := make([]string, 0, len(words)+2)
+	for _, w := range words {
+		if len([]rune(w)) < 2 || !strings.ContainsFunc(w, unicode.IsLetter) {
+			continue
+		}
+		tokens = append(tokens, w)
+	}
+
+	if tx.Payee != "" {
+		tokens = append(tokens, "payee:"+strings.ToLower(tx.Payee))
+	}
+	if tx.Amount > 0 {
+		bucket := int(math.Floor(math.Log2(tx.Amount)))
+		tokens = append(tokens, "amount:"+strconv.Itoa(bucket))
+	}
+
+	return tokens
+}
@@ -0,0 +1,49 @@
+package domain
+
+import "testing"
+
+func TestClassifier_Suggest(t *testing.T) {
+	c := NewClassifier()
+
+	for _, tx := range []Transaction{
+		{Amount: 4.5, Category: "coffee", Description: "Starbucks latte"},
+		{Amount: 3.9, Category: "coffee", Description: "Starbucks #12 cappuccino"},
+		{Amount: 5.1, Category: "coffee", Description: "Blue Bottle latte"},
+		{Amount: 54, Category: "groceries", Description: "Lidl weekly shop"},
+		{Amount: 61, Category: "groceries", Description: "Lidl shop"},
+		{Amount: 23, Category: "transport", Description: "Uber ride"},
+	} {
+		c.Learn(tx)
+	}
+
+	got := c.Suggest(Transaction{Amount: 4.2, Description: "STARBUCKS 0042"}, 2)
+	if len(got) != 2 {
+		t.Fatalf("expected 2 suggestions, got %+v", got)
+	}
+	if got[0].Category != "coffee" || got[0].Confidence < 0.8 {
+		t.Fatalf("expected a confident coffee suggestion, got %+v", got[0])
+	}
+	if got[0].Confidence < got[1].Confidence {
+		t.Fatalf("suggestions are not ordered: %+v", got)
+	}
+
+	if got := c.Suggest(Transaction{Description: "zzz unknown"}, 3); len(got) != 0 {
+		t.Fatalf("expected no suggestion for unseen words, got %+v", got)
+	}
+}
+
+func TestClassifier_LearnsIncrementally(t *testing.T) {
+	c := NewClassifier()
+	c.Learn(Transaction{Amount: 10, Category: "books", Description: "Waterstones"})
+
+	if got := c.Suggest(Transaction{Description: "netflix"}, 1); len(got) != 0 {
+		t.Fatalf("expected no suggestion before learning, got %+v", got)
+	}
+
+	c.Learn(Transaction{Amount: 12, Category: "subscriptions", Description: "Netflix"})
+
+	got := c.Suggest(Transaction{Description: "netflix"}, 1)
+	if len(got) != 1 || got[0].Category != "subscriptions" {
+		t.Fatalf("expected subscriptions, got %+v", got)
+	}
+}
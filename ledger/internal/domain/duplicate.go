@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// DuplicatePolicy says what happens to a new transaction that looks like
+// one already in the ledger.
+type DuplicatePolicy string
+
+const (
+	// DuplicateReject refuses the transaction.
+	DuplicateReject DuplicatePolicy = "reject"
+	// DuplicateFlag stores it with DuplicateOf set.
+	DuplicateFlag DuplicatePolicy = "flag"
+	// DuplicateAllow stores it without checking.
+	DuplicateAllow DuplicatePolicy = "allow"
+)
+
+func ParseDuplicatePolicy(s string) (DuplicatePolicy, error) {
+	switch p := DuplicatePolicy(strings.ToLower(strings.TrimSpace(s))); p {
+	case DuplicateReject, DuplicateFlag, DuplicateAllow:
+		return p, nil
+	default:
+		return "", fmt.Errorf("validation failed: unknown duplicate policy %q", s)
+	}
+}
+
+// DuplicateDetector decides whether two transactions are the same one
+// recorded twice, e.g. from overlapping bank exports: same amount, dates
+// at most WindowDays apart and similar descriptions.
+type DuplicateDetector struct {
+	Policy     DuplicatePolicy
+	WindowDays int
+	// MinSimilarity is the share of description words, from 0 to 1, two
+	// transactions must have in common.
+	MinSimilarity float64
+}
+
+func DefaultDuplicateDetector() DuplicateDetector {
+	return DuplicateDetector{
+		Policy:        DuplicateFlag,
+		WindowDays:    3,
+		MinSimilarity: 0.5,
+	}
+}
+
+// Window returns the range of dates a duplicate of a transaction dated
+// date can have.
+func (d DuplicateDetector) Window(date time.Time) (time.Time, time.Time) {
+	day := DateOf(date)
+	return day.AddDate(0, 0, -d.WindowDays), day.AddDate(0, 0, d.WindowDays+1).Add(-time.Nanosecond)
+}
+
+func (d DuplicateDetector) IsDuplicate(a, b Transaction) bool {
+	if a.ID != 0 && a.ID == b.ID {
+		return false
+	}
+	if math.Abs(a.Amount-b.Amount) >= 0.005 {
+		return false
+	}
+
+	days := DateOf(a.Date).Sub(DateOf(b.Date)).Hours() / 24
+	if math.Abs(days) > float64(d.WindowDays) {
+		return false
+	}
+
+	return descriptionSimilarity(a.Description, b.Description) >= d.MinSimilarity
+}
+
+// FindDuplicate returns the first of candidates tx duplicates.
+func (d DuplicateDetector) FindDuplicate(tx Transaction, candidates []Transaction) (Transaction, bool) {
+	for _, c := range candidates {
+		if d.IsDuplicate(tx, c) {
+			return c, true
+		}
+	}
+
+	return Transaction{}, false
+}
+
+// Groups returns the sets of transactions that duplicate each other,
+// directly or through another member. Pairs for which dismissed returns
+// true are not linked. Groups and their members are ordered by ID.
+func (d DuplicateDetector) Groups(txs []Transaction, dismissed func(a, b int) bool) [][]Transaction {
+	sorted := make([]Transaction, len(txs))
+	copy(sorted, txs)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Amount != sorted[j].Amount {
+			return sorted[i].Amount < sorted[j].Amount
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	parent := make(map[int]int)
+	var find func(int) int
+	find = func(id int) int {
+		if p, ok := parent[id]; ok && p != id {
+			parent[id] = find(p)
+			return parent[id]
+		}
+		return id
+	}
+
+	linked := make(map[int]bool)
+	for i := range sorted {
+		for j := i + 1; j < len(sorted); j++ {
+			// Sorted by amount, so nothing further on can match.
+			if sorted[j].Amount-sorted[i].Amount >= 0.005 {
+				break
+			}
+			a, b := sorted[i], sorted[j]
+			if !d.IsDuplicate(a, b) || dismissed(a.ID, b.ID) {
+				continue
+			}
+			ra, rb := find(a.ID), find(b.ID)
+			if ra != rb {
+				parent[max(ra, rb)] = min(ra, rb)
+			}
+			linked[a.ID], linked[b.ID] = true, true
+		}
+	}
+
+	byRoot := make(map[int][]Transaction)
+	for _, tx := range sorted {
+		if linked[tx.ID] {
+			root := find(tx.ID)
+			byRoot[root] = append(byRoot[root], tx)
+		}
+	}
+
+	groups := make([][]Transaction, 0, len(byRoot))
+	for _, g := range byRoot {
+		sort.Slice(g, func(i, j int) bool { return g[i].ID < g[j].ID })
+		groups = append(groups, g)
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i][0].ID < groups[j][0].ID })
+
+	return groups
+}
+
+// descriptionSimilarity is the Jaccard index of the descriptions' words.
+// Two empty descriptions are identical.
+func descriptionSimilarity(a, b string) float64 {
+	wa, wb := descriptionWords(a), descriptionWords(b)
+	if len(wa) == 0 && len(wb) == 0 {
+		return 1
+	}
+
+	common := 0
+	for w := range wa {
+		if wb[w] {
+			common++
+		}
+	}
+
+	return float64(common) / float64(len(wa)+len(wb)-common)
+}
+
+func descriptionWords(s string) map[string]bool {
+	words := make(map[string]bool)
+	for _, w := range strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		words[w] = true
+	}
+	return words
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDuplicateDetector_IsDuplicate(t *testing.T) {
+	d := DefaultDuplicateDetector()
+	day := time.Date(2026, 10, 5, 14, 30, 0, 0, time.UTC)
+	tx := Transaction{ID: 1, Amount: 42.5, Description: "LIDL #88 - weekly shop", Date: day}
+
+	tests := []struct {
+		name  string
+		other Transaction
+		want  bool
+	}{
+		{"same export row", Transaction{ID: 2, Amount: 42.5, Description: "Lidl 88 weekly shop", Date: day.AddDate(0, 0, 2)}, true},
+		{"itself", tx, false},
+		{"other amount", Transaction{ID: 2, Amount: 42.51, Description: "Lidl 88 weekly shop", Date: day}, false},
+		{"outside the window", Transaction{ID: 2, Amount: 42.5, Description: "Lidl 88 weekly shop", Date: day.AddDate(0, 0, -4)}, false},
+		{"other description", Transaction{ID: 2, Amount: 42.5, Description: "Shell fuel", Date: day}, false},
+		{"partly similar", Transaction{ID: 2, Amount: 42.5, Description: "LIDL weekly shop", Date: day}, true},
+	}
+
+	for _, tt := range tests {
+		if got := d.IsDuplicate(tx, tt.other); got != tt.want {
+			t.Errorf("%s: IsDuplicate = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDuplicateDetector_Groups(t *testing.T) {
+	d := DefaultDuplicateDetector()
+	day := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+	txs := []Transaction{
+		{ID: 1, Amount: 10, Description: "Coffee Bar", Date: day},
+		{ID: 2, Amount: 99, Description: "Rent", Date: day},
+		{ID: 3, Amount: 10, Description: "coffee bar", Date: day.AddDate(0, 0, 3)},
+		// Linked to 1 only through 3.
+		{ID: 4, Amount: 10, Description: "Coffee Bar", Date: day.AddDate(0, 0, 6)},
+		{ID: 5, Amount: 99, Description: "rent", Date: day.AddDate(0, 0, 1)},
+		{ID: 6, Amount: 10, Description: "Bakery", Date: day},
+	}
+
+	groups := d.Groups(txs, func(a, b int) bool { return false })
+	if len(groups) != 2 {
+		t.Fatalf("expected 2 groups, got %+v", groups)
+	}
+	if ids := groupIDs(groups[0]); ids != [3]int{1, 3, 4} {
+		t.Fatalf("unexpected first group: %v", ids)
+	}
+	if ids := groupIDs(groups[1]); ids != [3]int{2, 5} {
+		t.Fatalf("unexpected second group: %v", ids)
+	}
+
+	groups = d.Groups(txs, func(a, b int) bool { return a == 2 && b == 5 })
+	if len(groups) != 1 {
+		t.Fatalf("expected the dismissed pair to be left out, got %+v", groups)
+	}
+}
+
+func groupIDs(g []Transaction) [3]int {
+	var ids [3]int
+	for i, tx := range g {
+		ids[i] = tx.ID
+	}
+	return ids
+}
+
+func TestParseDuplicatePolicy(t *testing.T) {
+	if p, err := ParseDuplicatePolicy(" Reject "); err != nil || p != DuplicateReject {
+		t.Fatalf("unexpected result: %q, %v", p, err)
+	}
+	if _, err := ParseDuplicatePolicy("ignore"); err == nil {
+		t.Fatal("expected an error for an unknown policy")
+	}
+}
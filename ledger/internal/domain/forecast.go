@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// ForecastQuery asks for a projection of the period containing AsOf.
+type ForecastQuery struct {
+	AsOf   time.Time
+	Period Granularity
+}
+
+func (q ForecastQuery) Validate() error {
+	switch q.Period {
+	case GranularityWeek, GranularityMonth, GranularityQuarter, GranularityYear:
+		return nil
+	}
+	return errors.New("validation failed: forecast period should be one of week, month, quarter, year")
+}
+
+// ForecastLine is the projected end-of-period spend of one category.
+type ForecastLine struct {
+	Category string
+	Spent    float64
+
+	// PaceProjection extrapolates Spent linearly over the whole period.
+	PaceProjection float64
+	// HistoryProjection adds to Spent what was spent in the rest of the
+	// period in past periods, on average. Only set when HasHistory.
+	HistoryProjection float64
+	HasHistory        bool
+	// RecurringPending is the total of items that were posted in each of
+	// the last periods and have not been posted in this one yet.
+	RecurringPending float64
+
+	Projected float64
+	Limit     float64
+	HasBudget bool
+	// OverBudget compares the projected spend of the category and all of
+	// its subcategories with Limit.
+	OverBudget bool
+}
+
+type Forecast struct {
+	Period      Granularity
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	AsOf        time.Time
+	Lines       []ForecastLine
+}
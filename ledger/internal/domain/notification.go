@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"errors"
+	"net/mail"
+	"time"
+)
+
+// NotificationKind is a kind of email a recipient can opt in to.
+type NotificationKind string
+
+const (
+	// NotifyBudgetAlerts is sent when a budget crosses the alert
+	// threshold or a transaction is rejected for exceeding it.
+	NotifyBudgetAlerts NotificationKind = "budget_alerts"
+	// NotifyWeeklyDigest is a summary of the past week's spending.
+	NotifyWeeklyDigest NotificationKind = "weekly_digest"
+)
+
+// NotificationRecipient is someone who gets the ledger's emails. They
+// only get the kinds they opted in to.
+type NotificationRecipient struct {
+	ID           int
+	Name         string
+	Email        string
+	BudgetAlerts bool
+	WeeklyDigest bool
+	CreatedAt    time.Time
+}
+
+func (r NotificationRecipient) Validate() error {
+	addr, err := mail.ParseAddress(r.Email)
+	if err != nil || addr.Address != r.Email {
+		return errors.New("validation failed: recipient email must be a plain email address")
+	}
+
+	return nil
+}
+
+// Wants reports whether r opted in to kind.
+func (r NotificationRecipient) Wants(kind NotificationKind) bool {
+	switch kind {
+	case NotifyBudgetAlerts:
+		return r.BudgetAlerts
+	case NotifyWeeklyDigest:
+		return r.WeeklyDigest
+	default:
+		return false
+	}
+}
+
+// Digest summarizes the spending between From and To, and where the
+// budgets stand when it is made.
+type Digest struct {
+	From time.Time
+	To   time.Time
+	// Total is the spend of the period.
+	Total float64
+	// Categories is the spend per top-level category, most first.
+	Categories []CategorySpend
+	Budgets    []BudgetLine
+}
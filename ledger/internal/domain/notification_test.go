@@ -0,0 +1,30 @@
+package domain
+
+import "testing"
+
+func TestNotificationRecipient_Validate(t *testing.T) {
+	tests := []struct {
+		email string
+		ok    bool
+	}{
+		{"alex@example.com", true},
+		{"", false},
+		{"alex", false},
+		{"Alex <alex@example.com>", false},
+		{"alex@example.com\r\nBcc: eve@example.com", false},
+	}
+
+	for _, tt := range tests {
+		err := NotificationRecipient{Email: tt.email}.Validate()
+		if (err == nil) != tt.ok {
+			t.Errorf("Validate(%q) = %v, want ok %v", tt.email, err, tt.ok)
+		}
+	}
+}
+
+func TestNotificationRecipient_Wants(t *testing.T) {
+	r := NotificationRecipient{Email: "alex@example.com", WeeklyDigest: true}
+	if r.Wants(NotifyBudgetAlerts) || !r.Wants(NotifyWeeklyDigest) {
+		t.Fatalf("expected only the digest to be wanted, got %+v", r)
+	}
+}
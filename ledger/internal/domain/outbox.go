@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// OutboxMessage is an event stored in the same database transaction as
+// the change it describes, so it cannot be lost when the ledger stops
+// before telling anybody. A relay publishes it afterwards, at least once.
+type OutboxMessage struct {
+	ID      int64
+	EventID string
+	Type    EventType
+	// Payload is the event as JSON.
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+func NewOutboxMessage(e Event) (OutboxMessage, error) {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return OutboxMessage{}, err
+	}
+
+	return OutboxMessage{
+		EventID:   e.ID,
+		Type:      e.Type,
+		Payload:   payload,
+		CreatedAt: e.CreatedAt,
+	}, nil
+}
+
+// DecodeEvent is the reverse of encoding an event as JSON: Data gets the
+// type that goes with the event type.
+func DecodeEvent(payload []byte) (Event, error) {
+	var raw struct {
+		Event
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(payload, &raw); err != nil {
+		return Event{}, err
+	}
+
+	e := raw.Event
+	var err error
+	switch e.Type {
+	case EventTransactionCreated:
+		e.Data, err = decodeData[TransactionEvent](raw.Data)
+	case EventBudgetExceeded, EventBudgetThresholdCrossed:
+		e.Data, err = decodeData[BudgetEvent](raw.Data)
+	case EventImportCompleted:
+		e.Data, err = decodeData[ImportEvent](raw.Data)
+	case EventBudgetUpdated:
+		e.Data, err = decodeData[BudgetLimitEvent](raw.Data)
+	default:
+		return e, fmt.Errorf("unknown event %q", e.Type)
+	}
+
+	return e, err
+}
+
+func decodeData[T any](data json.RawMessage) (T, error) {
+	var v T
+	err := json.Unmarshal(data, &v)
+	return v, err
+}
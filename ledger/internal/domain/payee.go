@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+// Payee is the merchant or person a transaction was paid to.
+type Payee struct {
+	ID   int
+	Name string
+}
+
+func (p Payee) Validate() error {
+	if p.Name == "" {
+		return errors.New("validation failed: payee name cannot be empty")
+	}
+
+	return nil
+}
+
+// PayeeSpend is one line of the spend by payee report.
+type PayeeSpend struct {
+	PayeeID int     `json:"payee_id"`
+	Payee   string  `json:"payee"`
+	Spent   float64 `json:"spent"`
+	Count   int     `json:"count"`
+}
+
+// paymentPrefixes are card processors that put their name in front of
+// the merchant, as in "SQ *COFFEE BAR".
+var paymentPrefixes = []string{"sq", "tst", "paypal", "pp", "sp", "sumup", "zettle"}
+
+// descriptionSeparators split the payee from the notes in a description,
+// as in "Lidl - weekly shop".
+var descriptionSeparators = []string{" - ", " – ", " — ", " | ", ": ", "; ", ", "}
+
+// NormalizePayee cleans up a payee name as it appears on statements: it
+// collapses whitespace, drops card processor prefixes and trailing store
+// numbers, and title-cases names written in a single case.
+func NormalizePayee(name string) string {
+	name = strings.Join(strings.Fields(name), " ")
+
+	if head, rest, ok := strings.Cut(name, "*"); ok {
+		head = strings.ToLower(strings.TrimSpace(head))
+		for _, p := range paymentPrefixes {
+			if head == p {
+				name = strings.TrimSpace(rest)
+				break
+			}
+		}
+	}
+
+	words := strings.Fields(name)
+	for len(words) > 1 && isStoreNumber(words[len(words)-1]) {
+		words = words[:len(words)-1]
+	}
+	name = strings.Join(words, " ")
+
+	if name == strings.ToUpper(name) || name == strings.ToLower(name) {
+		name = titleCase(name)
+	}
+
+	return name
+}
+
+// PayeeFromDescription returns the normalized payee at the start of a
+// description, up to the first separator, or "" when there is none.
+func PayeeFromDescription(description string) string {
+	head := description
+	for _, sep := range descriptionSeparators {
+		if i := strings.Index(head, sep); i >= 0 {
+			head = head[:i]
+		}
+	}
+
+	name := NormalizePayee(head)
+	if !strings.ContainsFunc(name, unicode.IsLetter) {
+		return ""
+	}
+
+	return name
+}
+
+// isStoreNumber matches "#123", "No.12" and bare numbers of three or more
+// digits.
+func isStoreNumber(word string) bool {
+	w := strings.ToLower(word)
+	switch {
+	case strings.HasPrefix(w, "#"):
+		w = w[1:]
+	case strings.HasPrefix(w, "no."):
+		w = w[3:]
+	case len(w) < 3:
+		return false
+	}
+
+	return w != "" && strings.IndexFunc(w, func(r rune) bool { return !unicode.IsDigit(r) }) < 0
+}
+
+func titleCase(s string) string {
+	words := strings.Fields(strings.ToLower(s))
+	for i, w := range words {
+		r := []rune(w)
+		r[0] = unicode.ToUpper(r[0])
+		words[i] = string(r)
+	}
+
+	return strings.Join(words, " ")
+}
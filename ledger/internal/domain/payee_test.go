@@ -0,0 +1,38 @@
+package domain
+
+import "testing"
+
+func TestNormalizePayee(t *testing.T) {
+	tests := map[string]string{
+		"  LIDL   STORE #1234 ": "Lidl Store",
+		"SQ *BLUE BOTTLE":       "Blue Bottle",
+		"PAYPAL *Netflix":       "Netflix",
+		"starbucks 00421":       "Starbucks",
+		"McDonald's":            "McDonald's",
+		"7-ELEVEN":              "7-eleven",
+		"Shell No.12":           "Shell",
+	}
+
+	for in, want := range tests {
+		if got := NormalizePayee(in); got != want {
+			t.Errorf("NormalizePayee(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestPayeeFromDescription(t *testing.T) {
+	tests := map[string]string{
+		"LIDL #88 - weekly shop": "Lidl",
+		"Uber: ride to airport":  "Uber",
+		"Coffee Bar":             "Coffee Bar",
+		"12345":                  "",
+		"":                       "",
+		"Rent | October":         "Rent",
+	}
+
+	for in, want := range tests {
+		if got := PayeeFromDescription(in); got != want {
+			t.Errorf("PayeeFromDescription(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
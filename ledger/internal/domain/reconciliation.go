@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrTransactionLocked is returned when changing a transaction that
+	// was reconciled against a bank statement.
+	ErrTransactionLocked = errors.New("transaction is reconciled and locked")
+	// ErrReconciliationOpen is returned when starting a reconciliation
+	// for an account that has one open already.
+	ErrReconciliationOpen = errors.New("account has an open reconciliation")
+	// ErrReconciliationCompleted is returned when completing a
+	// reconciliation twice.
+	ErrReconciliationCompleted = errors.New("reconciliation is already completed")
+	// ErrReconciliationUnbalanced is returned when completing a
+	// reconciliation whose cleared balance differs from the statement.
+	ErrReconciliationUnbalanced = errors.New("cleared balance does not match the statement")
+)
+
+// Reconciliation checks an account against the closing balance of a bank
+// statement. Transactions are outflows, so the ledger's balance is the
+// opening balance less the cleared transactions.
+type Reconciliation struct {
+	ID      int
+	Account string
+	// StatementDate is the closing date of the statement; transactions
+	// dated after it belong to the next one.
+	StatementDate    time.Time
+	StatementBalance float64
+	// OpeningBalance is the statement balance of the previous completed
+	// reconciliation of the account, or entered for the first one.
+	OpeningBalance float64
+	// CompletedAt is zero while the reconciliation is open.
+	CompletedAt time.Time
+
+	// ClearedTotal is the spend of the cleared transactions, and
+	// Transactions the account's unreconciled ones up to StatementDate.
+	// Both are computed, not stored.
+	ClearedTotal float64
+	Transactions []Transaction
+}
+
+func (r Reconciliation) Validate() error {
+	if r.StatementDate.IsZero() {
+		return errors.New("validation failed: statement date is required")
+	}
+	if r.Account != strings.TrimSpace(r.Account) {
+		return errors.New("validation failed: account cannot start or end with spaces")
+	}
+
+	return nil
+}
+
+// ClearedBalance is what the account holds once the cleared transactions
+// are paid.
+func (r Reconciliation) ClearedBalance() float64 {
+	return roundCents(r.OpeningBalance - r.ClearedTotal)
+}
+
+// Difference is what the statement shows beyond the cleared balance. It
+// must be zero to complete the reconciliation.
+func (r Reconciliation) Difference() float64 {
+	return roundCents(r.StatementBalance - r.ClearedBalance())
+}
+
+func (r Reconciliation) Balanced() bool {
+	return math.Abs(r.Difference()) < 0.005
+}
+
+func roundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}
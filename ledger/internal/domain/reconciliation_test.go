@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReconciliation_Difference(t *testing.T) {
+	r := Reconciliation{
+		StatementDate:    time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC),
+		OpeningBalance:   500.10,
+		StatementBalance: 379.90,
+		ClearedTotal:     120.10,
+	}
+
+	if got := r.ClearedBalance(); got != 380 {
+		t.Fatalf("ClearedBalance = %v, want 380", got)
+	}
+	if got := r.Difference(); got != -0.1 {
+		t.Fatalf("Difference = %v, want -0.1", got)
+	}
+	if r.Balanced() {
+		t.Fatal("expected a 10 cent difference not to balance")
+	}
+
+	r.ClearedTotal = 120.20
+	if !r.Balanced() {
+		t.Fatalf("expected to balance, difference is %v", r.Difference())
+	}
+}
+
+func TestReconciliation_Validate(t *testing.T) {
+	if err := (Reconciliation{}).Validate(); err == nil {
+		t.Fatal("expected an error without a statement date")
+	}
+	if err := (Reconciliation{Account: " checking", StatementDate: time.Now()}).Validate(); err == nil {
+		t.Fatal("expected an error for an untrimmed account")
+	}
+}
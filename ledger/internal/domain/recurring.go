@@ -0,0 +1,234 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrAlreadyPosted is returned when an occurrence of a recurring
+// transaction is already in the ledger.
+var ErrAlreadyPosted = errors.New("recurring occurrence already posted")
+
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "DAILY"
+	FrequencyWeekly  Frequency = "WEEKLY"
+	FrequencyMonthly Frequency = "MONTHLY"
+)
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// Schedule is a subset of an iCalendar RRULE: FREQ=DAILY, WEEKLY or
+// MONTHLY, an optional INTERVAL, BYDAY=MO..SU for weekly and BYMONTHDAY=N
+// for monthly schedules. Without BYDAY or BYMONTHDAY the start date picks
+// the day. Monthly days past the end of a month fall on its last day.
+type Schedule struct {
+	Frequency Frequency
+	Interval  int
+	// Weekday is used by weekly schedules when HasWeekday is set.
+	Weekday    time.Weekday
+	HasWeekday bool
+	// MonthDay is used by monthly schedules when not zero.
+	MonthDay int
+}
+
+func ParseSchedule(rule string) (Schedule, error) {
+	s := Schedule{Interval: 1}
+
+	for _, part := range strings.Split(strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:"), ";") {
+		if part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return Schedule{}, fmt.Errorf("validation failed: invalid schedule part %q", part)
+		}
+		key, value = strings.ToUpper(strings.TrimSpace(key)), strings.ToUpper(strings.TrimSpace(value))
+
+		switch key {
+		case "FREQ":
+			s.Frequency = Frequency(value)
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return Schedule{}, fmt.Errorf("validation failed: invalid schedule interval %q", value)
+			}
+			s.Interval = n
+		case "BYDAY":
+			d, ok := weekdays[value]
+			if !ok {
+				return Schedule{}, fmt.Errorf("validation failed: invalid schedule day %q", value)
+			}
+			s.Weekday, s.HasWeekday = d, true
+		case "BYMONTHDAY":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 || n > 31 {
+				return Schedule{}, fmt.Errorf("validation failed: invalid schedule month day %q", value)
+			}
+			s.MonthDay = n
+		default:
+			return Schedule{}, fmt.Errorf("validation failed: unsupported schedule part %q", key)
+		}
+	}
+
+	switch s.Frequency {
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly:
+	case "":
+		return Schedule{}, errors.New("validation failed: schedule needs FREQ")
+	default:
+		return Schedule{}, fmt.Errorf("validation failed: unsupported schedule frequency %q", s.Frequency)
+	}
+	if s.HasWeekday && s.Frequency != FrequencyWeekly {
+		return Schedule{}, errors.New("validation failed: BYDAY needs FREQ=WEEKLY")
+	}
+	if s.MonthDay != 0 && s.Frequency != FrequencyMonthly {
+		return Schedule{}, errors.New("validation failed: BYMONTHDAY needs FREQ=MONTHLY")
+	}
+
+	return s, nil
+}
+
+func (s Schedule) String() string {
+	parts := []string{"FREQ=" + string(s.Frequency)}
+	if s.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(s.Interval))
+	}
+	if s.HasWeekday {
+		for code, d := range weekdays {
+			if d == s.Weekday {
+				parts = append(parts, "BYDAY="+code)
+			}
+		}
+	}
+	if s.MonthDay != 0 {
+		parts = append(parts, "BYMONTHDAY="+strconv.Itoa(s.MonthDay))
+	}
+	return strings.Join(parts, ";")
+}
+
+// occurrence returns the k-th date of the schedule counted from the
+// period containing start. It can fall before start.
+func (s Schedule) occurrence(start time.Time, k int) time.Time {
+	interval := max(s.Interval, 1)
+
+	switch s.Frequency {
+	case FrequencyWeekly:
+		first := start
+		if s.HasWeekday {
+			first = start.AddDate(0, 0, (int(s.Weekday)-int(start.Weekday())+7)%7)
+		}
+		return first.AddDate(0, 0, 7*interval*k)
+
+	case FrequencyMonthly:
+		day := s.MonthDay
+		if day == 0 {
+			day = start.Day()
+		}
+		month := time.Date(start.Year(), start.Month()+time.Month(interval*k), 1, 0, 0, 0, 0, time.UTC)
+		last := month.AddDate(0, 1, -1).Day()
+		return month.AddDate(0, 0, min(day, last)-1)
+
+	default:
+		return start.AddDate(0, 0, interval*k)
+	}
+}
+
+// Next returns the first date of the schedule that is on or after start
+// and after after.
+func (s Schedule) Next(start, after time.Time) time.Time {
+	start = DateOf(start)
+	after = DateOf(after)
+
+	// Start a little before the index after falls on, then walk forward.
+	k := 0
+	if after.After(start) {
+		days := int(after.Sub(start).Hours() / 24)
+		switch s.Frequency {
+		case FrequencyWeekly:
+			k = days/(7*max(s.Interval, 1)) - 1
+		case FrequencyMonthly:
+			k = days/(31*max(s.Interval, 1)) - 1
+		default:
+			k = days/max(s.Interval, 1) - 1
+		}
+		k = max(k, 0)
+	}
+
+	for ; ; k++ {
+		d := s.occurrence(start, k)
+		if !d.Before(start) && d.After(after) {
+			return d
+		}
+	}
+}
+
+// DateOf drops the time of day of t, keeping its calendar date.
+func DateOf(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// RecurringTransaction is a template the scheduler posts as a regular
+// transaction on every date of its schedule between Start and End.
+type RecurringTransaction struct {
+	ID          int
+	Amount      float64
+	Category    string
+	Description string
+	Schedule    Schedule
+	Start       time.Time
+	// End is the last day occurrences may fall on. Zero means no end.
+	End time.Time
+	// Next is the first occurrence not posted yet. Zero once the schedule
+	// is over.
+	Next time.Time
+	// LastError is why the last occurrence could not be posted, if it
+	// could not.
+	LastError string
+}
+
+func (r RecurringTransaction) Validate() error {
+	if err := r.Transaction(r.Start).Validate(); err != nil {
+		return err
+	}
+	if r.Start.IsZero() {
+		return errors.New("validation failed: recurring transaction needs a start date")
+	}
+	if !r.End.IsZero() && r.End.Before(r.Start) {
+		return errors.New("validation failed: end date is before start date")
+	}
+	return nil
+}
+
+// NextAfter returns the first occurrence after date, or zero time when
+// the schedule ends before it.
+func (r RecurringTransaction) NextAfter(date time.Time) time.Time {
+	next := r.Schedule.Next(r.Start, date)
+	if !r.End.IsZero() && next.After(DateOf(r.End)) {
+		return time.Time{}
+	}
+	return next
+}
+
+// Transaction is the occurrence of r on date.
+func (r RecurringTransaction) Transaction(date time.Time) Transaction {
+	return Transaction{
+		Amount:      r.Amount,
+		Category:    r.Category,
+		Description: r.Description,
+		Date:        date,
+		RecurringID: r.ID,
+	}
+}
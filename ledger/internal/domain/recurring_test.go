@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParseSchedule(t *testing.T) {
+	s, err := ParseSchedule("freq=monthly;bymonthday=31")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s.String() != "FREQ=MONTHLY;BYMONTHDAY=31" {
+		t.Fatalf("unexpected schedule %q", s)
+	}
+
+	for _, rule := range []string{"", "FREQ=YEARLY", "FREQ=DAILY;INTERVAL=0", "FREQ=DAILY;BYDAY=MO", "FREQ=WEEKLY;BYDAY=XX"} {
+		if _, err := ParseSchedule(rule); err == nil {
+			t.Fatalf("%q: expected an error", rule)
+		}
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	cases := []struct {
+		rule  string
+		start time.Time
+		after time.Time
+		want  []time.Time
+	}{
+		{
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=31",
+			start: date(2026, 1, 15),
+			after: date(2026, 1, 14),
+			want:  []time.Time{date(2026, 1, 31), date(2026, 2, 28), date(2026, 3, 31)},
+		},
+		{
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=5",
+			start: date(2026, 1, 15),
+			after: date(2026, 1, 14),
+			want:  []time.Time{date(2026, 2, 5), date(2026, 3, 5)},
+		},
+		{
+			rule:  "FREQ=WEEKLY;BYDAY=MO",
+			start: date(2026, 10, 1),
+			after: date(2026, 9, 30),
+			want:  []time.Time{date(2026, 10, 5), date(2026, 10, 12)},
+		},
+		{
+			rule:  "FREQ=DAILY;INTERVAL=10",
+			start: date(2026, 1, 1),
+			after: date(2026, 3, 1),
+			want:  []time.Time{date(2026, 3, 2), date(2026, 3, 12)},
+		},
+	}
+
+	for _, c := range cases {
+		s, err := ParseSchedule(c.rule)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.rule, err)
+		}
+
+		after := c.after
+		for _, want := range c.want {
+			got := s.Next(c.start, after)
+			if !got.Equal(want) {
+				t.Fatalf("%s after %s: expected %s, got %s",
+					c.rule, after.Format("2006-01-02"), want.Format("2006-01-02"), got.Format("2006-01-02"))
+			}
+			after = got
+		}
+	}
+}
+
+func TestRecurringTransaction_NextAfterEnd(t *testing.T) {
+	s, _ := ParseSchedule("FREQ=MONTHLY")
+	r := RecurringTransaction{Schedule: s, Start: date(2026, 1, 10), End: date(2026, 2, 10)}
+
+	if got := r.NextAfter(date(2026, 1, 10)); !got.Equal(date(2026, 2, 10)) {
+		t.Fatalf("expected 2026-02-10, got %s", got)
+	}
+	if got := r.NextAfter(date(2026, 2, 10)); !got.IsZero() {
+		t.Fatalf("expected no occurrence after the end, got %s", got)
+	}
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+var (
+	// ErrRefundExceedsOriginal is returned when refunds of a transaction
+	// would add up to more than it cost.
+	ErrRefundExceedsOriginal = errors.New("refunds exceed the original amount")
+	// ErrTransactionVoided is returned when refunding a voided
+	// transaction.
+	ErrTransactionVoided = errors.New("transaction is voided")
+)
+
+// Refund gives back part or all of a transaction. It reduces the spend of
+// the original's category on the original's date, so budgets and reports
+// for that period see what was actually spent.
+type Refund struct {
+	ID            int
+	TransactionID int
+	// Category is the category whose spend goes down. For a split
+	// original it picks the line refunded; otherwise it is the
+	// original's category.
+	Category    string
+	Amount      float64
+	Description string
+	// Date is when the money came back. OriginalDate is the date of the
+	// original, which the spend is taken off.
+	Date         time.Time
+	OriginalDate time.Time
+}
+
+func (r Refund) Validate() error {
+	if r.TransactionID <= 0 {
+		return errors.New("validation failed: original transaction is required")
+	}
+	if r.Amount <= 0 {
+		return errors.New("validation failed: refund amount should be > 0")
+	}
+
+	return nil
+}
+
+// CheckRefund fills in r's category and original date from original and
+// checks that r, with the earlier refunds of original, gives back no more
+// than the original amount, or than the line it refunds of a split one.
+func CheckRefund(original Transaction, earlier []Refund, r *Refund) error {
+	if original.Status == StatusVoided {
+		return ErrTransactionVoided
+	}
+
+	if r.Category == "" && len(original.Splits) == 0 {
+		r.Category = original.Category
+	}
+	if r.Category == "" {
+		return errors.New("validation failed: category is required to refund a split transaction")
+	}
+
+	var line float64
+	found := false
+	for _, l := range original.Lines() {
+		if l.Category == r.Category {
+			line += l.Amount
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("validation failed: transaction %d has no %s line", original.ID, r.Category)
+	}
+
+	var total, onLine float64
+	for _, e := range earlier {
+		total += e.Amount
+		if e.Category == r.Category {
+			onLine += e.Amount
+		}
+	}
+	if total+r.Amount-original.Amount >= 0.005 || onLine+r.Amount-line >= 0.005 {
+		return fmt.Errorf(
+			"%w: %.2f of %.2f is left to refund",
+			ErrRefundExceedsOriginal, math.Max(0, roundCents(math.Min(original.Amount-total, line-onLine))), line,
+		)
+	}
+	r.OriginalDate = original.Date
+
+	return nil
+}
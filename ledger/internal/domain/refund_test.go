@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCheckRefund(t *testing.T) {
+	day := time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC)
+	original := Transaction{ID: 1, Amount: 50, Category: "food", Date: day}
+
+	r := Refund{TransactionID: 1, Amount: 20}
+	if err := CheckRefund(original, nil, &r); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.Category != "food" || !r.OriginalDate.Equal(day) {
+		t.Fatalf("expected the original's category and date, got %+v", r)
+	}
+
+	earlier := []Refund{r}
+	over := Refund{TransactionID: 1, Amount: 30.01}
+	if err := CheckRefund(original, earlier, &over); !errors.Is(err, ErrRefundExceedsOriginal) {
+		t.Fatalf("expected ErrRefundExceedsOriginal, got %v", err)
+	}
+	rest := Refund{TransactionID: 1, Amount: 30}
+	if err := CheckRefund(original, earlier, &rest); err != nil {
+		t.Fatalf("expected the rest to be refundable, got %v", err)
+	}
+
+	original.Status = StatusVoided
+	if err := CheckRefund(original, nil, &Refund{TransactionID: 1, Amount: 1}); !errors.Is(err, ErrTransactionVoided) {
+		t.Fatalf("expected ErrTransactionVoided, got %v", err)
+	}
+}
+
+func TestCheckRefund_Split(t *testing.T) {
+	original := Transaction{
+		ID:       1,
+		Amount:   100,
+		Category: "food",
+		Splits:   []Split{{Category: "food", Amount: 70}, {Category: "home", Amount: 30}},
+	}
+
+	if err := CheckRefund(original, nil, &Refund{TransactionID: 1, Amount: 10}); err == nil {
+		t.Fatal("expected a category to be required for a split transaction")
+	}
+	if err := CheckRefund(original, nil, &Refund{TransactionID: 1, Amount: 10, Category: "fuel"}); err == nil {
+		t.Fatal("expected a category without a line to be rejected")
+	}
+
+	earlier := []Refund{{TransactionID: 1, Category: "home", Amount: 25}}
+	if err := CheckRefund(original, earlier, &Refund{TransactionID: 1, Amount: 10, Category: "home"}); !errors.Is(err, ErrRefundExceedsOriginal) {
+		t.Fatalf("expected the home line to be over-refunded, got %v", err)
+	}
+	if err := CheckRefund(original, earlier, &Refund{TransactionID: 1, Amount: 70, Category: "food"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
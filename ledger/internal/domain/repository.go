@@ -12,14 +12,248 @@ type BudgetRepository interface {
 }
 
 type TransactionRepository interface {
-	Add(ctx context.Context, tx *Transaction) error
-	List(ctx context.Context) ([]Transaction, error)
+	// Add stores tx and writes a transaction.created event and events,
+	// e.g. budgets tx crossed a threshold of, to the outbox with it.
+	Add(ctx context.Context, tx *Transaction, events ...Event) error
+	List(ctx context.Context, filter TransactionFilter) ([]Transaction, error)
 	SumByCategory(ctx context.Context, category string) (float64, error)
+	// SumByCategories returns the combined all-time spend of categories
+	// in statuses, or in every status but voided when statuses is empty.
+	SumByCategories(ctx context.Context, categories []string, statuses []TransactionStatus) (float64, error)
 	ListCategories(ctx context.Context) ([]string, error)
+	// Reclassify stores the category, description and tags of tx. Tags
+	// are only added, never removed. It returns ErrTransactionLocked when
+	// tx is reconciled.
+	Reclassify(ctx context.Context, tx Transaction) error
+
+	// SetStatus moves ids to status in one transaction, records the
+	// change of every one not in it already and returns their dates. It
+	// returns ErrTransactionNotFound when one of them does not exist,
+	// ErrTransactionLocked when one is reconciled and ErrStatusChange
+	// when one cannot move to status.
+	SetStatus(ctx context.Context, ids []int, status TransactionStatus) ([]time.Time, error)
+	// ListStatusChanges returns the status history of a transaction,
+	// oldest first. It returns ErrTransactionNotFound when id does not
+	// exist.
+	ListStatusChanges(ctx context.Context, id int) ([]StatusChange, error)
+	// ListUnreconciled returns the transactions of account that are
+	// neither reconciled nor voided and dated on or before through, oldest first.
+	ListUnreconciled(ctx context.Context, account string, through time.Time) ([]Transaction, error)
+	// Reconcile completes r in one transaction: it moves the cleared
+	// transactions of the account up to the statement date to reconciled
+	// and sets r.CompletedAt. It returns ErrReconciliationUnbalanced when they do
+	// not add up to the statement and ErrReconciliationCompleted when r
+	// is completed already, and the number of transactions locked.
+	Reconcile(ctx context.Context, r *Reconciliation) (int, error)
 
 	SumByCategoryAndPeriod(
 		ctx context.Context,
 		category string,
 		from, to time.Time,
 	) (float64, error)
-}
\ No newline at end of file
+
+	// SumByCategoriesInPeriod returns the spend of every category with
+	// transactions between from and to, inclusive.
+	SumByCategoriesInPeriod(ctx context.Context, from, to time.Time) (map[string]float64, error)
+
+	// SumByCategoriesInPeriodByStatus is SumByCategoriesInPeriod counting
+	// only the transactions in statuses. It reads the transactions rather
+	// than the daily totals.
+	SumByCategoriesInPeriodByStatus(
+		ctx context.Context,
+		from, to time.Time,
+		statuses []TransactionStatus,
+	) (map[string]float64, error)
+
+	// ListByPeriod returns the transactions dated between from and to,
+	// inclusive, oldest first. Voided transactions are left out of it and
+	// of every other period query.
+	ListByPeriod(ctx context.Context, from, to time.Time) ([]Transaction, error)
+
+	// ListByCategoryAndPeriod is ListByPeriod for a single category.
+	ListByCategoryAndPeriod(ctx context.Context, category string, from, to time.Time) ([]Transaction, error)
+
+	// SumByTagInPeriod returns the spend of every tag between from and to.
+	// A transaction with several tags counts towards each of them.
+	SumByTagInPeriod(ctx context.Context, from, to time.Time) (map[string]float64, error)
+
+	// SumByPayeeInPeriod returns the spend of the limit payees with the
+	// most spend between from and to, largest first. Zero means no limit.
+	// Transactions without a payee are left out.
+	SumByPayeeInPeriod(ctx context.Context, from, to time.Time, limit int) ([]PayeeSpend, error)
+
+	// SumByCategoryAndBucket groups spend between from and to by category
+	// and time bucket. An empty categories list means every category.
+	SumByCategoryAndBucket(
+		ctx context.Context,
+		granularity Granularity,
+		from, to time.Time,
+		categories []string,
+	) ([]BucketTotal, error)
+}
+
+// CategoryRepository stores the category tree. Categories are addressed
+// by their canonical name, like on transactions and budgets.
+type CategoryRepository interface {
+	Create(ctx context.Context, c *Category) error
+	// Resolve returns the canonical name of the category whose name or
+	// alias matches name case-insensitively.
+	Resolve(ctx context.Context, name string) (string, bool, error)
+	AddAlias(ctx context.Context, alias, category string) error
+	// Rename renames a category and rewrites the transactions and budgets
+	// filed under it in one transaction. The old name becomes an alias.
+	Rename(ctx context.Context, from, to string) error
+	// Merge moves the transactions, budgets, subcategories and aliases of
+	// sources to target in one transaction and deletes sources. Budget
+	// limits are added up.
+	Merge(ctx context.Context, sources []string, target string) error
+	// SetParent moves a category under parent, or to the top level when
+	// parent is empty. It reports false when name does not exist.
+	SetParent(ctx context.Context, name, parent string) (bool, error)
+	// Delete reports false when name does not exist.
+	Delete(ctx context.Context, name string) (bool, error)
+	GetByName(ctx context.Context, name string) (Category, bool, error)
+	List(ctx context.Context) ([]Category, error)
+}
+
+// RuleRepository stores categorization rules.
+type RuleRepository interface {
+	Create(ctx context.Context, r *Rule) error
+	// Update reports false when r.ID does not exist.
+	Update(ctx context.Context, r Rule) (bool, error)
+	// Delete reports false when id does not exist.
+	Delete(ctx context.Context, id int) (bool, error)
+	// List returns the rules by priority, lowest first.
+	List(ctx context.Context) ([]Rule, error)
+}
+
+type PayeeRepository interface {
+	Create(ctx context.Context, p *Payee) error
+	// Ensure returns the payee named name case-insensitively, creating it
+	// when there is none.
+	Ensure(ctx context.Context, name string) (Payee, error)
+	Get(ctx context.Context, id int) (Payee, bool, error)
+	GetByName(ctx context.Context, name string) (Payee, bool, error)
+	List(ctx context.Context) ([]Payee, error)
+}
+
+type RecurringRepository interface {
+	Create(ctx context.Context, r *RecurringTransaction) error
+	// Update reports false when r.ID does not exist.
+	Update(ctx context.Context, r RecurringTransaction) (bool, error)
+	// Delete reports false when id does not exist.
+	Delete(ctx context.Context, id int) (bool, error)
+	Get(ctx context.Context, id int) (RecurringTransaction, bool, error)
+	List(ctx context.Context) ([]RecurringTransaction, error)
+	// ListDue returns the recurring transactions with an occurrence due on
+	// or before asOf.
+	ListDue(ctx context.Context, asOf time.Time) ([]RecurringTransaction, error)
+	// Advance moves next from from to to, which is zero when the schedule
+	// is over. It reports false when next is no longer from, i.e. someone
+	// else advanced it first.
+	Advance(ctx context.Context, id int, from, to time.Time, lastError string) (bool, error)
+}
+
+// ReconciliationRepository stores the reconciliations of accounts against
+// bank statements.
+type ReconciliationRepository interface {
+	// Create returns ErrReconciliationOpen when r's account has an open
+	// reconciliation.
+	Create(ctx context.Context, r *Reconciliation) error
+	Get(ctx context.Context, id int) (Reconciliation, bool, error)
+	// List returns every reconciliation, latest statement first.
+	List(ctx context.Context) ([]Reconciliation, error)
+	// LastCompleted returns the completed reconciliation of account with
+	// the latest statement date.
+	LastCompleted(ctx context.Context, account string) (Reconciliation, bool, error)
+}
+
+// RefundRepository stores refunds of transactions.
+type RefundRepository interface {
+	// Create locks the original transaction, checks r against it and its
+	// earlier refunds with CheckRefund and stores r. It returns
+	// ErrTransactionNotFound when the original does not exist.
+	Create(ctx context.Context, r *Refund) error
+	// ListByTransaction returns the refunds of a transaction, oldest
+	// first.
+	ListByTransaction(ctx context.Context, transactionID int) ([]Refund, error)
+}
+
+// WebhookRepository stores webhook subscriptions and the log of their
+// deliveries.
+type WebhookRepository interface {
+	Create(ctx context.Context, s *WebhookSubscription) error
+	// Delete reports false when id does not exist.
+	Delete(ctx context.Context, id int) (bool, error)
+	Get(ctx context.Context, id int) (WebhookSubscription, bool, error)
+	List(ctx context.Context) ([]WebhookSubscription, error)
+	// ListByEvent returns the subscriptions to events of type e.
+	ListByEvent(ctx context.Context, e EventType) ([]WebhookSubscription, error)
+	LogDelivery(ctx context.Context, d *WebhookDelivery) error
+	// ListDeliveries returns the latest limit deliveries to a
+	// subscription, latest first.
+	ListDeliveries(ctx context.Context, subscriptionID, limit int) ([]WebhookDelivery, error)
+}
+
+// NotificationRepository stores who gets the ledger's emails.
+type NotificationRepository interface {
+	// Save creates r, or updates the recipient with the same email.
+	Save(ctx context.Context, r *NotificationRecipient) error
+	// Delete reports false when id does not exist.
+	Delete(ctx context.Context, id int) (bool, error)
+	List(ctx context.Context) ([]NotificationRecipient, error)
+	// ListOptedIn returns the recipients who want kind.
+	ListOptedIn(ctx context.Context, kind NotificationKind) ([]NotificationRecipient, error)
+}
+
+// OutboxRepository gives the relay the outbox messages to publish.
+type OutboxRepository interface {
+	// Relay locks up to limit unpublished messages, oldest first and
+	// skipping the ones another relay holds, and passes them to publish.
+	// They are marked published only if publish succeeds. It returns how
+	// many were published.
+	Relay(ctx context.Context, limit int, publish func(context.Context, []OutboxMessage) error) (int, error)
+	// Write stores e in the outbox on its own, for events that come with
+	// no change to store, e.g. a rejected transaction.
+	Write(ctx context.Context, e Event) error
+	// Purge deletes the messages published before before.
+	Purge(ctx context.Context, before time.Time) (int64, error)
+}
+
+// DuplicateRepository stores what was decided about suspected duplicates.
+type DuplicateRepository interface {
+	// ListDismissed returns the pairs of transactions that are not
+	// duplicates, lower ID first.
+	ListDismissed(ctx context.Context) ([][2]int, error)
+	// Dismiss records that no two of ids are duplicates and clears their
+	// DuplicateOf. It returns ErrTransactionNotFound when one of ids does
+	// not exist.
+	Dismiss(ctx context.Context, ids []int) error
+	// Merge deletes duplicates in one transaction, moving their tags,
+	// attachments and refunds to keep, and returns the dates whose spend
+	// changed. It returns ErrTransactionNotFound when one of the
+	// transactions does not exist, ErrTransactionLocked when one of
+	// duplicates is reconciled and the error of CheckRefund when keep
+	// cannot take one of their refunds.
+	Merge(ctx context.Context, keep int, duplicates []int) ([]time.Time, error)
+}
+
+// AggregateRepository maintains the daily per-category totals reports are
+// served from.
+type AggregateRepository interface {
+	// Rebuild recomputes every daily total from the transactions.
+	Rebuild(ctx context.Context) error
+	// Check compares the daily totals with the transactions and returns
+	// every difference.
+	Check(ctx context.Context) ([]AggregateMismatch, error)
+}
+
+// AttachmentRepository stores attachment metadata. The files themselves
+// are in a blob store.
+type AttachmentRepository interface {
+	// Create returns ErrTransactionNotFound when a.TransactionID does not
+	// exist.
+	Create(ctx context.Context, a *Attachment) error
+	Get(ctx context.Context, id int) (Attachment, bool, error)
+	ListByTransaction(ctx context.Context, transactionID int) ([]Attachment, error)
+}
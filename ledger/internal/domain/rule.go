@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Rule categorizes transactions. Every condition that is set must match;
+// the actions then fill in the transaction.
+type Rule struct {
+	ID   int
+	Name string
+	// Priority orders rules, lowest first. A field set by one rule is not
+	// changed by later ones; tags of every matching rule are added.
+	Priority int
+
+	// DescriptionContains matches a substring, ignoring case.
+	DescriptionContains string
+	// DescriptionRegex uses Go regexp syntax; prefix (?i) to ignore case.
+	DescriptionRegex string
+	// MinAmount and MaxAmount are inclusive; zero means no bound.
+	MinAmount float64
+	MaxAmount float64
+	// Payee matches the payee name, ignoring case.
+	Payee string
+
+	SetCategory    string
+	SetTags        []string
+	SetDescription string
+}
+
+func (r Rule) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return errors.New("validation failed: rule name cannot be empty")
+	}
+	if r.DescriptionContains == "" && r.DescriptionRegex == "" &&
+		r.MinAmount == 0 && r.MaxAmount == 0 && r.Payee == "" {
+		return errors.New("validation failed: rule needs at least one condition")
+	}
+	if r.SetCategory == "" && len(r.SetTags) == 0 && r.SetDescription == "" {
+		return errors.New("validation failed: rule needs at least one action")
+	}
+	if r.MinAmount < 0 || r.MaxAmount < 0 {
+		return errors.New("validation failed: rule amounts cannot be negative")
+	}
+	if r.MaxAmount != 0 && r.MinAmount > r.MaxAmount {
+		return errors.New("validation failed: rule min amount is above max amount")
+	}
+	if r.DescriptionRegex != "" {
+		if _, err := regexp.Compile(r.DescriptionRegex); err != nil {
+			return fmt.Errorf("validation failed: invalid description regex: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// RuleSet applies rules in priority order.
+type RuleSet struct {
+	rules []compiledRule
+}
+
+type compiledRule struct {
+	Rule
+	re *regexp.Regexp
+}
+
+// NewRuleSet compiles rules. Rules that fail to compile are an error, as
+// they should have been rejected by Validate.
+func NewRuleSet(rules []Rule) (RuleSet, error) {
+	sorted := slices.Clone(rules)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Priority != sorted[j].Priority {
+			return sorted[i].Priority < sorted[j].Priority
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	set := RuleSet{rules: make([]compiledRule, 0, len(sorted))}
+	for _, r := range sorted {
+		c := compiledRule{Rule: r}
+		if r.DescriptionRegex != "" {
+			re, err := regexp.Compile(r.DescriptionRegex)
+			if err != nil {
+				return RuleSet{}, fmt.Errorf("rule %d: %w", r.ID, err)
+			}
+			c.re = re
+		}
+		set.rules = append(set.rules, c)
+	}
+
+	return set, nil
+}
+
+// Apply runs the rules against tx and returns the IDs of those that
+// matched. Conditions see tx as it was passed in, not as changed by
+// earlier rules. The category of a split transaction is left alone.
+func (s RuleSet) Apply(tx *Transaction) []int {
+	original := *tx
+
+	var (
+		matched        []int
+		setCategory    bool
+		setDescription bool
+	)
+	for _, r := range s.rules {
+		if !r.matches(original) {
+			continue
+		}
+		matched = append(matched, r.ID)
+
+		if r.SetCategory != "" && !setCategory && len(tx.Splits) == 0 {
+			tx.Category = r.SetCategory
+			setCategory = true
+		}
+		if r.SetDescription != "" && !setDescription {
+			tx.Description = r.SetDescription
+			setDescription = true
+		}
+		for _, tag := range r.SetTags {
+			if !slices.Contains(tx.Tags, tag) {
+				// Clip so the caller's backing array is never written to.
+				tx.Tags = append(slices.Clip(tx.Tags), tag)
+			}
+		}
+	}
+
+	return matched
+}
+
+func (r compiledRule) matches(tx Transaction) bool {
+	if r.DescriptionContains != "" &&
+		!strings.Contains(strings.ToLower(tx.Description), strings.ToLower(r.DescriptionContains)) {
+		return false
+	}
+	if r.re != nil && !r.re.MatchString(tx.Description) {
+		return false
+	}
+	if r.MinAmount != 0 && tx.Amount < r.MinAmount {
+		return false
+	}
+	if r.MaxAmount != 0 && tx.Amount > r.MaxAmount {
+		return false
+	}
+	if r.Payee != "" && !strings.EqualFold(r.Payee, tx.Payee) {
+		return false
+	}
+
+	return true
+}
+
+// RuleChange is what re-applying the rules changes on a stored
+// transaction.
+type RuleChange struct {
+	TransactionID int
+	RuleIDs       []int
+	Before        Transaction
+	After         Transaction
+	// CategoryKept is set when a rule would have changed the category of
+	// a split transaction. It keeps that of its largest line.
+	CategoryKept bool
+}
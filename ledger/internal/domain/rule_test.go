@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRule_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    Rule
+		wantErr bool
+	}{
+		{
+			name:    "valid rule",
+			rule:    Rule{Name: "coffee", DescriptionContains: "starbucks", SetCategory: "coffee"},
+			wantErr: false,
+		},
+		{
+			name:    "no condition",
+			rule:    Rule{Name: "coffee", SetCategory: "coffee"},
+			wantErr: true,
+		},
+		{
+			name:    "no action",
+			rule:    Rule{Name: "coffee", Payee: "Starbucks"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid regex",
+			rule:    Rule{Name: "coffee", DescriptionRegex: "(", SetCategory: "coffee"},
+			wantErr: true,
+		},
+		{
+			name:    "min above max",
+			rule:    Rule{Name: "big", MinAmount: 100, MaxAmount: 10, SetTags: []string{"big"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error=%v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRuleSet_Apply(t *testing.T) {
+	set, err := NewRuleSet([]Rule{
+		{ID: 1, Priority: 20, DescriptionContains: "uber", SetCategory: "transport", SetTags: []string{"travel"}},
+		{ID: 2, Priority: 10, DescriptionRegex: `(?i)^uber eats`, SetCategory: "food"},
+		{ID: 3, Priority: 30, MinAmount: 100, SetTags: []string{"large"}},
+		{ID: 4, Priority: 5, Payee: "lidl", SetCategory: "groceries"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tx := Transaction{Amount: 120, Description: "UBER EATS order", Tags: []string{"travel"}}
+	matched := set.Apply(&tx)
+
+	if !slices.Equal(matched, []int{2, 1, 3}) {
+		t.Fatalf("unexpected matched rules: %v", matched)
+	}
+	if tx.Category != "food" {
+		t.Fatalf("expected the higher priority category, got %q", tx.Category)
+	}
+	if !slices.Equal(tx.Tags, []string{"travel", "large"}) {
+		t.Fatalf("unexpected tags: %v", tx.Tags)
+	}
+
+	split := Transaction{
+		Amount:   40,
+		Category: "misc",
+		Payee:    "Lidl",
+		Splits:   []Split{{Category: "groceries", Amount: 30}, {Category: "household", Amount: 10}},
+	}
+	if matched := set.Apply(&split); len(matched) != 1 || split.Category != "misc" {
+		t.Fatalf("unexpected result for a split transaction: %v %q", matched, split.Category)
+	}
+}
@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Granularity is the width of a time bucket in a spending series. The
+// values match PostgreSQL date_trunc field names.
+type Granularity string
+
+const (
+	GranularityDay     Granularity = "day"
+	GranularityWeek    Granularity = "week"
+	GranularityMonth   Granularity = "month"
+	GranularityQuarter Granularity = "quarter"
+	GranularityYear    Granularity = "year"
+)
+
+// MaxSeriesBuckets caps how many buckets a single series may span.
+const MaxSeriesBuckets = 1000
+
+func ParseGranularity(s string) (Granularity, error) {
+	switch g := Granularity(s); g {
+	case GranularityDay, GranularityWeek, GranularityMonth, GranularityQuarter, GranularityYear:
+		return g, nil
+	}
+	return "", errors.New("validation failed: granularity should be one of day, week, month, quarter, year")
+}
+
+// Truncate returns the start of the bucket containing t, the same way
+// date_trunc does: weeks start on Monday, quarters in Jan/Apr/Jul/Oct.
+func (g Granularity) Truncate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	switch g {
+	case GranularityWeek:
+		offset := (int(t.Weekday()) + 6) % 7
+		return time.Date(y, m, d-offset, 0, 0, 0, 0, t.Location())
+	case GranularityMonth:
+		return time.Date(y, m, 1, 0, 0, 0, 0, t.Location())
+	case GranularityQuarter:
+		return time.Date(y, m-(m-1)%3, 1, 0, 0, 0, 0, t.Location())
+	case GranularityYear:
+		return time.Date(y, time.January, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+	}
+}
+
+// Next returns the start of the bucket after the one starting at start.
+func (g Granularity) Next(start time.Time) time.Time {
+	switch g {
+	case GranularityWeek:
+		return start.AddDate(0, 0, 7)
+	case GranularityMonth:
+		return start.AddDate(0, 1, 0)
+	case GranularityQuarter:
+		return start.AddDate(0, 3, 0)
+	case GranularityYear:
+		return start.AddDate(1, 0, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// Prev returns the start of the bucket before the one starting at start.
+func (g Granularity) Prev(start time.Time) time.Time {
+	switch g {
+	case GranularityWeek:
+		return start.AddDate(0, 0, -7)
+	case GranularityMonth:
+		return start.AddDate(0, -1, 0)
+	case GranularityQuarter:
+		return start.AddDate(0, -3, 0)
+	case GranularityYear:
+		return start.AddDate(-1, 0, 0)
+	default:
+		return start.AddDate(0, 0, -1)
+	}
+}
+
+// Buckets lists the start of every bucket between from and to, inclusive.
+func (g Granularity) Buckets(from, to time.Time) []time.Time {
+	var res []time.Time
+	for b := g.Truncate(from); !b.After(to); b = g.Next(b) {
+		res = append(res, b)
+	}
+	return res
+}
+
+type SeriesQuery struct {
+	From        time.Time
+	To          time.Time
+	Granularity Granularity
+	Categories  []string
+}
+
+func (q SeriesQuery) Validate() error {
+	if _, err := ParseGranularity(string(q.Granularity)); err != nil {
+		return err
+	}
+	if q.To.Before(q.From) {
+		return errors.New("validation failed: from should not be after to")
+	}
+	if len(q.Granularity.Buckets(q.From, q.To)) > MaxSeriesBuckets {
+		return errors.New("validation failed: range has too many buckets for this granularity")
+	}
+	return nil
+}
+
+// BucketTotal is the spend of one category within one time bucket.
+type BucketTotal struct {
+	Category string
+	Start    time.Time
+	Amount   float64
+}
+
+// SpendingSeries holds one zero-filled amount per bucket for each category.
+type SpendingSeries struct {
+	Granularity Granularity
+	Buckets     []time.Time
+	Categories  []CategorySeries
+}
+
+type CategorySeries struct {
+	Category string
+	Amounts  []float64
+}
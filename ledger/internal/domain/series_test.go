@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGranularity_Buckets(t *testing.T) {
+	day := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name        string
+		granularity Granularity
+		from, to    time.Time
+		want        []time.Time
+	}{
+		{
+			name:        "days",
+			granularity: GranularityDay,
+			from:        day(2026, 2, 27),
+			to:          day(2026, 3, 1),
+			want:        []time.Time{day(2026, 2, 27), day(2026, 2, 28), day(2026, 3, 1)},
+		},
+		{
+			name:        "weeks start on monday",
+			granularity: GranularityWeek,
+			from:        day(2026, 3, 4),
+			to:          day(2026, 3, 15),
+			want:        []time.Time{day(2026, 3, 2), day(2026, 3, 9)},
+		},
+		{
+			name:        "quarters",
+			granularity: GranularityQuarter,
+			from:        day(2026, 2, 10),
+			to:          day(2026, 8, 1),
+			want:        []time.Time{day(2026, 1, 1), day(2026, 4, 1), day(2026, 7, 1)},
+		},
+		{
+			name:        "years",
+			granularity: GranularityYear,
+			from:        day(2025, 6, 1),
+			to:          day(2026, 1, 1),
+			want:        []time.Time{day(2025, 1, 1), day(2026, 1, 1)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.granularity.Buckets(tt.from, tt.to)
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Fatalf("expected %v, got %v", tt.want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestSeriesQuery_Validate(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		query   SeriesQuery
+		wantErr bool
+	}{
+		{
+			name:    "valid query",
+			query:   SeriesQuery{From: from, To: from.AddDate(0, 6, 0), Granularity: GranularityMonth},
+			wantErr: false,
+		},
+		{
+			name:    "unknown granularity",
+			query:   SeriesQuery{From: from, To: from, Granularity: "hour"},
+			wantErr: true,
+		},
+		{
+			name:    "from after to",
+			query:   SeriesQuery{From: from, To: from.AddDate(0, 0, -1), Granularity: GranularityDay},
+			wantErr: true,
+		},
+		{
+			name:    "too many buckets",
+			query:   SeriesQuery{From: from, To: from.AddDate(5, 0, 0), Granularity: GranularityDay},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.query.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error=%v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
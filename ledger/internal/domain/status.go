@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrStatusChange is returned when a transaction cannot move from its
+// status to the one asked for.
+var ErrStatusChange = errors.New("invalid status change")
+
+// TransactionStatus is where a transaction is in its life: pending until
+// it shows on a bank statement, cleared once it does and reconciled once
+// a reconciliation locked it. A voided transaction is kept for the record
+// but counts towards no budget or report.
+type TransactionStatus string
+
+const (
+	StatusPending    TransactionStatus = "pending"
+	StatusCleared    TransactionStatus = "cleared"
+	StatusReconciled TransactionStatus = "reconciled"
+	StatusVoided     TransactionStatus = "voided"
+)
+
+func ParseTransactionStatus(s string) (TransactionStatus, error) {
+	switch st := TransactionStatus(strings.ToLower(strings.TrimSpace(s))); st {
+	case StatusPending, StatusCleared, StatusReconciled, StatusVoided:
+		return st, nil
+	default:
+		return "", fmt.Errorf("validation failed: unknown transaction status %q", s)
+	}
+}
+
+// CanBecome reports whether a transaction with status s can be moved to
+// to. Pending and cleared go back and forth and can be voided; only a
+// cleared transaction is reconciled. Reconciled and voided are final.
+func (s TransactionStatus) CanBecome(to TransactionStatus) bool {
+	switch s {
+	case StatusPending:
+		return to == StatusCleared || to == StatusVoided
+	case StatusCleared:
+		return to == StatusPending || to == StatusVoided || to == StatusReconciled
+	default:
+		return false
+	}
+}
+
+// StatusChange is one entry of the status history of a transaction. From
+// is empty for the status it was created with.
+type StatusChange struct {
+	TransactionID int
+	From          TransactionStatus
+	To            TransactionStatus
+	ChangedAt     time.Time
+}
+
+// BudgetPolicy decides what counts towards a budget when a transaction is
+// added.
+type BudgetPolicy struct {
+	// CountPending counts pending transactions along with cleared and
+	// reconciled ones. Without it a pending transaction is not checked
+	// against budgets at all, and only counts once it clears.
+	CountPending bool
+}
+
+func DefaultBudgetPolicy() BudgetPolicy {
+	return BudgetPolicy{CountPending: true}
+}
+
+// Counts reports whether spend in status s counts towards budgets.
+func (p BudgetPolicy) Counts(s TransactionStatus) bool {
+	switch s {
+	case StatusVoided:
+		return false
+	case StatusPending:
+		return p.CountPending
+	default:
+		return true
+	}
+}
+
+// Statuses returns the statuses that count towards budgets, or nil when
+// every status but voided does.
+func (p BudgetPolicy) Statuses() []TransactionStatus {
+	if p.CountPending {
+		return nil
+	}
+	return []TransactionStatus{StatusCleared, StatusReconciled}
+}
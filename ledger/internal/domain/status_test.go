@@ -0,0 +1,41 @@
+package domain
+
+import "testing"
+
+func TestTransactionStatus_CanBecome(t *testing.T) {
+	tests := []struct {
+		from, to TransactionStatus
+		want     bool
+	}{
+		{StatusPending, StatusCleared, true},
+		{StatusCleared, StatusPending, true},
+		{StatusPending, StatusVoided, true},
+		{StatusCleared, StatusVoided, true},
+		{StatusCleared, StatusReconciled, true},
+		{StatusPending, StatusReconciled, false},
+		{StatusReconciled, StatusCleared, false},
+		{StatusReconciled, StatusVoided, false},
+		{StatusVoided, StatusPending, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.from.CanBecome(tt.to); got != tt.want {
+			t.Errorf("%s -> %s: CanBecome = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestBudgetPolicy_Counts(t *testing.T) {
+	counting := DefaultBudgetPolicy()
+	cleared := BudgetPolicy{CountPending: false}
+
+	if !counting.Counts(StatusPending) || cleared.Counts(StatusPending) {
+		t.Fatal("pending must only count when CountPending is set")
+	}
+	if counting.Counts(StatusVoided) || cleared.Counts(StatusVoided) {
+		t.Fatal("voided must never count")
+	}
+	if !cleared.Counts(StatusCleared) || !cleared.Counts(StatusReconciled) {
+		t.Fatal("cleared and reconciled must always count")
+	}
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const maxTagLength = 64
+
+// NormalizeTag lowercases a tag and joins its words with dashes, so
+// "Vacation 2026" and " vacation  2026" are the same tag.
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.Join(strings.Fields(tag), "-"))
+}
+
+// NormalizeTags normalizes every tag, drops empty ones and duplicates and
+// sorts the rest.
+func NormalizeTags(tags []string) []string {
+	res := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if t := NormalizeTag(tag); t != "" {
+			res = append(res, t)
+		}
+	}
+	slices.Sort(res)
+	return slices.Compact(res)
+}
+
+// ValidateTag accepts normalized tags made of letters, digits and the
+// separators - _ . / :
+func ValidateTag(tag string) error {
+	if tag == "" {
+		return errors.New("validation failed: tag cannot be empty")
+	}
+	if len(tag) > maxTagLength {
+		return errors.New("validation failed: tag is too long")
+	}
+	for _, r := range tag {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("-_./:", r) {
+			continue
+		}
+		return errors.New("validation failed: tag " + tag + " has invalid characters")
+	}
+	return nil
+}
+
+// TransactionFilter narrows ListTransactions. A transaction must carry
+// every tag in Tags and, when Statuses is set, be in one of them to match.
+type TransactionFilter struct {
+	Tags     []string
+	Statuses []TransactionStatus
+}
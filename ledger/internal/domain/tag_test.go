@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	got := NormalizeTags([]string{"Vacation 2026", " vacation  2026 ", "Business", "", "  "})
+	want := []string{"business", "vacation-2026"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestValidateTag(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     string
+		wantErr bool
+	}{
+		{name: "plain", tag: "business", wantErr: false},
+		{name: "separators", tag: "trip/2026-05:rome_v1.2", wantErr: false},
+		{name: "non-latin letters", tag: "отпуск", wantErr: false},
+		{name: "empty", tag: "", wantErr: true},
+		{name: "comma", tag: "a,b", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTag(tt.tag)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error=%v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -2,15 +2,67 @@ package domain
 
 import (
 	"errors"
+	"math"
 	"time"
 )
 
+// Split is one line of a transaction spread over several categories.
+type Split struct {
+	Category string
+	Amount   float64
+}
+
 type Transaction struct {
 	ID          int
 	Amount      float64
 	Category    string
 	Description string
 	Date        time.Time
+	Tags        []string
+
+	// Splits spread Amount over several categories. When set, Category
+	// is only the category of the largest line, and budgets and reports
+	// use the lines.
+	Splits []Split
+
+	// RecurringID is the recurring transaction this one is an occurrence
+	// of, or zero.
+	RecurringID int
+
+	// PayeeID is the payee the transaction was paid to, or zero. Payee is
+	// its name; on new transactions a name without an ID is looked up and
+	// created if needed.
+	PayeeID int
+	Payee   string
+
+	// Account is the bank account the transaction was paid from; empty
+	// is the default account.
+	Account string
+	// Status is pending for a new transaction unless it is added as
+	// cleared, and StatusChangedAt when it last changed.
+	// ReconciliationID is the reconciliation that locked it, or zero.
+	Status           TransactionStatus
+	StatusChangedAt  time.Time
+	ReconciliationID int
+
+	// Refunded is how much of the transaction was refunded. It is
+	// computed from its refunds, not stored.
+	Refunded float64
+
+	// DuplicateOf is the earlier transaction this one looked like when it
+	// was stored under the flag policy, or zero.
+	DuplicateOf int
+	// DuplicatePolicy overrides the configured duplicate policy for this
+	// write when set. It is not stored.
+	DuplicatePolicy DuplicatePolicy
+
+	// Anomaly is set when the amount is unusual for the category. It is
+	// computed from history, not stored.
+	Anomaly *Anomaly
+
+	// Suggestion is set when the category was missing and filled in by
+	// the classifier. It is not stored.
+	Suggestion *CategorySuggestion
 }
 
 func (tx Transaction) Validate() error {
@@ -23,5 +75,53 @@ func (tx Transaction) Validate() error {
 		return errors.New("validation failed: budget category cannot be empty")
 	}
 
+	for _, tag := range tx.Tags {
+		if err := ValidateTag(tag); err != nil {
+			return err
+		}
+	}
+
+	if len(tx.Splits) == 0 {
+		return nil
+	}
+	if len(tx.Splits) < 2 {
+		return errors.New("validation failed: a split transaction needs at least two lines")
+	}
+
+	var sum float64
+	for _, s := range tx.Splits {
+		if s.Amount <= 0 {
+			return errors.New("validation failed: split amount should be > 0")
+		}
+		if s.Category == "" {
+			return errors.New("validation failed: split category cannot be empty")
+		}
+		sum += s.Amount
+	}
+	if math.Abs(sum-tx.Amount) >= 0.005 {
+		return errors.New("validation failed: split amounts must add up to the transaction amount")
+	}
+
 	return nil
 }
+
+// Lines returns the category amounts of tx: its splits, or the whole
+// amount in its category.
+func (tx Transaction) Lines() []Split {
+	if len(tx.Splits) > 0 {
+		return tx.Splits
+	}
+	return []Split{{Category: tx.Category, Amount: tx.Amount}}
+}
+
+// MainCategory is the category of the largest split line, the first one
+// on ties.
+func MainCategory(splits []Split) string {
+	var main Split
+	for _, s := range splits {
+		if s.Amount > main.Amount {
+			main = s
+		}
+	}
+	return main.Category
+}
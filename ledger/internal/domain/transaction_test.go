@@ -35,6 +35,39 @@ func TestTransaction_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid splits",
+			tx: Transaction{
+				Amount:   42.5,
+				Category: "groceries",
+				Splits: []Split{
+					{Category: "groceries", Amount: 30},
+					{Category: "household", Amount: 12.5},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "splits not adding up",
+			tx: Transaction{
+				Amount:   42.5,
+				Category: "groceries",
+				Splits: []Split{
+					{Category: "groceries", Amount: 30},
+					{Category: "household", Amount: 10},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "single split",
+			tx: Transaction{
+				Amount:   30,
+				Category: "groceries",
+				Splits:   []Split{{Category: "groceries", Amount: 30}},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
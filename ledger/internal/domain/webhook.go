@@ -0,0 +1,231 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// EventType names something that happened in the ledger that webhooks
+// can subscribe to.
+type EventType string
+
+const (
+	// EventTransactionCreated is sent for every transaction added,
+	// imported or posted from a recurring one.
+	EventTransactionCreated EventType = "transaction.created"
+	// EventBudgetExceeded is sent when a transaction is rejected because
+	// it would take a budget over its limit.
+	EventBudgetExceeded EventType = "budget.exceeded"
+	// EventBudgetThresholdCrossed is sent when a transaction takes the
+	// spend of a budget over the threshold of a subscription.
+	EventBudgetThresholdCrossed EventType = "budget.threshold_crossed"
+	// EventImportCompleted is sent once an import finished.
+	EventImportCompleted EventType = "import.completed"
+	// EventBudgetUpdated is sent when a budget is set or its limit
+	// changed.
+	EventBudgetUpdated EventType = "budget.updated"
+)
+
+var EventTypes = []EventType{
+	EventTransactionCreated,
+	EventBudgetExceeded,
+	EventBudgetThresholdCrossed,
+	EventImportCompleted,
+	EventBudgetUpdated,
+}
+
+func ParseEventType(s string) (EventType, error) {
+	e := EventType(strings.ToLower(strings.TrimSpace(s)))
+	if !slices.Contains(EventTypes, e) {
+		return "", fmt.Errorf("validation failed: unknown event %q", s)
+	}
+	return e, nil
+}
+
+// Event is what a webhook receives, as JSON. Data is one of
+// TransactionEvent, BudgetEvent, ImportEvent or BudgetLimitEvent
+// depending on Type.
+type Event struct {
+	ID        string    `json:"id"`
+	Type      EventType `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+// NewEvent returns an event of type t that happened now, with a random ID
+// receivers can tell redeliveries apart by.
+func NewEvent(t EventType, data any) Event {
+	id := make([]byte, 16)
+	_, _ = rand.Read(id)
+
+	return Event{
+		ID:        hex.EncodeToString(id),
+		Type:      t,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	}
+}
+
+type TransactionEvent struct {
+	ID          int               `json:"id"`
+	Amount      float64           `json:"amount"`
+	Category    string            `json:"category"`
+	Description string            `json:"description"`
+	Date        string            `json:"date"`
+	Payee       string            `json:"payee,omitempty"`
+	Account     string            `json:"account,omitempty"`
+	Status      TransactionStatus `json:"status"`
+	Tags        []string          `json:"tags,omitempty"`
+}
+
+func NewTransactionEvent(t Transaction) TransactionEvent {
+	return TransactionEvent{
+		ID:          t.ID,
+		Amount:      t.Amount,
+		Category:    t.Category,
+		Description: t.Description,
+		Date:        t.Date.Format("2006-01-02"),
+		Payee:       t.Payee,
+		Account:     t.Account,
+		Status:      t.Status,
+		Tags:        t.Tags,
+	}
+}
+
+// BudgetEvent is the spend of a budget before and after a transaction.
+// For budget.exceeded the transaction was rejected, so After is what the
+// spend would have been.
+type BudgetEvent struct {
+	Category string  `json:"category"`
+	Limit    float64 `json:"limit"`
+	Before   float64 `json:"spent_before"`
+	After    float64 `json:"spent_after"`
+	// Amount is what the transaction added to the budget's category and
+	// the categories under it.
+	Amount float64 `json:"amount"`
+}
+
+// PercentUsed returns how much of the limit spent is, in percent.
+func (e BudgetEvent) PercentUsed(spent float64) float64 {
+	if e.Limit <= 0 {
+		return 0
+	}
+	return spent / e.Limit * 100
+}
+
+// Crosses reports whether the transaction took the spend from below
+// threshold percent of the limit to at least that.
+func (e BudgetEvent) Crosses(threshold float64) bool {
+	return e.PercentUsed(e.Before) < threshold && e.PercentUsed(e.After) >= threshold
+}
+
+type ImportEvent struct {
+	Accepted int `json:"accepted"`
+	Rejected int `json:"rejected"`
+}
+
+type BudgetLimitEvent struct {
+	Category string  `json:"category"`
+	Limit    float64 `json:"limit"`
+}
+
+// DefaultWebhookThreshold is the percentage of a budget a subscription is
+// told about unless it picks another one.
+const DefaultWebhookThreshold = 80
+
+// WebhookSubscription is an HTTP endpoint notified of ledger events.
+// Deliveries are signed with Secret.
+type WebhookSubscription struct {
+	ID     int
+	URL    string
+	Secret string
+	Events []EventType
+	// MinAmount leaves out transaction.created for smaller transactions.
+	MinAmount float64
+	// Threshold is the percentage of a budget whose crossing sends
+	// budget.threshold_crossed.
+	Threshold float64
+	CreatedAt time.Time
+}
+
+func (s WebhookSubscription) Validate() error {
+	u, err := url.Parse(s.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("validation failed: webhook url must be an absolute http or https url")
+	}
+	if s.Secret == "" {
+		return errors.New("validation failed: webhook secret is required")
+	}
+	if len(s.Events) == 0 {
+		return errors.New("validation failed: at least one event is required")
+	}
+	for _, e := range s.Events {
+		if !slices.Contains(EventTypes, e) {
+			return fmt.Errorf("validation failed: unknown event %q", e)
+		}
+	}
+	if s.MinAmount < 0 {
+		return errors.New("validation failed: min amount should be >= 0")
+	}
+	if s.Threshold <= 0 || s.Threshold > 100 {
+		return errors.New("validation failed: threshold should be > 0 and <= 100")
+	}
+
+	return nil
+}
+
+// Matches reports whether s wants to be told about e.
+func (s WebhookSubscription) Matches(e Event) bool {
+	if !slices.Contains(s.Events, e.Type) {
+		return false
+	}
+
+	switch data := e.Data.(type) {
+	case TransactionEvent:
+		return data.Amount >= s.MinAmount
+	case BudgetEvent:
+		if e.Type != EventBudgetThresholdCrossed {
+			return true
+		}
+		return data.Crosses(s.Threshold)
+	default:
+		return true
+	}
+}
+
+// WebhookDelivery is one attempt to deliver an event to a subscription.
+type WebhookDelivery struct {
+	ID             int
+	SubscriptionID int
+	EventID        string
+	EventType      EventType
+	Attempt        int
+	// StatusCode is zero when no response came back; Error says why.
+	StatusCode int
+	Error      string
+	Succeeded  bool
+	Duration   time.Duration
+	CreatedAt  time.Time
+}
+
+// EventPublisher is told about ledger events after they happened. It must
+// not block the caller.
+type EventPublisher interface {
+	Publish(ctx context.Context, e Event)
+}
+
+// EventPublishers publishes every event to each of its publishers.
+type EventPublishers []EventPublisher
+
+func (p EventPublishers) Publish(ctx context.Context, e Event) {
+	for _, publisher := range p {
+		publisher.Publish(ctx, e)
+	}
+}
@@ -0,0 +1,30 @@
+package domain
+
+import "testing"
+
+func TestWebhookSubscription_Matches(t *testing.T) {
+	s := WebhookSubscription{
+		Events:    []EventType{EventTransactionCreated, EventBudgetThresholdCrossed},
+		MinAmount: 100,
+		Threshold: 80,
+	}
+
+	tests := []struct {
+		name string
+		e    Event
+		want bool
+	}{
+		{"large transaction", NewEvent(EventTransactionCreated, TransactionEvent{Amount: 100}), true},
+		{"small transaction", NewEvent(EventTransactionCreated, TransactionEvent{Amount: 99.99}), false},
+		{"crosses threshold", NewEvent(EventBudgetThresholdCrossed, BudgetEvent{Limit: 100, Before: 70, After: 80}), true},
+		{"already over threshold", NewEvent(EventBudgetThresholdCrossed, BudgetEvent{Limit: 100, Before: 80, After: 90}), false},
+		{"under threshold", NewEvent(EventBudgetThresholdCrossed, BudgetEvent{Limit: 100, Before: 10, After: 79}), false},
+		{"not subscribed", NewEvent(EventImportCompleted, ImportEvent{Accepted: 1}), false},
+	}
+
+	for _, tt := range tests {
+		if got := s.Matches(tt.e); got != tt.want {
+			t.Errorf("%s: Matches = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,283 @@
+// Package notify emails budget alerts and a weekly spending digest to the
+// recipients who opted in to them.
+//
+// Every email has a plain text and an HTML body, rendered from the
+// templates in templates/. The text template of an email also defines
+// its subject.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"log/slog"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+//go:embed templates/*.tmpl
+var templates embed.FS
+
+var funcs = map[string]any{
+	"money": func(v float64) string {
+		return strconv.FormatFloat(v, 'f', 2, 64)
+	},
+	"percent": func(v float64) string {
+		return strconv.FormatFloat(v, 'f', 0, 64) + "%"
+	},
+	"date": func(t time.Time) string {
+		return t.Format("Mon Jan 2")
+	},
+}
+
+// email renders one kind of email.
+type email struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+func parseEmail(name string) email {
+	return email{
+		text: texttemplate.Must(texttemplate.New(name+".txt.tmpl").Funcs(funcs).
+			ParseFS(templates, "templates/"+name+".txt.tmpl")),
+		html: htmltemplate.Must(htmltemplate.New(name+".html.tmpl").Funcs(funcs).
+			ParseFS(templates, "templates/"+name+".html.tmpl")),
+	}
+}
+
+func (e email) render(to string, data any) (Message, error) {
+	var subject, text, html bytes.Buffer
+	if err := e.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Message{}, err
+	}
+	if err := e.text.Execute(&text, data); err != nil {
+		return Message{}, err
+	}
+	if err := e.html.Execute(&html, data); err != nil {
+		return Message{}, err
+	}
+
+	return Message{
+		To:      to,
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
+
+type alertData struct {
+	Recipient domain.NotificationRecipient
+	Budget    domain.BudgetEvent
+	// Exceeded is set when the transaction was rejected.
+	Exceeded bool
+	Percent  float64
+}
+
+func (d alertData) Remaining() float64 {
+	return d.Budget.Limit - d.Budget.After
+}
+
+type digestData struct {
+	Recipient domain.NotificationRecipient
+	Digest    domain.Digest
+}
+
+type Config struct {
+	// Threshold is the percentage of a budget whose crossing sends an
+	// alert. Exceeding a budget always does.
+	Threshold float64
+	// DigestDay and DigestHour are when the weekly digest goes out, in
+	// local time. It covers the seven days before.
+	DigestDay  time.Weekday
+	DigestHour int
+	// Timeout bounds sending a single email.
+	Timeout time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Threshold:  80,
+		DigestDay:  time.Monday,
+		DigestHour: 8,
+		Timeout:    30 * time.Second,
+	}
+}
+
+// DigestSource makes the digest of a period.
+type DigestSource interface {
+	GetDigest(ctx context.Context, from, to time.Time) (domain.Digest, error)
+}
+
+// Notifier emails budget alerts, as the handler of a stream consumer
+// group or, without a stream, as a domain.EventPublisher. It also sends
+// the weekly digest.
+type Notifier struct {
+	repo   domain.NotificationRepository
+	sender Sender
+	cfg    Config
+	log    *slog.Logger
+	alert  email
+	digest email
+}
+
+func NewNotifier(repo domain.NotificationRepository, sender Sender, cfg Config, logger *slog.Logger) *Notifier {
+	return &Notifier{
+		repo:   repo,
+		sender: sender,
+		cfg:    cfg,
+		log:    logger,
+		alert:  parseEmail("budget_alert"),
+		digest: parseEmail("digest"),
+	}
+}
+
+// Handle emails an alert for budget.exceeded, and for
+// budget.threshold_crossed when the threshold crossed is the alert
+// threshold, before returning. Other events are ignored. As the handler
+// of a stream consumer group it has a failed alert sent again, so
+// recipients who got it the first time may get it twice.
+func (n *Notifier) Handle(ctx context.Context, e domain.Event) error {
+	data, ok := n.alertFor(e)
+	if !ok {
+		return nil
+	}
+	return n.SendAlert(ctx, data.Budget, data.Exceeded)
+}
+
+// Publish runs Handle in the background, for when there is no stream to
+// consume. An alert not sent by the time the ledger stops is lost.
+func (n *Notifier) Publish(ctx context.Context, e domain.Event) {
+	if _, ok := n.alertFor(e); !ok {
+		return
+	}
+
+	go func() {
+		if err := n.Handle(context.WithoutCancel(ctx), e); err != nil {
+			n.log.Error(
+				"budget alert failed",
+				slog.String("event_id", e.ID),
+				slog.String("error", err.Error()),
+			)
+		}
+	}()
+}
+
+func (n *Notifier) alertFor(e domain.Event) (alertData, bool) {
+	b, ok := e.Data.(domain.BudgetEvent)
+	if !ok {
+		return alertData{}, false
+	}
+
+	switch e.Type {
+	case domain.EventBudgetExceeded:
+		return alertData{Budget: b, Exceeded: true}, true
+	case domain.EventBudgetThresholdCrossed:
+		return alertData{Budget: b}, b.Crosses(n.cfg.Threshold)
+	default:
+		return alertData{}, false
+	}
+}
+
+// SendAlert emails b to everyone who opted in to budget alerts. exceeded
+// tells that the transaction was rejected.
+func (n *Notifier) SendAlert(ctx context.Context, b domain.BudgetEvent, exceeded bool) error {
+	return n.send(ctx, domain.NotifyBudgetAlerts, n.alert, func(r domain.NotificationRecipient) any {
+		return alertData{
+			Recipient: r,
+			Budget:    b,
+			Exceeded:  exceeded,
+			Percent:   b.PercentUsed(b.After),
+		}
+	})
+}
+
+// SendDigest emails d to everyone who opted in to the weekly digest.
+func (n *Notifier) SendDigest(ctx context.Context, d domain.Digest) error {
+	return n.send(ctx, domain.NotifyWeeklyDigest, n.digest, func(r domain.NotificationRecipient) any {
+		return digestData{Recipient: r, Digest: d}
+	})
+}
+
+// send emails each recipient of kind separately, so one bad address does
+// not keep the others from getting theirs.
+func (n *Notifier) send(
+	ctx context.Context,
+	kind domain.NotificationKind,
+	tmpl email,
+	data func(domain.NotificationRecipient) any,
+) error {
+	recipients, err := n.repo.ListOptedIn(ctx, kind)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, r := range recipients {
+		msg, err := tmpl.render(r.Email, data(r))
+		if err != nil {
+			return err
+		}
+
+		sendCtx, cancel := context.WithTimeout(ctx, n.cfg.Timeout)
+		err = n.sender.Send(sendCtx, msg)
+		cancel()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", r.Email, err))
+			continue
+		}
+
+		n.log.Info("email sent", slog.String("kind", string(kind)), slog.Int("recipient_id", r.ID))
+	}
+
+	return errors.Join(errs...)
+}
+
+// RunDigest sends the digest of the past week every DigestDay at
+// DigestHour until ctx is done. A digest due while the ledger was down
+// is not sent later.
+func (n *Notifier) RunDigest(ctx context.Context, src DigestSource) {
+	n.log.Info(
+		"weekly digest scheduled",
+		slog.String("day", n.cfg.DigestDay.String()),
+		slog.Int("hour", n.cfg.DigestHour),
+	)
+
+	for {
+		next := nextDigest(time.Now(), n.cfg.DigestDay, n.cfg.DigestHour)
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			n.log.Info("weekly digest stopped")
+			return
+		case <-timer.C:
+		}
+
+		// Report periods include both ends: the seven days before next.
+		d, err := src.GetDigest(ctx, next.AddDate(0, 0, -7), next.AddDate(0, 0, -1))
+		if err == nil {
+			err = n.SendDigest(ctx, d)
+		}
+		if err != nil && ctx.Err() == nil {
+			n.log.Error("weekly digest failed", slog.String("error", err.Error()))
+		}
+	}
+}
+
+// nextDigest returns the first day at hour after now.
+func nextDigest(now time.Time, day time.Weekday, hour int) time.Time {
+	next := time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, now.Location())
+	next = next.AddDate(0, 0, (int(day)-int(next.Weekday())+7)%7)
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 7)
+	}
+	return next
+}
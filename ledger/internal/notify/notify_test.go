@@ -0,0 +1,298 @@
+package notify
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+// smtpStub is a local SMTP server that keeps what it is sent.
+type smtpStub struct {
+	mu   sync.Mutex
+	msgs []stubMessage
+}
+
+type stubMessage struct {
+	From string
+	To   []string
+	Data []byte
+}
+
+func newSMTPStub(t *testing.T) (*smtpStub, string) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	s := &smtpStub{}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s, ln.Addr().String()
+}
+
+func (s *smtpStub) serve(conn net.Conn) {
+	c := textproto.NewConn(conn)
+	defer c.Close()
+
+	_ = c.PrintfLine("220 localhost ESMTP stub")
+	var msg stubMessage
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+
+		cmd := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			_ = c.PrintfLine("250 localhost")
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			msg.From = strings.Trim(line[len("MAIL FROM:"):], "<> ")
+			_ = c.PrintfLine("250 OK")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			msg.To = append(msg.To, strings.Trim(line[len("RCPT TO:"):], "<> "))
+			_ = c.PrintfLine("250 OK")
+		case cmd == "DATA":
+			_ = c.PrintfLine("354 go ahead")
+			data, err := c.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			msg.Data = data
+			s.mu.Lock()
+			s.msgs = append(s.msgs, msg)
+			s.mu.Unlock()
+			msg = stubMessage{}
+			_ = c.PrintfLine("250 OK")
+		case cmd == "QUIT":
+			_ = c.PrintfLine("221 bye")
+			return
+		default:
+			_ = c.PrintfLine("250 OK")
+		}
+	}
+}
+
+func (s *smtpStub) messages() []stubMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]stubMessage(nil), s.msgs...)
+}
+
+type memRecipients []domain.NotificationRecipient
+
+func (r memRecipients) Save(context.Context, *domain.NotificationRecipient) error { return nil }
+func (r memRecipients) Delete(context.Context, int) (bool, error)                 { return false, nil }
+func (r memRecipients) List(context.Context) ([]domain.NotificationRecipient, error) {
+	return r, nil
+}
+
+func (r memRecipients) ListOptedIn(_ context.Context, kind domain.NotificationKind) ([]domain.NotificationRecipient, error) {
+	var res []domain.NotificationRecipient
+	for _, rcpt := range r {
+		if rcpt.Wants(kind) {
+			res = append(res, rcpt)
+		}
+	}
+	return res, nil
+}
+
+var testRecipients = memRecipients{
+	{ID: 1, Name: "Alex", Email: "alex@example.com", BudgetAlerts: true},
+	{ID: 2, Name: "Sam", Email: "sam@example.com", WeeklyDigest: true},
+}
+
+func newTestNotifier(t *testing.T) (*Notifier, *smtpStub) {
+	t.Helper()
+	stub, addr := newSMTPStub(t)
+	sender := NewSMTP(SMTPConfig{Addr: addr, From: "ledger@example.com"})
+	return NewNotifier(testRecipients, sender, DefaultConfig(), slog.New(slog.NewTextHandler(io.Discard, nil))), stub
+}
+
+// parse returns the subject and the text and HTML bodies of data.
+func parse(t *testing.T, data []byte) (subject, text, html string) {
+	t.Helper()
+	msg, err := mail.ReadMessage(strings.NewReader(string(data)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	subject, err = new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		// NextPart undoes the quoted-printable encoding.
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		body, _ := io.ReadAll(p)
+		if strings.HasPrefix(p.Header.Get("Content-Type"), "text/html") {
+			html = string(body)
+		} else {
+			text = string(body)
+		}
+	}
+	return subject, text, html
+}
+
+func TestNotifier_SendAlert(t *testing.T) {
+	n, stub := newTestNotifier(t)
+
+	b := domain.BudgetEvent{Category: "food", Limit: 200, Before: 150, After: 170, Amount: 20}
+	if err := n.SendAlert(context.Background(), b, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	msgs := stub.messages()
+	if len(msgs) != 1 || msgs[0].From != "ledger@example.com" || msgs[0].To[0] != "alex@example.com" {
+		t.Fatalf("expected one email to the alerts recipient, got %+v", msgs)
+	}
+
+	subject, text, html := parse(t, msgs[0].Data)
+	if subject != "Budget alert: food at 85%" {
+		t.Fatalf("unexpected subject %q", subject)
+	}
+	if !strings.Contains(text, "Hi Alex,") || !strings.Contains(text, "170.00 of 200.00 spent, 30.00 left") {
+		t.Fatalf("unexpected text body %q", text)
+	}
+	if !strings.Contains(html, "<strong>food</strong>") {
+		t.Fatalf("unexpected html body %q", html)
+	}
+}
+
+func TestNotifier_SendDigestEscapesHTML(t *testing.T) {
+	n, stub := newTestNotifier(t)
+
+	d := domain.Digest{
+		From:       time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+		To:         time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC),
+		Total:      120,
+		Categories: []domain.CategorySpend{{Category: "<b>fun</b>", Spent: 120}},
+		Budgets:    []domain.BudgetLine{domain.NewBudgetLine(domain.Budget{Category: "<b>fun</b>", Limit: 100}, 120)},
+	}
+	if err := n.SendDigest(context.Background(), d); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	msgs := stub.messages()
+	if len(msgs) != 1 || msgs[0].To[0] != "sam@example.com" {
+		t.Fatalf("expected one email to the digest recipient, got %+v", msgs)
+	}
+
+	subject, text, html := parse(t, msgs[0].Data)
+	if subject != "Your spending Mon Mar 2 - Sun Mar 8" {
+		t.Fatalf("unexpected subject %q", subject)
+	}
+	if !strings.Contains(text, "<b>fun</b>: 120.00 of 100.00 (120%), over budget") {
+		t.Fatalf("unexpected text body %q", text)
+	}
+	if strings.Contains(html, "<b>fun</b>") || !strings.Contains(html, "&lt;b&gt;fun&lt;/b&gt;") {
+		t.Fatalf("expected the category to be escaped, got %q", html)
+	}
+}
+
+func TestNotifier_AlertsOnlyAtThreshold(t *testing.T) {
+	n := NewNotifier(testRecipients, nil, DefaultConfig(), slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	tests := []struct {
+		name string
+		e    domain.Event
+		want bool
+	}{
+		{
+			name: "crossed",
+			e:    domain.NewEvent(domain.EventBudgetThresholdCrossed, domain.BudgetEvent{Limit: 100, Before: 70, After: 85}),
+			want: true,
+		},
+		{
+			name: "already above",
+			e:    domain.NewEvent(domain.EventBudgetThresholdCrossed, domain.BudgetEvent{Limit: 100, Before: 85, After: 90}),
+		},
+		{
+			name: "exceeded",
+			e:    domain.NewEvent(domain.EventBudgetExceeded, domain.BudgetEvent{Limit: 100, Before: 90, After: 120}),
+			want: true,
+		},
+		{
+			name: "other event",
+			e:    domain.NewEvent(domain.EventImportCompleted, domain.ImportEvent{Accepted: 1}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, got := n.alertFor(tt.e); got != tt.want {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNextDigest(t *testing.T) {
+	// 2026-03-04 is a Wednesday.
+	tests := []struct {
+		now  time.Time
+		want time.Time
+	}{
+		{time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC), time.Date(2026, 3, 9, 8, 0, 0, 0, time.UTC)},
+		{time.Date(2026, 3, 9, 7, 59, 0, 0, time.UTC), time.Date(2026, 3, 9, 8, 0, 0, 0, time.UTC)},
+		{time.Date(2026, 3, 9, 8, 0, 0, 0, time.UTC), time.Date(2026, 3, 16, 8, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		if got := nextDigest(tt.now, time.Monday, 8); !got.Equal(tt.want) {
+			t.Fatalf("nextDigest(%v) = %v, want %v", tt.now, got, tt.want)
+		}
+	}
+}
+
+func TestNotifier_Handle(t *testing.T) {
+	n, stub := newTestNotifier(t)
+	ctx := context.Background()
+
+	if err := n.Handle(ctx, domain.NewEvent(domain.EventImportCompleted, domain.ImportEvent{Accepted: 1})); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msgs := stub.messages(); len(msgs) != 0 {
+		t.Fatalf("expected no email for other events, got %d", len(msgs))
+	}
+
+	// Handle returns once the alert was sent.
+	e := domain.NewEvent(domain.EventBudgetExceeded, domain.BudgetEvent{Category: "food", Limit: 100, Before: 90, After: 120})
+	if err := n.Handle(ctx, e); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msgs := stub.messages(); len(msgs) != 1 {
+		t.Fatalf("expected one alert, got %d", len(msgs))
+	}
+}
@@ -0,0 +1,188 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+	"github.com/redis/go-redis/v9"
+)
+
+// memOutbox is an outbox table without the locking.
+type memOutbox struct {
+	msgs      []domain.OutboxMessage
+	published map[int64]bool
+}
+
+func (r *memOutbox) add(t *testing.T, e domain.Event) {
+	t.Helper()
+	if err := r.Write(context.Background(), e); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func (r *memOutbox) Write(_ context.Context, e domain.Event) error {
+	msg, err := domain.NewOutboxMessage(e)
+	if err != nil {
+		return err
+	}
+	msg.ID = int64(len(r.msgs) + 1)
+	r.msgs = append(r.msgs, msg)
+	return nil
+}
+
+func (r *memOutbox) Relay(
+	ctx context.Context,
+	limit int,
+	publish func(context.Context, []domain.OutboxMessage) error,
+) (int, error) {
+	var batch []domain.OutboxMessage
+	for _, msg := range r.msgs {
+		if !r.published[msg.ID] && len(batch) < limit {
+			batch = append(batch, msg)
+		}
+	}
+	if len(batch) == 0 {
+		return 0, nil
+	}
+	if err := publish(ctx, batch); err != nil {
+		return 0, err
+	}
+	if r.published == nil {
+		r.published = make(map[int64]bool)
+	}
+	for _, msg := range batch {
+		r.published[msg.ID] = true
+	}
+	return len(batch), nil
+}
+
+func (r *memOutbox) Purge(context.Context, time.Time) (int64, error) { return 0, nil }
+
+type failingSink struct{}
+
+func (failingSink) Publish(context.Context, []domain.OutboxMessage) error {
+	return errors.New("redis is down")
+}
+
+func newTestRedis(t *testing.T) *redis.Client {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	return rdb
+}
+
+func discard() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func TestRelay_PublishesToConsumerGroups(t *testing.T) {
+	ctx := context.Background()
+	rdb := newTestRedis(t)
+	stream := NewStream(rdb, DefaultStream, 1000)
+
+	cfg := ConsumerConfig{Batch: 10, Block: 10 * time.Millisecond, MinIdle: time.Minute}
+	webhooks := NewConsumer(rdb, DefaultStream, "webhooks", "w1", cfg, discard())
+	reports := NewConsumer(rdb, DefaultStream, "reports", "r1", cfg, discard())
+	for _, c := range []*Consumer{webhooks, reports} {
+		if err := c.EnsureGroup(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	repo := &memOutbox{}
+	repo.add(t, domain.NewEvent(domain.EventTransactionCreated, domain.TransactionEvent{ID: 1, Amount: 12.5}))
+	repo.add(t, domain.NewEvent(domain.EventBudgetUpdated, domain.BudgetLimitEvent{Category: "food", Limit: 300}))
+	repo.add(t, domain.NewEvent(domain.EventTransactionCreated, domain.TransactionEvent{ID: 2, Amount: 7}))
+
+	relay := NewRelay(repo, stream, Config{BatchSize: 2}, discard())
+	if n, err := relay.Flush(ctx); err != nil || n != 3 {
+		t.Fatalf("expected 3 events published, got %d, %v", n, err)
+	}
+	if n, _ := relay.Flush(ctx); n != 0 {
+		t.Fatalf("expected published events to stay published, got %d more", n)
+	}
+
+	for _, c := range []*Consumer{webhooks, reports} {
+		var got []domain.Event
+		err := c.Poll(ctx, func(_ context.Context, e domain.Event) error {
+			got = append(got, e)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(got) != 3 {
+			t.Fatalf("expected every group to get all 3 events, got %+v", got)
+		}
+		if tx, ok := got[0].Data.(domain.TransactionEvent); !ok || tx.ID != 1 || tx.Amount != 12.5 {
+			t.Fatalf("expected a typed transaction event, got %#v", got[0].Data)
+		}
+		if b, ok := got[1].Data.(domain.BudgetLimitEvent); !ok || b.Limit != 300 {
+			t.Fatalf("expected a typed budget event, got %#v", got[1].Data)
+		}
+	}
+}
+
+func TestRelay_KeepsEventsWhenSinkFails(t *testing.T) {
+	repo := &memOutbox{}
+	repo.add(t, domain.NewEvent(domain.EventImportCompleted, domain.ImportEvent{Accepted: 1}))
+
+	relay := NewRelay(repo, failingSink{}, DefaultConfig(), discard())
+	if _, err := relay.Flush(context.Background()); err == nil {
+		t.Fatal("expected the sink error")
+	}
+	if repo.published[1] {
+		t.Fatal("expected the event to stay unpublished")
+	}
+}
+
+func TestConsumer_RedeliversFailedEvents(t *testing.T) {
+	ctx := context.Background()
+	rdb := newTestRedis(t)
+
+	// MinIdle zero lets the next poll claim a failed entry right away.
+	cfg := ConsumerConfig{Batch: 10, Block: 10 * time.Millisecond}
+	c := NewConsumer(rdb, DefaultStream, "webhooks", "w1", cfg, discard())
+	if err := c.EnsureGroup(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	repo := &memOutbox{}
+	repo.add(t, domain.NewEvent(domain.EventImportCompleted, domain.ImportEvent{Accepted: 3}))
+	if _, err := NewRelay(repo, NewStream(rdb, DefaultStream, 1000), DefaultConfig(), discard()).Flush(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	calls := 0
+	handle := func(context.Context, domain.Event) error {
+		calls++
+		if calls == 1 {
+			return errors.New("endpoint down")
+		}
+		return nil
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := c.Poll(ctx, handle); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if calls != 2 {
+		t.Fatalf("expected the failed event to be handled once more, got %d calls", calls)
+	}
+
+	pending, err := rdb.XPending(ctx, DefaultStream, "webhooks").Result()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pending.Count != 0 {
+		t.Fatalf("expected nothing left pending, got %d", pending.Count)
+	}
+}
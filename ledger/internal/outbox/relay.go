@@ -0,0 +1,121 @@
+// Package outbox publishes the events the ledger stores in its outbox
+// table and lets other services consume them.
+//
+// The ledger writes an event in the same database transaction as the
+// change it describes. A Relay then hands unpublished events to a Sink,
+// usually a Redis stream, and marks them published once the sink took
+// them. If the ledger stops in between, the events are published again,
+// so delivery is at least once: consumers tell repeats apart by event ID.
+package outbox
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+// Sink takes published events. Publish must not return nil unless every
+// message was taken.
+type Sink interface {
+	Publish(ctx context.Context, msgs []domain.OutboxMessage) error
+}
+
+type Config struct {
+	// Interval is how often the outbox is checked for new events.
+	Interval time.Duration
+	// BatchSize is how many events are published at once.
+	BatchSize int
+	// Retention is how long published events are kept.
+	Retention time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Interval:  time.Second,
+		BatchSize: 100,
+		Retention: 7 * 24 * time.Hour,
+	}
+}
+
+type Relay struct {
+	repo domain.OutboxRepository
+	sink Sink
+	cfg  Config
+	log  *slog.Logger
+}
+
+func NewRelay(repo domain.OutboxRepository, sink Sink, cfg Config, logger *slog.Logger) *Relay {
+	return &Relay{repo: repo, sink: sink, cfg: cfg, log: logger}
+}
+
+// Run publishes the outbox every interval until ctx is done, and purges
+// published events once an hour.
+func (r *Relay) Run(ctx context.Context) {
+	r.log.Info("outbox relay started", slog.Duration("interval", r.cfg.Interval))
+
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+
+	var lastPurge time.Time
+	for {
+		if _, err := r.Flush(ctx); err != nil && ctx.Err() == nil {
+			r.log.Error("outbox relay run failed", slog.String("error", err.Error()))
+		}
+
+		if time.Since(lastPurge) >= time.Hour {
+			lastPurge = time.Now()
+			if n, err := r.repo.Purge(ctx, lastPurge.Add(-r.cfg.Retention)); err != nil && ctx.Err() == nil {
+				r.log.Error("outbox purge failed", slog.String("error", err.Error()))
+			} else if n > 0 {
+				r.log.Info("outbox purged", slog.Int64("events", n))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			r.log.Info("outbox relay stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Flush publishes batches until the outbox has no unpublished events
+// left and returns how many it published.
+func (r *Relay) Flush(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		n, err := r.repo.Relay(ctx, r.cfg.BatchSize, r.sink.Publish)
+		total += n
+		if err != nil || n < r.cfg.BatchSize {
+			return total, err
+		}
+	}
+}
+
+// PublisherSink hands events to a domain.EventPublisher. It stands in for
+// a stream when there is none, and is only as reliable as the publisher.
+type PublisherSink struct {
+	Publisher domain.EventPublisher
+	Log       *slog.Logger
+}
+
+func (s PublisherSink) Publish(ctx context.Context, msgs []domain.OutboxMessage) error {
+	for _, msg := range msgs {
+		e, err := domain.DecodeEvent(msg.Payload)
+		if err != nil {
+			// Publishing it again would not help.
+			s.Log.Error(
+				"outbox event dropped",
+				slog.String("event_id", msg.EventID),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		s.Publisher.Publish(ctx, e)
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+	"github.com/redis/go-redis/v9"
+)
+
+// DefaultStream is the Redis stream the ledger publishes its events to.
+// Every entry has the fields event_id, type and payload, the event as
+// JSON.
+const DefaultStream = "ledger:events"
+
+// Stream is a Sink that adds events to a Redis stream, trimmed to about
+// maxLen entries.
+type Stream struct {
+	rdb    *redis.Client
+	name   string
+	maxLen int64
+}
+
+func NewStream(rdb *redis.Client, name string, maxLen int64) *Stream {
+	return &Stream{rdb: rdb, name: name, maxLen: maxLen}
+}
+
+func (s *Stream) Publish(ctx context.Context, msgs []domain.OutboxMessage) error {
+	pipe := s.rdb.Pipeline()
+	for _, msg := range msgs {
+		pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: s.name,
+			MaxLen: s.maxLen,
+			Approx: true,
+			Values: map[string]any{
+				"event_id": msg.EventID,
+				"type":     string(msg.Type),
+				"payload":  msg.Payload,
+			},
+		})
+	}
+
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Handler processes one event. An event is acknowledged once its handler
+// returns nil; otherwise it is redelivered later, possibly to another
+// consumer of the group.
+type Handler func(ctx context.Context, e domain.Event) error
+
+type ConsumerConfig struct {
+	// Batch is how many entries are read at once.
+	Batch int64
+	// Block is how long a read waits for new entries.
+	Block time.Duration
+	// MinIdle is how long an entry stays unacknowledged before another
+	// consumer of the group claims it. A batch is handled one entry after
+	// the other, so it has to be well above Batch times the longest a
+	// handler takes, or entries are claimed while still being handled.
+	MinIdle time.Duration
+}
+
+func DefaultConsumerConfig() ConsumerConfig {
+	return ConsumerConfig{
+		Batch:   16,
+		Block:   5 * time.Second,
+		MinIdle: time.Minute,
+	}
+}
+
+// Consumer reads a stream as one member of a consumer group. Every event
+// goes to one consumer of each group, so a group is a service and its
+// consumers are that service's workers.
+type Consumer struct {
+	rdb    *redis.Client
+	stream string
+	group  string
+	name   string
+	cfg    ConsumerConfig
+	log    *slog.Logger
+}
+
+func NewConsumer(
+	rdb *redis.Client,
+	stream, group, name string,
+	cfg ConsumerConfig,
+	logger *slog.Logger,
+) *Consumer {
+	return &Consumer{
+		rdb:    rdb,
+		stream: stream,
+		group:  group,
+		name:   name,
+		cfg:    cfg,
+		log:    logger.With(slog.String("group", group), slog.String("consumer", name)),
+	}
+}
+
+// EnsureGroup creates the group if it does not exist yet. A new group
+// starts with the events published after it was created, so it has to
+// be called before the relay publishes anything the group should see.
+func (c *Consumer) EnsureGroup(ctx context.Context) error {
+	err := c.rdb.XGroupCreateMkStream(ctx, c.stream, c.group, "$").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return err
+	}
+	return nil
+}
+
+// Run passes events to handle until ctx is done. Events left
+// unacknowledged for MinIdle by any consumer of the group, this one
+// included, are claimed and handled again first.
+func (c *Consumer) Run(ctx context.Context, handle Handler) error {
+	if err := c.EnsureGroup(ctx); err != nil {
+		return err
+	}
+
+	c.log.Info("stream consumer started", slog.String("stream", c.stream))
+
+	for ctx.Err() == nil {
+		if err := c.Poll(ctx, handle); err != nil && ctx.Err() == nil {
+			c.log.Error("stream consumer poll failed", slog.String("error", err.Error()))
+
+			select {
+			case <-ctx.Done():
+			case <-time.After(time.Second):
+			}
+		}
+	}
+
+	c.log.Info("stream consumer stopped")
+	return nil
+}
+
+// Poll claims stale entries of the group, reads new ones, waiting up to
+// Block for them, and handles both.
+func (c *Consumer) Poll(ctx context.Context, handle Handler) error {
+	claimed, _, err := c.rdb.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+		Stream:   c.stream,
+		Group:    c.group,
+		Consumer: c.name,
+		MinIdle:  c.cfg.MinIdle,
+		Start:    "0-0",
+		Count:    c.cfg.Batch,
+	}).Result()
+	if err != nil {
+		return err
+	}
+	if err := c.handle(ctx, claimed, handle); err != nil {
+		return err
+	}
+
+	streams, err := c.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    c.group,
+		Consumer: c.name,
+		Streams:  []string{c.stream, ">"},
+		Count:    c.cfg.Batch,
+		Block:    c.cfg.Block,
+	}).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, s := range streams {
+		if err := c.handle(ctx, s.Messages, handle); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Consumer) handle(ctx context.Context, msgs []redis.XMessage, handle Handler) error {
+	for _, msg := range msgs {
+		payload, _ := msg.Values["payload"].(string)
+		e, err := domain.DecodeEvent([]byte(payload))
+		if err != nil {
+			// It would fail the same way every time; drop it.
+			c.log.Error(
+				"stream entry dropped",
+				slog.String("id", msg.ID),
+				slog.String("error", err.Error()),
+			)
+		} else if err := handle(ctx, e); err != nil {
+			c.log.Warn(
+				"stream event failed",
+				slog.String("event_id", e.ID),
+				slog.String("event", string(e.Type)),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+
+		if err := c.rdb.XAck(ctx, c.stream, c.group, msg.ID).Err(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -41,6 +41,12 @@ func (r *BudgetRepository) List(ctx context.Context) ([]domain.Budget, error) {
 	return budgets, nil
 }
 
+// EvictBudgets drops the cached budget list, for changes made behind
+// BudgetRepository's back.
+func EvictBudgets(ctx context.Context, cache *redis.Client) error {
+	return cache.Del(ctx, budgetsCacheKey).Err()
+}
+
 func (r *BudgetRepository) Upsert(ctx context.Context, b domain.Budget) error {
 	if err := r.next.Upsert(ctx, b); err != nil {
 		return err
@@ -0,0 +1,65 @@
+package cached
+
+import (
+	"context"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+	"github.com/redis/go-redis/v9"
+)
+
+// CategoryRepository evicts the cached budget list when a rename or merge
+// rewrites budgets behind BudgetRepository's back.
+type CategoryRepository struct {
+	cache *redis.Client
+	next  domain.CategoryRepository
+}
+
+func NewCategoryRepository(cache *redis.Client, next domain.CategoryRepository) domain.CategoryRepository {
+	return &CategoryRepository{cache: cache, next: next}
+}
+
+func (r *CategoryRepository) Create(ctx context.Context, c *domain.Category) error {
+	return r.next.Create(ctx, c)
+}
+
+func (r *CategoryRepository) Resolve(ctx context.Context, name string) (string, bool, error) {
+	return r.next.Resolve(ctx, name)
+}
+
+func (r *CategoryRepository) AddAlias(ctx context.Context, alias, category string) error {
+	return r.next.AddAlias(ctx, alias, category)
+}
+
+func (r *CategoryRepository) Rename(ctx context.Context, from, to string) error {
+	if err := r.next.Rename(ctx, from, to); err != nil {
+		return err
+	}
+
+	_ = r.cache.Del(ctx, budgetsCacheKey).Err()
+	return nil
+}
+
+func (r *CategoryRepository) Merge(ctx context.Context, sources []string, target string) error {
+	if err := r.next.Merge(ctx, sources, target); err != nil {
+		return err
+	}
+
+	_ = r.cache.Del(ctx, budgetsCacheKey).Err()
+	return nil
+}
+
+func (r *CategoryRepository) SetParent(ctx context.Context, name, parent string) (bool, error) {
+	return r.next.SetParent(ctx, name, parent)
+}
+
+func (r *CategoryRepository) Delete(ctx context.Context, name string) (bool, error) {
+	return r.next.Delete(ctx, name)
+}
+
+func (r *CategoryRepository) GetByName(ctx context.Context, name string) (domain.Category, bool, error) {
+	return r.next.GetByName(ctx, name)
+}
+
+func (r *CategoryRepository) List(ctx context.Context) ([]domain.Category, error) {
+	return r.next.List(ctx)
+}
@@ -0,0 +1,83 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+// AggregateRepository works on daily_category_totals, which triggers on
+// expenses keep up to date.
+type AggregateRepository struct {
+	db *sql.DB
+}
+
+func (r AggregateRepository) Rebuild(ctx context.Context) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// SHARE mode blocks writes to expenses until the rebuild commits, so
+	// no trigger update can be lost between the delete and the insert.
+	const lock = `LOCK TABLE expenses, expense_splits, refunds IN SHARE MODE`
+	if _, err := tx.ExecContext(ctx, lock); err != nil {
+		return err
+	}
+
+	const clear = `DELETE FROM daily_category_totals`
+	if _, err := tx.ExecContext(ctx, clear); err != nil {
+		return err
+	}
+
+	const fill = `
+		INSERT INTO daily_category_totals (day, category, total, tx_count)
+		SELECT date, category, SUM(amount), COUNT(*) FILTER (WHERE amount > 0)
+		FROM expense_lines
+		GROUP BY date, category
+	`
+	if _, err := tx.ExecContext(ctx, fill); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r AggregateRepository) Check(ctx context.Context) ([]domain.AggregateMismatch, error) {
+	const q = `
+		WITH actual AS (
+			SELECT date AS day, category, SUM(amount) AS total
+			FROM expense_lines
+			GROUP BY date, category
+		)
+		SELECT
+			COALESCE(a.day, d.day),
+			COALESCE(a.category, d.category),
+			COALESCE(d.total, 0),
+			COALESCE(a.total, 0)
+		FROM actual a
+		FULL OUTER JOIN daily_category_totals d
+		  ON d.day = a.day AND d.category = a.category
+		WHERE a.total IS DISTINCT FROM d.total
+		ORDER BY 1, 2
+	`
+
+	rows, err := r.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []domain.AggregateMismatch
+	for rows.Next() {
+		var m domain.AggregateMismatch
+		if err := rows.Scan(&m.Day, &m.Category, &m.Stored, &m.Actual); err != nil {
+			return nil, err
+		}
+		res = append(res, m)
+	}
+
+	return res, rows.Err()
+}
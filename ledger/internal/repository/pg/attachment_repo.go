@@ -0,0 +1,96 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+type AttachmentRepository struct {
+	db *sql.DB
+}
+
+const attachmentColumns = `
+	id, transaction_id, filename, content_type, size, blob_key, created_at
+`
+
+func scanAttachment(row interface{ Scan(...any) error }) (domain.Attachment, error) {
+	var a domain.Attachment
+	err := row.Scan(
+		&a.ID,
+		&a.TransactionID,
+		&a.Filename,
+		&a.ContentType,
+		&a.Size,
+		&a.Key,
+		&a.CreatedAt,
+	)
+	return a, err
+}
+
+func (r AttachmentRepository) Create(ctx context.Context, a *domain.Attachment) error {
+	const q = `
+		INSERT INTO attachments (transaction_id, filename, content_type, size, blob_key)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+	err := r.db.QueryRowContext(
+		ctx,
+		q,
+		a.TransactionID,
+		a.Filename,
+		a.ContentType,
+		a.Size,
+		a.Key,
+	).Scan(&a.ID, &a.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return domain.ErrTransactionNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r AttachmentRepository) Get(ctx context.Context, id int) (domain.Attachment, bool, error) {
+	q := `SELECT ` + attachmentColumns + ` FROM attachments WHERE id = $1`
+
+	a, err := scanAttachment(r.db.QueryRowContext(ctx, q, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Attachment{}, false, nil
+	}
+	if err != nil {
+		return domain.Attachment{}, false, err
+	}
+
+	return a, true, nil
+}
+
+func (r AttachmentRepository) ListByTransaction(ctx context.Context, transactionID int) ([]domain.Attachment, error) {
+	q := `SELECT ` + attachmentColumns + `
+		FROM attachments
+		WHERE transaction_id = $1
+		ORDER BY id
+	`
+	rows, err := r.db.QueryContext(ctx, q, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []domain.Attachment
+	for rows.Next() {
+		a, err := scanAttachment(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, a)
+	}
+
+	return out, rows.Err()
+}
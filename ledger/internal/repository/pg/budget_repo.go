@@ -17,16 +17,39 @@ type BudgetRepository struct {
 }
 
 func (r BudgetRepository) Upsert(ctx context.Context,b domain.Budget) error {
+	dbTx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer dbTx.Rollback()
+
+	const register = `INSERT INTO categories (name) VALUES ($1) ON CONFLICT DO NOTHING`
+	if _, err := dbTx.ExecContext(ctx, register, b.Category); err != nil {
+		return err
+	}
+
 	const q = `INSERT INTO budgets (category, limit_amount)
 		 VALUES ($1, $2)
 		 ON CONFLICT (category)
 		 DO UPDATE SET limit_amount = EXCLUDED.limit_amount`
-	_, err := r.db.ExecContext(
+	_, err = dbTx.ExecContext(
 		ctx,
 		q,
 		b.Category, b.Limit,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+
+	event := domain.NewEvent(domain.EventBudgetUpdated, domain.BudgetLimitEvent{
+		Category: b.Category,
+		Limit:    b.Limit,
+	})
+	if err := writeOutbox(ctx, dbTx, event); err != nil {
+		return err
+	}
+
+	return dbTx.Commit()
 }
 
 func (r BudgetRepository) GetByCategory(ctx context.Context, category string) (domain.Budget, bool, error) {
@@ -0,0 +1,272 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+type CategoryRepository struct {
+	db *sql.DB
+}
+
+func (r CategoryRepository) Create(ctx context.Context, c *domain.Category) error {
+	const q = `
+		INSERT INTO categories (name, parent_id)
+		VALUES ($1, (SELECT id FROM categories WHERE name = NULLIF($2, '')))
+		RETURNING id
+	`
+	return r.db.QueryRowContext(ctx, q, c.Name, c.Parent).Scan(&c.ID)
+}
+
+func (r CategoryRepository) SetParent(ctx context.Context, name, parent string) (bool, error) {
+	const q = `
+		UPDATE categories
+		SET parent_id = (SELECT id FROM categories WHERE name = NULLIF($2, ''))
+		WHERE name = $1
+	`
+	res, err := r.db.ExecContext(ctx, q, name, parent)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+func (r CategoryRepository) Delete(ctx context.Context, name string) (bool, error) {
+	const q = `DELETE FROM categories WHERE name = $1`
+	res, err := r.db.ExecContext(ctx, q, name)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+// categoryColumns selects a category row of c, its parent p and its
+// aliases, in the order scanCategory expects.
+const categoryColumns = `
+	c.id, c.name, COALESCE(p.name, ''),
+	COALESCE(
+		(SELECT json_agg(a.alias ORDER BY a.alias)
+		 FROM category_aliases a
+		 WHERE a.category_id = c.id),
+		'[]'
+	)
+`
+
+func scanCategory(row interface{ Scan(...any) error }) (domain.Category, error) {
+	var (
+		c       domain.Category
+		aliases []byte
+	)
+	if err := row.Scan(&c.ID, &c.Name, &c.Parent, &aliases); err != nil {
+		return domain.Category{}, err
+	}
+	if err := json.Unmarshal(aliases, &c.Aliases); err != nil {
+		return domain.Category{}, err
+	}
+	return c, nil
+}
+
+func (r CategoryRepository) GetByName(ctx context.Context, name string) (domain.Category, bool, error) {
+	q := `
+		SELECT ` + categoryColumns + `
+		FROM categories c
+		LEFT JOIN categories p ON p.id = c.parent_id
+		WHERE c.name = $1
+	`
+
+	c, err := scanCategory(r.db.QueryRowContext(ctx, q, name))
+	if err == sql.ErrNoRows {
+		return domain.Category{}, false, nil
+	}
+	if err != nil {
+		return domain.Category{}, false, err
+	}
+
+	return c, true, nil
+}
+
+func (r CategoryRepository) List(ctx context.Context) ([]domain.Category, error) {
+	q := `
+		SELECT ` + categoryColumns + `
+		FROM categories c
+		LEFT JOIN categories p ON p.id = c.parent_id
+		ORDER BY c.name
+	`
+
+	rows, err := r.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []domain.Category
+	for rows.Next() {
+		c, err := scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, c)
+	}
+
+	return res, rows.Err()
+}
+
+func (r CategoryRepository) Resolve(ctx context.Context, name string) (string, bool, error) {
+	// A canonical name wins over an alias with the same spelling.
+	const q = `
+		SELECT name FROM (
+			SELECT name, 0 AS rank
+			FROM categories
+			WHERE lower(name) = lower($1)
+			UNION ALL
+			SELECT c.name, 1
+			FROM category_aliases a
+			JOIN categories c ON c.id = a.category_id
+			WHERE a.alias = lower($1)
+		) matches
+		ORDER BY rank
+		LIMIT 1
+	`
+
+	var canonical string
+	err := r.db.QueryRowContext(ctx, q, name).Scan(&canonical)
+	if err == sql.ErrNoRows {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+
+	return canonical, true, nil
+}
+
+func (r CategoryRepository) AddAlias(ctx context.Context, alias, category string) error {
+	const q = `
+		INSERT INTO category_aliases (alias, category_id)
+		SELECT lower($1), id FROM categories WHERE name = $2
+		ON CONFLICT (alias) DO UPDATE SET category_id = EXCLUDED.category_id
+	`
+	_, err := r.db.ExecContext(ctx, q, alias, category)
+	return err
+}
+
+func (r CategoryRepository) Rename(ctx context.Context, from, to string) error {
+	dbTx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer dbTx.Rollback()
+
+	const rename = `UPDATE categories SET name = $2 WHERE name = $1`
+	if _, err := dbTx.ExecContext(ctx, rename, from, to); err != nil {
+		return err
+	}
+
+	const dropAlias = `DELETE FROM category_aliases WHERE alias = lower($1)`
+	if _, err := dbTx.ExecContext(ctx, dropAlias, to); err != nil {
+		return err
+	}
+
+	const keepOldName = `
+		INSERT INTO category_aliases (alias, category_id)
+		SELECT lower($1), id FROM categories
+		WHERE name = $2 AND lower($1) <> lower($2)
+		ON CONFLICT (alias) DO UPDATE SET category_id = EXCLUDED.category_id
+	`
+	if _, err := dbTx.ExecContext(ctx, keepOldName, from, to); err != nil {
+		return err
+	}
+
+	if err := refile(ctx, dbTx, []string{from}, to); err != nil {
+		return err
+	}
+
+	return dbTx.Commit()
+}
+
+func (r CategoryRepository) Merge(ctx context.Context, sources []string, target string) error {
+	dbTx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer dbTx.Rollback()
+
+	// Subcategories and aliases have to move before the sources are
+	// deleted, which would cascade to the aliases.
+	statements := []string{
+		`UPDATE categories
+		 SET parent_id = (SELECT id FROM categories WHERE name = $2)
+		 WHERE parent_id IN (
+			SELECT id FROM categories WHERE name = ANY($1::text[]) AND name <> $2
+		 )`,
+		`UPDATE category_aliases
+		 SET category_id = (SELECT id FROM categories WHERE name = $2)
+		 WHERE category_id IN (
+			SELECT id FROM categories WHERE name = ANY($1::text[]) AND name <> $2
+		 )`,
+		`INSERT INTO category_aliases (alias, category_id)
+		 SELECT DISTINCT lower(s), (SELECT id FROM categories WHERE name = $2)
+		 FROM unnest($1::text[]) s
+		 WHERE lower(s) <> lower($2)
+		 ON CONFLICT (alias) DO UPDATE SET category_id = EXCLUDED.category_id`,
+		`DELETE FROM categories WHERE name = ANY($1::text[]) AND name <> $2`,
+	}
+	for _, q := range statements {
+		if _, err := dbTx.ExecContext(ctx, q, sources, target); err != nil {
+			return err
+		}
+	}
+
+	if err := refile(ctx, dbTx, append([]string{target}, sources...), target); err != nil {
+		return err
+	}
+
+	return dbTx.Commit()
+}
+
+// refile moves the transactions and budgets of every category matching
+// one of names case-insensitively to target. Budgets that end up on the
+// same category have their limits added up.
+func refile(ctx context.Context, dbTx *sql.Tx, names []string, target string) error {
+	statements := []string{
+		`UPDATE expenses SET category = $2
+		 WHERE lower(btrim(category)) IN (SELECT lower(unnest($1::text[])))
+		   AND category <> $2`,
+		`UPDATE expense_splits SET category = $2
+		 WHERE lower(btrim(category)) IN (SELECT lower(unnest($1::text[])))
+		   AND category <> $2`,
+		`UPDATE refunds SET category = $2
+		 WHERE lower(btrim(category)) IN (SELECT lower(unnest($1::text[])))
+		   AND category <> $2`,
+		`INSERT INTO budgets (category, limit_amount)
+		 SELECT $2, SUM(limit_amount) FROM budgets
+		 WHERE lower(btrim(category)) IN (SELECT lower(unnest($1::text[])))
+		 HAVING COUNT(*) > 0
+		 ON CONFLICT (category) DO UPDATE SET limit_amount = EXCLUDED.limit_amount`,
+		`DELETE FROM budgets
+		 WHERE lower(btrim(category)) IN (SELECT lower(unnest($1::text[])))
+		   AND category <> $2`,
+	}
+	for _, q := range statements {
+		if _, err := dbTx.ExecContext(ctx, q, names, target); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+type DuplicateRepository struct {
+	db *sql.DB
+}
+
+func (r DuplicateRepository) ListDismissed(ctx context.Context) ([][2]int, error) {
+	const q = `SELECT transaction_id, other_id FROM duplicate_dismissals`
+
+	rows, err := r.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pairs [][2]int
+	for rows.Next() {
+		var p [2]int
+		if err := rows.Scan(&p[0], &p[1]); err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, p)
+	}
+
+	return pairs, rows.Err()
+}
+
+func (r DuplicateRepository) Dismiss(ctx context.Context, ids []int) error {
+	dbTx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer dbTx.Rollback()
+
+	if err := lockTransactions(ctx, dbTx, ids, false); err != nil {
+		return err
+	}
+
+	const dismiss = `
+		INSERT INTO duplicate_dismissals (transaction_id, other_id)
+		SELECT a, b
+		FROM unnest($1::int[]) AS a, unnest($1::int[]) AS b
+		WHERE a < b
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := dbTx.ExecContext(ctx, dismiss, ids); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return domain.ErrTransactionNotFound
+		}
+		return err
+	}
+
+	const unflag = `
+		UPDATE expenses SET duplicate_of = NULL
+		WHERE id = ANY($1::int[]) AND duplicate_of = ANY($1::int[])
+	`
+	if _, err := dbTx.ExecContext(ctx, unflag, ids); err != nil {
+		return err
+	}
+
+	return dbTx.Commit()
+}
+
+func (r DuplicateRepository) Merge(ctx context.Context, keep int, duplicates []int) ([]time.Time, error) {
+	dbTx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer dbTx.Rollback()
+
+	if err := lockTransactions(ctx, dbTx, []int{keep}, false); err != nil {
+		return nil, err
+	}
+	if err := lockTransactions(ctx, dbTx, duplicates, true); err != nil {
+		return nil, err
+	}
+
+	const moveTags = `
+		INSERT INTO transaction_tags (transaction_id, tag_id)
+		SELECT $1, tag_id FROM transaction_tags WHERE transaction_id = ANY($2::int[])
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := dbTx.ExecContext(ctx, moveTags, keep, duplicates); err != nil {
+		return nil, err
+	}
+
+	const moveAttachments = `
+		UPDATE attachments SET transaction_id = $1 WHERE transaction_id = ANY($2::int[])
+	`
+	if _, err := dbTx.ExecContext(ctx, moveAttachments, keep, duplicates); err != nil {
+		return nil, err
+	}
+
+	dates, err := moveRefunds(ctx, dbTx, keep, duplicates)
+	if err != nil {
+		return nil, err
+	}
+
+	const unflag = `UPDATE expenses SET duplicate_of = NULL WHERE id = $1`
+	if _, err := dbTx.ExecContext(ctx, unflag, keep); err != nil {
+		return nil, err
+	}
+
+	// The daily totals triggers take the deleted rows out of the reports.
+	const remove = `DELETE FROM expenses WHERE id = ANY($1::int[]) RETURNING date`
+	rows, err := dbTx.QueryContext(ctx, remove, duplicates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var date time.Time
+		if err := rows.Scan(&date); err != nil {
+			return nil, err
+		}
+		dates = append(dates, date)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return dates, dbTx.Commit()
+}
+
+// moveRefunds moves the refunds of duplicates to keep, checking each one
+// against keep as if it had been made there. It returns the date of keep
+// when its spend changed.
+func moveRefunds(ctx context.Context, dbTx *sql.Tx, keep int, duplicates []int) ([]time.Time, error) {
+	q := `SELECT ` + refundColumns + ` FROM refunds WHERE transaction_id = ANY($1::int[]) AND NOT voided ORDER BY id`
+	rows, err := dbTx.QueryContext(ctx, q, duplicates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var refunds []domain.Refund
+	for rows.Next() {
+		refund, err := scanRefund(rows)
+		if err != nil {
+			return nil, err
+		}
+		refunds = append(refunds, refund)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(refunds) == 0 {
+		return nil, nil
+	}
+
+	kept, err := lockRefunded(ctx, dbTx, keep)
+	if err != nil {
+		return nil, err
+	}
+	earlier, err := listRefunds(ctx, dbTx, keep)
+	if err != nil {
+		return nil, err
+	}
+
+	// The daily totals trigger moves the refunded spend with the category
+	// and original date.
+	const move = `
+		UPDATE refunds SET transaction_id = $2, category = $3, original_date = $4
+		WHERE id = $1
+	`
+	for _, refund := range refunds {
+		from := refund.TransactionID
+		refund.TransactionID = keep
+		if len(kept.Splits) == 0 {
+			refund.Category = ""
+		}
+		if err := domain.CheckRefund(kept, earlier, &refund); err != nil {
+			return nil, fmt.Errorf("refund %d of transaction %d cannot move to transaction %d: %w", refund.ID, from, keep, err)
+		}
+		if _, err := dbTx.ExecContext(ctx, move, refund.ID, keep, refund.Category, refund.OriginalDate); err != nil {
+			return nil, err
+		}
+		earlier = append(earlier, refund)
+	}
+
+	return []time.Time{kept.Date}, nil
+}
+
+// lockTransactions locks the rows of ids until dbTx ends. It returns
+// ErrTransactionNotFound when one of them does not exist and, when
+// unreconciled is set, ErrTransactionLocked when one is reconciled.
+func lockTransactions(ctx context.Context, dbTx *sql.Tx, ids []int, unreconciled bool) error {
+	const q = `
+		SELECT COUNT(*), COUNT(reconciliation_id) FROM (
+			SELECT id, reconciliation_id FROM expenses WHERE id = ANY($1::int[]) FOR UPDATE
+		) locked
+	`
+	var found, reconciled int
+	if err := dbTx.QueryRowContext(ctx, q, ids).Scan(&found, &reconciled); err != nil {
+		return err
+	}
+
+	distinct := make(map[int]struct{}, len(ids))
+	for _, id := range ids {
+		distinct[id] = struct{}{}
+	}
+	if found != len(distinct) {
+		return domain.ErrTransactionNotFound
+	}
+	if unreconciled && reconciled > 0 {
+		return domain.ErrTransactionLocked
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+type NotificationRepository struct {
+	db *sql.DB
+}
+
+const recipientColumns = `id, name, email, budget_alerts, weekly_digest, created_at`
+
+func scanRecipient(row interface{ Scan(...any) error }) (domain.NotificationRecipient, error) {
+	var r domain.NotificationRecipient
+	err := row.Scan(
+		&r.ID,
+		&r.Name,
+		&r.Email,
+		&r.BudgetAlerts,
+		&r.WeeklyDigest,
+		&r.CreatedAt,
+	)
+	return r, err
+}
+
+func (r NotificationRepository) Save(ctx context.Context, rcpt *domain.NotificationRecipient) error {
+	const q = `
+		INSERT INTO notification_recipients (name, email, budget_alerts, weekly_digest)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (email) DO UPDATE
+		SET name = EXCLUDED.name,
+			budget_alerts = EXCLUDED.budget_alerts,
+			weekly_digest = EXCLUDED.weekly_digest
+		RETURNING id, created_at
+	`
+	return r.db.QueryRowContext(
+		ctx,
+		q,
+		rcpt.Name,
+		rcpt.Email,
+		rcpt.BudgetAlerts,
+		rcpt.WeeklyDigest,
+	).Scan(&rcpt.ID, &rcpt.CreatedAt)
+}
+
+func (r NotificationRepository) Delete(ctx context.Context, id int) (bool, error) {
+	const q = `DELETE FROM notification_recipients WHERE id = $1`
+	res, err := r.db.ExecContext(ctx, q, id)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+func (r NotificationRepository) List(ctx context.Context) ([]domain.NotificationRecipient, error) {
+	q := `SELECT ` + recipientColumns + ` FROM notification_recipients ORDER BY id`
+	return r.list(ctx, q)
+}
+
+func (r NotificationRepository) ListOptedIn(
+	ctx context.Context,
+	kind domain.NotificationKind,
+) ([]domain.NotificationRecipient, error) {
+	var column string
+	switch kind {
+	case domain.NotifyBudgetAlerts:
+		column = "budget_alerts"
+	case domain.NotifyWeeklyDigest:
+		column = "weekly_digest"
+	default:
+		return nil, fmt.Errorf("unknown notification kind %q", kind)
+	}
+
+	q := `SELECT ` + recipientColumns + ` FROM notification_recipients WHERE ` + column + ` ORDER BY id`
+	return r.list(ctx, q)
+}
+
+func (r NotificationRepository) list(ctx context.Context, q string, args ...any) ([]domain.NotificationRecipient, error) {
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.NotificationRecipient, 0)
+	for rows.Next() {
+		rcpt, err := scanRecipient(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, rcpt)
+	}
+
+	return res, rows.Err()
+}
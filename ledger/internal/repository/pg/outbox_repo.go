@@ -0,0 +1,105 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+type OutboxRepository struct {
+	db *sql.DB
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// writeOutbox stores e in the outbox as part of dbTx, so it is published
+// if and only if dbTx commits.
+func writeOutbox(ctx context.Context, dbTx execer, e domain.Event) error {
+	msg, err := domain.NewOutboxMessage(e)
+	if err != nil {
+		return err
+	}
+
+	const q = `
+		INSERT INTO outbox (event_id, event_type, payload, created_at)
+		VALUES ($1, $2, $3, $4)
+	`
+	_, err = dbTx.ExecContext(ctx, q, msg.EventID, string(msg.Type), msg.Payload, msg.CreatedAt)
+	return err
+}
+
+func (r OutboxRepository) Write(ctx context.Context, e domain.Event) error {
+	return writeOutbox(ctx, r.db, e)
+}
+
+func (r OutboxRepository) Relay(
+	ctx context.Context,
+	limit int,
+	publish func(context.Context, []domain.OutboxMessage) error,
+) (int, error) {
+	dbTx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer dbTx.Rollback()
+
+	const q = `
+		SELECT id, event_id, event_type, payload, created_at
+		FROM outbox
+		WHERE published_at IS NULL
+		ORDER BY id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`
+	rows, err := dbTx.QueryContext(ctx, q, limit)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var (
+		msgs []domain.OutboxMessage
+		ids  []int64
+	)
+	for rows.Next() {
+		var msg domain.OutboxMessage
+		if err := rows.Scan(&msg.ID, &msg.EventID, &msg.Type, &msg.Payload, &msg.CreatedAt); err != nil {
+			return 0, err
+		}
+		msgs = append(msgs, msg)
+		ids = append(ids, msg.ID)
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	rows.Close()
+
+	if len(msgs) == 0 {
+		return 0, nil
+	}
+
+	if err := publish(ctx, msgs); err != nil {
+		return 0, err
+	}
+
+	const mark = `UPDATE outbox SET published_at = now() WHERE id = ANY($1::bigint[])`
+	if _, err := dbTx.ExecContext(ctx, mark, ids); err != nil {
+		return 0, err
+	}
+
+	return len(msgs), dbTx.Commit()
+}
+
+func (r OutboxRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	const q = `DELETE FROM outbox WHERE published_at < $1`
+	res, err := r.db.ExecContext(ctx, q, before)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
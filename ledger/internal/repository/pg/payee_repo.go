@@ -0,0 +1,74 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+type PayeeRepository struct {
+	db *sql.DB
+}
+
+func (r PayeeRepository) Create(ctx context.Context, p *domain.Payee) error {
+	const q = `INSERT INTO payees (name) VALUES ($1) RETURNING id`
+	return r.db.QueryRowContext(ctx, q, p.Name).Scan(&p.ID)
+}
+
+// Ensure does a no-op update on conflict so the existing row is returned
+// in the same statement.
+func (r PayeeRepository) Ensure(ctx context.Context, name string) (domain.Payee, error) {
+	const q = `
+		INSERT INTO payees (name) VALUES ($1)
+		ON CONFLICT ((lower(name))) DO UPDATE SET name = payees.name
+		RETURNING id, name
+	`
+	var p domain.Payee
+	err := r.db.QueryRowContext(ctx, q, name).Scan(&p.ID, &p.Name)
+	return p, err
+}
+
+func (r PayeeRepository) Get(ctx context.Context, id int) (domain.Payee, bool, error) {
+	const q = `SELECT id, name FROM payees WHERE id = $1`
+	return r.get(ctx, q, id)
+}
+
+func (r PayeeRepository) GetByName(ctx context.Context, name string) (domain.Payee, bool, error) {
+	const q = `SELECT id, name FROM payees WHERE lower(name) = lower($1)`
+	return r.get(ctx, q, name)
+}
+
+func (r PayeeRepository) get(ctx context.Context, q string, arg any) (domain.Payee, bool, error) {
+	var p domain.Payee
+	err := r.db.QueryRowContext(ctx, q, arg).Scan(&p.ID, &p.Name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Payee{}, false, nil
+	}
+	if err != nil {
+		return domain.Payee{}, false, err
+	}
+
+	return p, true, nil
+}
+
+func (r PayeeRepository) List(ctx context.Context) ([]domain.Payee, error) {
+	const q = `SELECT id, name FROM payees ORDER BY name`
+	rows, err := r.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []domain.Payee
+	for rows.Next() {
+		var p domain.Payee
+		if err := rows.Scan(&p.ID, &p.Name); err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+
+	return out, rows.Err()
+}
@@ -0,0 +1,120 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+type ReconciliationRepository struct {
+	db *sql.DB
+}
+
+const reconciliationColumns = `
+	id, account, statement_date, statement_balance, opening_balance, completed_at
+`
+
+func (r ReconciliationRepository) Create(ctx context.Context, rec *domain.Reconciliation) error {
+	const q = `
+		INSERT INTO reconciliations (account, statement_date, statement_balance, opening_balance)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`
+	err := r.db.QueryRowContext(
+		ctx,
+		q,
+		rec.Account,
+		rec.StatementDate,
+		rec.StatementBalance,
+		rec.OpeningBalance,
+	).Scan(&rec.ID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "reconciliations_open_idx" {
+			return domain.ErrReconciliationOpen
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r ReconciliationRepository) Get(ctx context.Context, id int) (domain.Reconciliation, bool, error) {
+	q := `SELECT ` + reconciliationColumns + ` FROM reconciliations WHERE id = $1`
+
+	rec, err := scanReconciliation(r.db.QueryRowContext(ctx, q, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Reconciliation{}, false, nil
+	}
+	if err != nil {
+		return domain.Reconciliation{}, false, err
+	}
+
+	return rec, true, nil
+}
+
+func (r ReconciliationRepository) List(ctx context.Context) ([]domain.Reconciliation, error) {
+	q := `SELECT ` + reconciliationColumns + ` FROM reconciliations ORDER BY statement_date DESC, id DESC`
+
+	rows, err := r.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []domain.Reconciliation
+	for rows.Next() {
+		rec, err := scanReconciliation(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, rec)
+	}
+
+	return res, rows.Err()
+}
+
+func (r ReconciliationRepository) LastCompleted(
+	ctx context.Context,
+	account string,
+) (domain.Reconciliation, bool, error) {
+	q := `SELECT ` + reconciliationColumns + `
+		FROM reconciliations
+		WHERE account = $1 AND completed_at IS NOT NULL
+		ORDER BY statement_date DESC, id DESC
+		LIMIT 1
+	`
+
+	rec, err := scanReconciliation(r.db.QueryRowContext(ctx, q, account))
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Reconciliation{}, false, nil
+	}
+	if err != nil {
+		return domain.Reconciliation{}, false, err
+	}
+
+	return rec, true, nil
+}
+
+func scanReconciliation(row interface{ Scan(...any) error }) (domain.Reconciliation, error) {
+	var (
+		rec       domain.Reconciliation
+		completed sql.NullTime
+	)
+	if err := row.Scan(
+		&rec.ID,
+		&rec.Account,
+		&rec.StatementDate,
+		&rec.StatementBalance,
+		&rec.OpeningBalance,
+		&completed,
+	); err != nil {
+		return rec, err
+	}
+	rec.CompletedAt = completed.Time
+
+	return rec, nil
+}
@@ -0,0 +1,193 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+type RecurringRepository struct {
+	db *sql.DB
+}
+
+const recurringColumns = `
+	id, amount, category, description, schedule,
+	start_date, end_date, next_date, last_error
+`
+
+func scanRecurring(row interface{ Scan(...any) error }) (domain.RecurringTransaction, error) {
+	var (
+		r        domain.RecurringTransaction
+		schedule string
+		end      sql.NullTime
+		next     sql.NullTime
+	)
+	if err := row.Scan(
+		&r.ID,
+		&r.Amount,
+		&r.Category,
+		&r.Description,
+		&schedule,
+		&r.Start,
+		&end,
+		&next,
+		&r.LastError,
+	); err != nil {
+		return domain.RecurringTransaction{}, err
+	}
+
+	s, err := domain.ParseSchedule(schedule)
+	if err != nil {
+		return domain.RecurringTransaction{}, err
+	}
+	r.Schedule = s
+	r.End = end.Time
+	r.Next = next.Time
+
+	return r, nil
+}
+
+// nullDate stores the zero time as NULL.
+func nullDate(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+func (r RecurringRepository) Create(ctx context.Context, rt *domain.RecurringTransaction) error {
+	const q = `
+		INSERT INTO recurring_transactions
+			(amount, category, description, schedule, start_date, end_date, next_date)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`
+	return r.db.QueryRowContext(
+		ctx,
+		q,
+		rt.Amount,
+		rt.Category,
+		rt.Description,
+		rt.Schedule.String(),
+		rt.Start,
+		nullDate(rt.End),
+		nullDate(rt.Next),
+	).Scan(&rt.ID)
+}
+
+func (r RecurringRepository) Update(ctx context.Context, rt domain.RecurringTransaction) (bool, error) {
+	const q = `
+		UPDATE recurring_transactions
+		SET amount = $2, category = $3, description = $4, schedule = $5,
+		    start_date = $6, end_date = $7, next_date = $8, last_error = ''
+		WHERE id = $1
+	`
+	res, err := r.db.ExecContext(
+		ctx,
+		q,
+		rt.ID,
+		rt.Amount,
+		rt.Category,
+		rt.Description,
+		rt.Schedule.String(),
+		rt.Start,
+		nullDate(rt.End),
+		nullDate(rt.Next),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+func (r RecurringRepository) Delete(ctx context.Context, id int) (bool, error) {
+	const q = `DELETE FROM recurring_transactions WHERE id = $1`
+	res, err := r.db.ExecContext(ctx, q, id)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+func (r RecurringRepository) Get(ctx context.Context, id int) (domain.RecurringTransaction, bool, error) {
+	q := `SELECT ` + recurringColumns + ` FROM recurring_transactions WHERE id = $1`
+
+	rt, err := scanRecurring(r.db.QueryRowContext(ctx, q, id))
+	if err == sql.ErrNoRows {
+		return domain.RecurringTransaction{}, false, nil
+	}
+	if err != nil {
+		return domain.RecurringTransaction{}, false, err
+	}
+
+	return rt, true, nil
+}
+
+func (r RecurringRepository) List(ctx context.Context) ([]domain.RecurringTransaction, error) {
+	q := `SELECT ` + recurringColumns + ` FROM recurring_transactions ORDER BY id`
+	return r.query(ctx, q)
+}
+
+func (r RecurringRepository) ListDue(ctx context.Context, asOf time.Time) ([]domain.RecurringTransaction, error) {
+	q := `
+		SELECT ` + recurringColumns + `
+		FROM recurring_transactions
+		WHERE next_date IS NOT NULL AND next_date <= $1
+		ORDER BY next_date, id
+	`
+	return r.query(ctx, q, asOf)
+}
+
+func (r RecurringRepository) query(ctx context.Context, q string, args ...any) ([]domain.RecurringTransaction, error) {
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []domain.RecurringTransaction
+	for rows.Next() {
+		rt, err := scanRecurring(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, rt)
+	}
+
+	return res, rows.Err()
+}
+
+func (r RecurringRepository) Advance(
+	ctx context.Context,
+	id int,
+	from, to time.Time,
+	lastError string,
+) (bool, error) {
+	const q = `
+		UPDATE recurring_transactions
+		SET next_date = $3, last_error = $4
+		WHERE id = $1 AND next_date = $2
+	`
+	res, err := r.db.ExecContext(ctx, q, id, from, nullDate(to), lastError)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
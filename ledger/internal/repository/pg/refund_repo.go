@@ -0,0 +1,145 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+type RefundRepository struct {
+	db *sql.DB
+}
+
+const refundColumns = `
+	id, transaction_id, category, amount, description, date, original_date
+`
+
+func scanRefund(row interface{ Scan(...any) error }) (domain.Refund, error) {
+	var r domain.Refund
+	err := row.Scan(
+		&r.ID,
+		&r.TransactionID,
+		&r.Category,
+		&r.Amount,
+		&r.Description,
+		&r.Date,
+		&r.OriginalDate,
+	)
+	return r, err
+}
+
+func (r RefundRepository) Create(ctx context.Context, refund *domain.Refund) error {
+	dbTx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer dbTx.Rollback()
+
+	// Locking the original serializes refunds of it, so two of them
+	// cannot both pass the check below.
+	tx, err := lockRefunded(ctx, dbTx, refund.TransactionID)
+	if err != nil {
+		return err
+	}
+
+	earlier, err := listRefunds(ctx, dbTx, refund.TransactionID)
+	if err != nil {
+		return err
+	}
+
+	if err := domain.CheckRefund(tx, earlier, refund); err != nil {
+		return err
+	}
+
+	const q = `
+		INSERT INTO refunds (transaction_id, category, amount, description, date, original_date)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`
+	err = dbTx.QueryRowContext(
+		ctx,
+		q,
+		refund.TransactionID,
+		refund.Category,
+		refund.Amount,
+		refund.Description,
+		refund.Date,
+		refund.OriginalDate,
+	).Scan(&refund.ID)
+	if err != nil {
+		return err
+	}
+
+	return dbTx.Commit()
+}
+
+// lockRefunded loads and locks the transaction with id, with the lines
+// refunds of it are checked against.
+func lockRefunded(ctx context.Context, dbTx *sql.Tx, id int) (domain.Transaction, error) {
+	const q = `
+		SELECT e.id, e.amount, e.category, e.date, e.status,
+		       COALESCE(
+		           (SELECT json_agg(json_build_object('category', s.category, 'amount', s.amount) ORDER BY s.id)
+		            FROM expense_splits s
+		            WHERE s.transaction_id = e.id),
+		           '[]'
+		       )
+		FROM expenses e
+		WHERE e.id = $1
+		FOR UPDATE
+	`
+	var (
+		tx         domain.Transaction
+		splitsJSON []byte
+	)
+	err := dbTx.QueryRowContext(ctx, q, id).Scan(
+		&tx.ID,
+		&tx.Amount,
+		&tx.Category,
+		&tx.Date,
+		&tx.Status,
+		&splitsJSON,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Transaction{}, domain.ErrTransactionNotFound
+	}
+	if err != nil {
+		return domain.Transaction{}, err
+	}
+	if err := json.Unmarshal(splitsJSON, &tx.Splits); err != nil {
+		return domain.Transaction{}, err
+	}
+
+	return tx, nil
+}
+
+func (r RefundRepository) ListByTransaction(ctx context.Context, transactionID int) ([]domain.Refund, error) {
+	return listRefunds(ctx, r.db, transactionID)
+}
+
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+func listRefunds(ctx context.Context, db querier, transactionID int) ([]domain.Refund, error) {
+	q := `SELECT ` + refundColumns + ` FROM refunds WHERE transaction_id = $1 ORDER BY id`
+	rows, err := db.QueryContext(ctx, q, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.Refund, 0)
+	for rows.Next() {
+		refund, err := scanRefund(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, refund)
+	}
+
+	return res, rows.Err()
+}
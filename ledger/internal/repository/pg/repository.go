@@ -7,13 +7,37 @@ import (
 )
 
 type Repositories struct {
-	BudgetRepository      domain.BudgetRepository
-	TransactionRepository domain.TransactionRepository
+	BudgetRepository         domain.BudgetRepository
+	TransactionRepository    domain.TransactionRepository
+	AggregateRepository      domain.AggregateRepository
+	CategoryRepository       domain.CategoryRepository
+	RecurringRepository      domain.RecurringRepository
+	AttachmentRepository     domain.AttachmentRepository
+	PayeeRepository          domain.PayeeRepository
+	RuleRepository           domain.RuleRepository
+	DuplicateRepository      domain.DuplicateRepository
+	ReconciliationRepository domain.ReconciliationRepository
+	RefundRepository         domain.RefundRepository
+	WebhookRepository        domain.WebhookRepository
+	OutboxRepository         domain.OutboxRepository
+	NotificationRepository   domain.NotificationRepository
 }
 
 func New(db *sql.DB) *Repositories {
 	return &Repositories{
-		BudgetRepository:      BudgetRepository{db: db},
-		TransactionRepository: TransactionRepository{db: db},
+		BudgetRepository:         BudgetRepository{db: db},
+		TransactionRepository:    TransactionRepository{db: db},
+		AggregateRepository:      AggregateRepository{db: db},
+		CategoryRepository:       CategoryRepository{db: db},
+		RecurringRepository:      RecurringRepository{db: db},
+		AttachmentRepository:     AttachmentRepository{db: db},
+		PayeeRepository:          PayeeRepository{db: db},
+		RuleRepository:           RuleRepository{db: db},
+		DuplicateRepository:      DuplicateRepository{db: db},
+		ReconciliationRepository: ReconciliationRepository{db: db},
+		RefundRepository:         RefundRepository{db: db},
+		WebhookRepository:        WebhookRepository{db: db},
+		OutboxRepository:         OutboxRepository{db: db},
+		NotificationRepository:   NotificationRepository{db: db},
 	}
-}
\ No newline at end of file
+}
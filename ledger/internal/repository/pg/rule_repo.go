@@ -0,0 +1,141 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+type RuleRepository struct {
+	db *sql.DB
+}
+
+func (r RuleRepository) Create(ctx context.Context, rule *domain.Rule) error {
+	const q = `
+		INSERT INTO rules
+			(name, priority, description_contains, description_regex,
+			 min_amount, max_amount, payee,
+			 set_category, set_tags, set_description)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id
+	`
+	return r.db.QueryRowContext(
+		ctx,
+		q,
+		rule.Name,
+		rule.Priority,
+		rule.DescriptionContains,
+		rule.DescriptionRegex,
+		rule.MinAmount,
+		rule.MaxAmount,
+		rule.Payee,
+		rule.SetCategory,
+		tagArray(rule.SetTags),
+		rule.SetDescription,
+	).Scan(&rule.ID)
+}
+
+func (r RuleRepository) Update(ctx context.Context, rule domain.Rule) (bool, error) {
+	const q = `
+		UPDATE rules
+		SET name = $2, priority = $3, description_contains = $4,
+		    description_regex = $5, min_amount = $6, max_amount = $7,
+		    payee = $8, set_category = $9, set_tags = $10,
+		    set_description = $11
+		WHERE id = $1
+	`
+	res, err := r.db.ExecContext(
+		ctx,
+		q,
+		rule.ID,
+		rule.Name,
+		rule.Priority,
+		rule.DescriptionContains,
+		rule.DescriptionRegex,
+		rule.MinAmount,
+		rule.MaxAmount,
+		rule.Payee,
+		rule.SetCategory,
+		tagArray(rule.SetTags),
+		rule.SetDescription,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+func (r RuleRepository) Delete(ctx context.Context, id int) (bool, error) {
+	const q = `DELETE FROM rules WHERE id = $1`
+	res, err := r.db.ExecContext(ctx, q, id)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+func (r RuleRepository) List(ctx context.Context) ([]domain.Rule, error) {
+	const q = `
+		SELECT id, name, priority, description_contains, description_regex,
+		       min_amount, max_amount, payee,
+		       set_category, array_to_json(set_tags), set_description
+		FROM rules
+		ORDER BY priority, id
+	`
+	rows, err := r.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []domain.Rule
+	for rows.Next() {
+		var (
+			rule     domain.Rule
+			tagsJSON []byte
+		)
+		if err := rows.Scan(
+			&rule.ID,
+			&rule.Name,
+			&rule.Priority,
+			&rule.DescriptionContains,
+			&rule.DescriptionRegex,
+			&rule.MinAmount,
+			&rule.MaxAmount,
+			&rule.Payee,
+			&rule.SetCategory,
+			&tagsJSON,
+			&rule.SetDescription,
+		); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(tagsJSON, &rule.SetTags); err != nil {
+			return nil, err
+		}
+		out = append(out, rule)
+	}
+
+	return out, rows.Err()
+}
+
+// tagArray passes nil as an empty array rather than NULL.
+func tagArray(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
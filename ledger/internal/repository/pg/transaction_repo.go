@@ -3,9 +3,13 @@ package pg
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/lyagu5h/finScope/ledger/internal/domain"
 )
 
@@ -13,31 +17,250 @@ type TransactionRepository struct {
 	db *sql.DB
 }
 
-func (r TransactionRepository) Add(ctx context.Context, tx *domain.Transaction) error {
-	const q = `INSERT INTO expenses (amount, category, description, date)
-		VALUES ($1, $2, $3, $4)
-		RETURNING id
+func (r TransactionRepository) Add(ctx context.Context, tx *domain.Transaction, events ...domain.Event) error {
+	dbTx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer dbTx.Rollback()
+
+	const q = `INSERT INTO expenses (amount, category, description, date, recurring_id, split, payee_id, duplicate_of, account, status)
+		VALUES ($1, $2, $3, $4, NULLIF($5, 0), $6, NULLIF($7, 0), NULLIF($8, 0), $9, $10)
+		RETURNING id, status_changed_at
 	`
-	err := r.db.QueryRowContext(
+	err = dbTx.QueryRowContext(
 		ctx,
 		q,
 		tx.Amount,
 		tx.Category,
 		tx.Description,
 		tx.Date,
-	).Scan(&tx.ID)
-	
+		tx.RecurringID,
+		len(tx.Splits) > 0,
+		tx.PayeeID,
+		tx.DuplicateOf,
+		tx.Account,
+		tx.Status,
+	).Scan(&tx.ID, &tx.StatusChangedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "expenses_recurring_occurrence_idx" {
+			return domain.ErrAlreadyPosted
+		}
+		return err
+	}
+
+	const history = `
+		INSERT INTO transaction_status_changes (transaction_id, to_status, changed_at)
+		VALUES ($1, $2, $3)
+	`
+	if _, err := dbTx.ExecContext(ctx, history, tx.ID, tx.Status, tx.StatusChangedAt); err != nil {
+		return err
+	}
+
+	if err := addTags(ctx, dbTx, tx.ID, tx.Tags); err != nil {
+		return err
+	}
+
+	if err := addSplits(ctx, dbTx, tx); err != nil {
+		return err
+	}
+
+	for _, line := range tx.Lines() {
+		if err := registerCategory(ctx, dbTx, line.Category); err != nil {
+			return err
+		}
+	}
+
+	event := domain.NewEvent(domain.EventTransactionCreated, domain.NewTransactionEvent(*tx))
+	for _, e := range append([]domain.Event{event}, events...) {
+		if err := writeOutbox(ctx, dbTx, e); err != nil {
+			return err
+		}
+	}
+
+	return dbTx.Commit()
+}
+
+// addTags links the transaction to tags, creating the ones that do not
+// exist yet.
+func addTags(ctx context.Context, dbTx *sql.Tx, id int, tags []string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	const upsert = `
+		INSERT INTO tags (name)
+		SELECT unnest($1::text[])
+		ON CONFLICT (name) DO NOTHING
+	`
+	if _, err := dbTx.ExecContext(ctx, upsert, tags); err != nil {
+		return err
+	}
+
+	const link = `
+		INSERT INTO transaction_tags (transaction_id, tag_id)
+		SELECT $1, id FROM tags WHERE name = ANY($2::text[])
+		ON CONFLICT DO NOTHING
+	`
+	_, err := dbTx.ExecContext(ctx, link, id, tags)
+	return err
+}
+
+func addSplits(ctx context.Context, dbTx *sql.Tx, tx *domain.Transaction) error {
+	const q = `
+		INSERT INTO expense_splits (transaction_id, category, amount, date)
+		VALUES ($1, $2, $3, $4)
+	`
+	for _, s := range tx.Splits {
+		if _, err := dbTx.ExecContext(ctx, q, tx.ID, s.Category, s.Amount, tx.Date); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// registerCategory adds a category seen for the first time to the
+// categories table, so later spellings of it resolve to this one.
+func registerCategory(ctx context.Context, dbTx *sql.Tx, category string) error {
+	const q = `INSERT INTO categories (name) VALUES ($1) ON CONFLICT DO NOTHING`
+	_, err := dbTx.ExecContext(ctx, q, category)
 	return err
 }
 
-func (r TransactionRepository) List(ctx context.Context) ([]domain.Transaction, error) {
+func (r TransactionRepository) List(ctx context.Context, filter domain.TransactionFilter) ([]domain.Transaction, error) {
+	const q = `
+		SELECT e.id, e.amount, e.category, e.description, e.date,
+		       COALESCE(e.recurring_id, 0),
+		       COALESCE(e.payee_id, 0), COALESCE(p.name, ''),
+		       COALESCE(e.duplicate_of, 0),
+		       e.account, e.status, e.status_changed_at, COALESCE(e.reconciliation_id, 0),
+		       COALESCE((SELECT SUM(rf.amount) FROM refunds rf WHERE rf.transaction_id = e.id), 0),
+		       COALESCE(
+		           (SELECT json_agg(t.name ORDER BY t.name)
+		            FROM transaction_tags tt
+		            JOIN tags t ON t.id = tt.tag_id
+		            WHERE tt.transaction_id = e.id),
+		           '[]'
+		       ),
+		       COALESCE(
+		           (SELECT json_agg(json_build_object('category', s.category, 'amount', s.amount) ORDER BY s.id)
+		            FROM expense_splits s
+		            WHERE s.transaction_id = e.id),
+		           '[]'
+		       )
+		FROM expenses e
+		LEFT JOIN payees p ON p.id = e.payee_id
+		WHERE (cardinality($1::text[]) = 0
+		    OR (SELECT COUNT(*)
+		        FROM transaction_tags tt
+		        JOIN tags t ON t.id = tt.tag_id
+		        WHERE tt.transaction_id = e.id
+		          AND t.name = ANY($1::text[])) = cardinality($1::text[]))
+		  AND (cardinality($2::text[]) = 0 OR e.status = ANY($2::text[]))
+		ORDER BY e.date DESC, e.id DESC
+	`
+
+	tags := filter.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	rows, err := r.db.QueryContext(ctx, q, tags, statusNames(filter.Statuses))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []domain.Transaction
+	for rows.Next() {
+		var tx domain.Transaction
+		var tagsJSON, splitsJSON []byte
+		if err := rows.Scan(
+			&tx.ID,
+			&tx.Amount,
+			&tx.Category,
+			&tx.Description,
+			&tx.Date,
+			&tx.RecurringID,
+			&tx.PayeeID,
+			&tx.Payee,
+			&tx.DuplicateOf,
+			&tx.Account,
+			&tx.Status,
+			&tx.StatusChangedAt,
+			&tx.ReconciliationID,
+			&tx.Refunded,
+			&tagsJSON,
+			&splitsJSON,
+		); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(tagsJSON, &tx.Tags); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(splitsJSON, &tx.Splits); err != nil {
+			return nil, err
+		}
+		res = append(res, tx)
+	}
+
+	return res, rows.Err()
+}
+
+func (r TransactionRepository) ListByPeriod(
+	ctx context.Context,
+	from, to time.Time,
+) ([]domain.Transaction, error) {
 	const q = `
 		SELECT id, amount, category, description, date
 		FROM expenses
-		ORDER BY date DESC, id DESC
+		WHERE date >= $1
+		  AND date <= $2
+		  AND status <> 'voided'
+		ORDER BY date ASC, id ASC
 	`
-	
-	rows, err := r.db.QueryContext(ctx, q)
+
+	rows, err := r.db.QueryContext(ctx, q, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []domain.Transaction
+	for rows.Next() {
+		var tx domain.Transaction
+		if err := rows.Scan(
+			&tx.ID,
+			&tx.Amount,
+			&tx.Category,
+			&tx.Description,
+			&tx.Date,
+		); err != nil {
+			return nil, err
+		}
+		res = append(res, tx)
+	}
+
+	return res, rows.Err()
+}
+
+func (r TransactionRepository) ListByCategoryAndPeriod(
+	ctx context.Context,
+	category string,
+	from, to time.Time,
+) ([]domain.Transaction, error) {
+	const q = `
+		SELECT id, amount, category, description, date
+		FROM expenses
+		WHERE category = $1
+		  AND date >= $2
+		  AND date <= $3
+		  AND status <> 'voided'
+		ORDER BY date ASC, id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, category, from, to)
 	if err != nil {
 		return nil, err
 	}
@@ -64,7 +287,7 @@ func (r TransactionRepository) List(ctx context.Context) ([]domain.Transaction,
 func (r TransactionRepository) SumByCategory(ctx context.Context, category string) (float64, error) {
 	var sum sql.NullFloat64
 	const q = `
-		SELECT COALESCE(SUM(amount), 0) FROM expenses WHERE category = $1
+		SELECT COALESCE(SUM(amount), 0) FROM expense_lines WHERE category = $1
 	`
 	if err := r.db.QueryRowContext(
 		ctx,
@@ -82,9 +305,303 @@ func (r TransactionRepository) SumByCategory(ctx context.Context, category strin
 	return sum.Float64, nil
 }
 
+// SumByCategories is SumByCategory over several categories at once.
+func (r TransactionRepository) SumByCategories(
+	ctx context.Context,
+	categories []string,
+	statuses []domain.TransactionStatus,
+) (float64, error) {
+	var sum float64
+	const q = `
+		SELECT COALESCE(SUM(amount), 0) FROM expense_lines
+		WHERE category = ANY($1::text[])
+		  AND (cardinality($2::text[]) = 0 OR status = ANY($2::text[]))
+	`
+	if err := r.db.QueryRowContext(ctx, q, categories, statusNames(statuses)).Scan(&sum); err != nil {
+		return 0, err
+	}
+
+	return sum, nil
+}
+
+func (r TransactionRepository) Reclassify(ctx context.Context, tx domain.Transaction) error {
+	dbTx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer dbTx.Rollback()
+
+	// A split transaction keeps the category of its largest line.
+	const q = `
+		UPDATE expenses
+		SET category = CASE WHEN split THEN category ELSE $2 END, description = $3
+		WHERE id = $1 AND reconciliation_id IS NULL
+	`
+	res, err := dbTx.ExecContext(ctx, q, tx.ID, tx.Category, tx.Description)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return domain.ErrTransactionLocked
+	}
+
+	// Refunds of an unsplit transaction follow its category.
+	const refunds = `
+		UPDATE refunds rf SET category = $2
+		FROM expenses e
+		WHERE e.id = rf.transaction_id AND e.id = $1 AND NOT e.split
+	`
+	if _, err := dbTx.ExecContext(ctx, refunds, tx.ID, tx.Category); err != nil {
+		return err
+	}
+
+	if err := addTags(ctx, dbTx, tx.ID, tx.Tags); err != nil {
+		return err
+	}
+
+	if err := registerCategory(ctx, dbTx, tx.Category); err != nil {
+		return err
+	}
+
+	return dbTx.Commit()
+}
+
+func (r TransactionRepository) SetStatus(
+	ctx context.Context,
+	ids []int,
+	status domain.TransactionStatus,
+) ([]time.Time, error) {
+	dbTx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer dbTx.Rollback()
+
+	const lock = `
+		SELECT id, status, date FROM expenses
+		WHERE id = ANY($1::int[])
+		ORDER BY id
+		FOR UPDATE
+	`
+	rows, err := dbTx.QueryContext(ctx, lock, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		found   int
+		changed []int
+		dates   []time.Time
+	)
+	for rows.Next() {
+		var (
+			id   int
+			from domain.TransactionStatus
+			date time.Time
+		)
+		if err := rows.Scan(&id, &from, &date); err != nil {
+			return nil, err
+		}
+		found++
+
+		switch {
+		case from == status:
+			continue
+		case from == domain.StatusReconciled:
+			return nil, domain.ErrTransactionLocked
+		case !from.CanBecome(status):
+			return nil, fmt.Errorf("%w: transaction %d is %s", domain.ErrStatusChange, id, from)
+		}
+		changed = append(changed, id)
+		dates = append(dates, date)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	if found != len(ids) {
+		return nil, domain.ErrTransactionNotFound
+	}
+	if len(changed) == 0 {
+		return nil, nil
+	}
+
+	// The daily totals triggers take voided rows and their split lines
+	// out of the reports.
+	const update = `
+		WITH old AS (
+			SELECT id, status FROM expenses WHERE id = ANY($1::int[])
+		), updated AS (
+			UPDATE expenses e SET status = $2, status_changed_at = now()
+			FROM old
+			WHERE e.id = old.id
+			RETURNING e.id, old.status AS from_status, e.status_changed_at
+		)
+		INSERT INTO transaction_status_changes (transaction_id, from_status, to_status, changed_at)
+		SELECT id, from_status, $2, status_changed_at FROM updated
+	`
+	if _, err := dbTx.ExecContext(ctx, update, changed, status); err != nil {
+		return nil, err
+	}
+
+	if status == domain.StatusVoided {
+		for _, q := range []string{
+			`UPDATE expense_splits SET voided = true WHERE transaction_id = ANY($1::int[])`,
+			`UPDATE refunds SET voided = true WHERE transaction_id = ANY($1::int[])`,
+		} {
+			if _, err := dbTx.ExecContext(ctx, q, changed); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return dates, dbTx.Commit()
+}
+
+func (r TransactionRepository) ListStatusChanges(ctx context.Context, id int) ([]domain.StatusChange, error) {
+	const exists = `SELECT EXISTS (SELECT 1 FROM expenses WHERE id = $1)`
+	var ok bool
+	if err := r.db.QueryRowContext(ctx, exists, id).Scan(&ok); err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, domain.ErrTransactionNotFound
+	}
+
+	const q = `
+		SELECT transaction_id, from_status, to_status, changed_at
+		FROM transaction_status_changes
+		WHERE transaction_id = $1
+		ORDER BY changed_at, id
+	`
+	rows, err := r.db.QueryContext(ctx, q, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.StatusChange, 0)
+	for rows.Next() {
+		var c domain.StatusChange
+		if err := rows.Scan(&c.TransactionID, &c.From, &c.To, &c.ChangedAt); err != nil {
+			return nil, err
+		}
+		res = append(res, c)
+	}
+
+	return res, rows.Err()
+}
+
+func (r TransactionRepository) ListUnreconciled(
+	ctx context.Context,
+	account string,
+	through time.Time,
+) ([]domain.Transaction, error) {
+	const q = `
+		SELECT id, amount, category, description, date, account, status, status_changed_at
+		FROM expenses
+		WHERE account = $1
+		  AND date <= $2
+		  AND reconciliation_id IS NULL
+		  AND status <> 'voided'
+		ORDER BY date ASC, id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, account, through)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []domain.Transaction
+	for rows.Next() {
+		var tx domain.Transaction
+		if err := rows.Scan(
+			&tx.ID,
+			&tx.Amount,
+			&tx.Category,
+			&tx.Description,
+			&tx.Date,
+			&tx.Account,
+			&tx.Status,
+			&tx.StatusChangedAt,
+		); err != nil {
+			return nil, err
+		}
+		res = append(res, tx)
+	}
+
+	return res, rows.Err()
+}
+
+func (r TransactionRepository) Reconcile(ctx context.Context, rec *domain.Reconciliation) (int, error) {
+	dbTx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer dbTx.Rollback()
+
+	const lock = `SELECT completed_at IS NOT NULL FROM reconciliations WHERE id = $1 FOR UPDATE`
+	var completed bool
+	if err := dbTx.QueryRowContext(ctx, lock, rec.ID).Scan(&completed); err != nil {
+		return 0, err
+	}
+	if completed {
+		return 0, domain.ErrReconciliationCompleted
+	}
+
+	// Lock the cleared rows so the total cannot change before they are
+	// reconciled.
+	const cleared = `
+		SELECT COALESCE(SUM(amount), 0) FROM (
+			SELECT amount FROM expenses
+			WHERE account = $1 AND date <= $2
+			  AND status = 'cleared' AND reconciliation_id IS NULL
+			FOR UPDATE
+		) c
+	`
+	if err := dbTx.QueryRowContext(ctx, cleared, rec.Account, rec.StatementDate).Scan(&rec.ClearedTotal); err != nil {
+		return 0, err
+	}
+	if !rec.Balanced() {
+		return 0, domain.ErrReconciliationUnbalanced
+	}
+
+	const reconcile = `
+		WITH reconciled AS (
+			UPDATE expenses
+			SET reconciliation_id = $3, status = 'reconciled', status_changed_at = now()
+			WHERE account = $1 AND date <= $2
+			  AND status = 'cleared' AND reconciliation_id IS NULL
+			RETURNING id, status_changed_at
+		)
+		INSERT INTO transaction_status_changes (transaction_id, from_status, to_status, changed_at)
+		SELECT id, 'cleared', 'reconciled', status_changed_at FROM reconciled
+	`
+	res, err := dbTx.ExecContext(ctx, reconcile, rec.Account, rec.StatementDate, rec.ID)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	const complete = `UPDATE reconciliations SET completed_at = now() WHERE id = $1 RETURNING completed_at`
+	if err := dbTx.QueryRowContext(ctx, complete, rec.ID).Scan(&rec.CompletedAt); err != nil {
+		return 0, err
+	}
+
+	return int(n), dbTx.Commit()
+}
+
 func (r TransactionRepository) ListCategories(ctx context.Context) ([]string, error) {
 	const q = `
-		SELECT DISTINCT category FROM expenses
+		SELECT DISTINCT category FROM expense_lines
 		ORDER BY category ASC
 	`
 	rows, err := r.db.QueryContext(ctx, q)
@@ -117,11 +634,11 @@ func (r TransactionRepository) SumByCategoryAndPeriod(
 	var sum sql.NullFloat64
 
 	const q = `
-		SELECT COALESCE(SUM(amount), 0)
-		FROM expenses
+		SELECT COALESCE(SUM(total), 0)
+		FROM daily_category_totals
 		WHERE category = $1
-		  AND date >= $2
-		  AND date <= $3
+		  AND day >= $2
+		  AND day <= $3
 	`
 
 	if err := r.db.QueryRowContext(
@@ -139,4 +656,203 @@ func (r TransactionRepository) SumByCategoryAndPeriod(
 	}
 
 	return sum.Float64, nil
-}
\ No newline at end of file
+}
+
+func (r TransactionRepository) SumByCategoriesInPeriod(
+	ctx context.Context,
+	from, to time.Time,
+) (map[string]float64, error) {
+	const q = `
+		SELECT category, SUM(total)
+		FROM daily_category_totals
+		WHERE day >= $1
+		  AND day <= $2
+		GROUP BY category
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	totals := make(map[string]float64)
+	for rows.Next() {
+		var (
+			category string
+			sum      float64
+		)
+		if err := rows.Scan(&category, &sum); err != nil {
+			return nil, err
+		}
+		totals[category] = sum
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return totals, nil
+}
+
+func (r TransactionRepository) SumByCategoriesInPeriodByStatus(
+	ctx context.Context,
+	from, to time.Time,
+	statuses []domain.TransactionStatus,
+) (map[string]float64, error) {
+	const q = `
+		SELECT category, SUM(amount)
+		FROM expense_lines
+		WHERE date >= $1
+		  AND date <= $2
+		  AND status = ANY($3::text[])
+		GROUP BY category
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, from, to, statusNames(statuses))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	totals := make(map[string]float64)
+	for rows.Next() {
+		var (
+			category string
+			sum      float64
+		)
+		if err := rows.Scan(&category, &sum); err != nil {
+			return nil, err
+		}
+		totals[category] = sum
+	}
+
+	return totals, rows.Err()
+}
+
+func (r TransactionRepository) SumByCategoryAndBucket(
+	ctx context.Context,
+	granularity domain.Granularity,
+	from, to time.Time,
+	categories []string,
+) ([]domain.BucketTotal, error) {
+	const q = `
+		SELECT category, date_trunc($1, day::timestamp)::date AS bucket, SUM(total)
+		FROM daily_category_totals
+		WHERE day >= $2
+		  AND day <= $3
+		  AND (cardinality($4::text[]) = 0 OR category = ANY($4::text[]))
+		GROUP BY category, bucket
+		ORDER BY category, bucket
+	`
+
+	if categories == nil {
+		categories = []string{}
+	}
+
+	rows, err := r.db.QueryContext(ctx, q, string(granularity), from, to, categories)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []domain.BucketTotal
+	for rows.Next() {
+		var bt domain.BucketTotal
+		if err := rows.Scan(&bt.Category, &bt.Start, &bt.Amount); err != nil {
+			return nil, err
+		}
+		res = append(res, bt)
+	}
+
+	return res, rows.Err()
+}
+
+func (r TransactionRepository) SumByPayeeInPeriod(
+	ctx context.Context,
+	from, to time.Time,
+	limit int,
+) ([]domain.PayeeSpend, error) {
+	const q = `
+		SELECT p.id, p.name, SUM(e.amount - COALESCE(rf.amount, 0)), COUNT(*)
+		FROM expenses e
+		JOIN payees p ON p.id = e.payee_id
+		LEFT JOIN (
+			SELECT transaction_id, SUM(amount) AS amount FROM refunds GROUP BY transaction_id
+		) rf ON rf.transaction_id = e.id
+		WHERE e.date >= $1
+		  AND e.date <= $2
+		  AND e.status <> 'voided'
+		GROUP BY p.id, p.name
+		ORDER BY SUM(e.amount - COALESCE(rf.amount, 0)) DESC, p.name
+		LIMIT NULLIF($3, 0)
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, from, to, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []domain.PayeeSpend
+	for rows.Next() {
+		var ps domain.PayeeSpend
+		if err := rows.Scan(&ps.PayeeID, &ps.Payee, &ps.Spent, &ps.Count); err != nil {
+			return nil, err
+		}
+		out = append(out, ps)
+	}
+
+	return out, rows.Err()
+}
+
+func (r TransactionRepository) SumByTagInPeriod(
+	ctx context.Context,
+	from, to time.Time,
+) (map[string]float64, error) {
+	const q = `
+		SELECT t.name, SUM(e.amount - COALESCE(rf.amount, 0))
+		FROM expenses e
+		JOIN transaction_tags tt ON tt.transaction_id = e.id
+		JOIN tags t ON t.id = tt.tag_id
+		LEFT JOIN (
+			SELECT transaction_id, SUM(amount) AS amount FROM refunds GROUP BY transaction_id
+		) rf ON rf.transaction_id = e.id
+		WHERE e.date >= $1
+		  AND e.date <= $2
+		  AND e.status <> 'voided'
+		GROUP BY t.name
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	totals := make(map[string]float64)
+	for rows.Next() {
+		var (
+			tag string
+			sum float64
+		)
+		if err := rows.Scan(&tag, &sum); err != nil {
+			return nil, err
+		}
+		totals[tag] = sum
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return totals, nil
+}
+
+func statusNames(statuses []domain.TransactionStatus) []string {
+	names := make([]string, len(statuses))
+	for i, st := range statuses {
+		names[i] = string(st)
+	}
+	return names
+}
@@ -0,0 +1,186 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+type WebhookRepository struct {
+	db *sql.DB
+}
+
+const webhookColumns = `
+	id, url, secret, array_to_json(events), min_amount, threshold, created_at
+`
+
+func scanWebhook(row interface{ Scan(...any) error }) (domain.WebhookSubscription, error) {
+	var (
+		s          domain.WebhookSubscription
+		eventsJSON []byte
+	)
+	err := row.Scan(
+		&s.ID,
+		&s.URL,
+		&s.Secret,
+		&eventsJSON,
+		&s.MinAmount,
+		&s.Threshold,
+		&s.CreatedAt,
+	)
+	if err != nil {
+		return s, err
+	}
+
+	return s, json.Unmarshal(eventsJSON, &s.Events)
+}
+
+func (r WebhookRepository) Create(ctx context.Context, s *domain.WebhookSubscription) error {
+	events := make([]string, 0, len(s.Events))
+	for _, e := range s.Events {
+		events = append(events, string(e))
+	}
+
+	const q = `
+		INSERT INTO webhook_subscriptions (url, secret, events, min_amount, threshold)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+	return r.db.QueryRowContext(
+		ctx,
+		q,
+		s.URL,
+		s.Secret,
+		events,
+		s.MinAmount,
+		s.Threshold,
+	).Scan(&s.ID, &s.CreatedAt)
+}
+
+func (r WebhookRepository) Delete(ctx context.Context, id int) (bool, error) {
+	const q = `DELETE FROM webhook_subscriptions WHERE id = $1`
+	res, err := r.db.ExecContext(ctx, q, id)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+func (r WebhookRepository) Get(ctx context.Context, id int) (domain.WebhookSubscription, bool, error) {
+	q := `SELECT ` + webhookColumns + ` FROM webhook_subscriptions WHERE id = $1`
+	s, err := scanWebhook(r.db.QueryRowContext(ctx, q, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return s, false, nil
+	}
+	if err != nil {
+		return s, false, err
+	}
+
+	return s, true, nil
+}
+
+func (r WebhookRepository) List(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	q := `SELECT ` + webhookColumns + ` FROM webhook_subscriptions ORDER BY id`
+	return r.list(ctx, q)
+}
+
+func (r WebhookRepository) ListByEvent(ctx context.Context, e domain.EventType) ([]domain.WebhookSubscription, error) {
+	q := `SELECT ` + webhookColumns + ` FROM webhook_subscriptions WHERE events @> ARRAY[$1::text] ORDER BY id`
+	return r.list(ctx, q, string(e))
+}
+
+func (r WebhookRepository) list(ctx context.Context, q string, args ...any) ([]domain.WebhookSubscription, error) {
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.WebhookSubscription, 0)
+	for rows.Next() {
+		s, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, s)
+	}
+
+	return res, rows.Err()
+}
+
+func (r WebhookRepository) LogDelivery(ctx context.Context, d *domain.WebhookDelivery) error {
+	const q = `
+		INSERT INTO webhook_deliveries
+			(subscription_id, event_id, event_type, attempt,
+			 status_code, error, succeeded, duration_ms)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at
+	`
+	return r.db.QueryRowContext(
+		ctx,
+		q,
+		d.SubscriptionID,
+		d.EventID,
+		string(d.EventType),
+		d.Attempt,
+		d.StatusCode,
+		d.Error,
+		d.Succeeded,
+		d.Duration.Milliseconds(),
+	).Scan(&d.ID, &d.CreatedAt)
+}
+
+func (r WebhookRepository) ListDeliveries(
+	ctx context.Context,
+	subscriptionID, limit int,
+) ([]domain.WebhookDelivery, error) {
+	const q = `
+		SELECT id, subscription_id, event_id, event_type, attempt,
+		       status_code, error, succeeded, duration_ms, created_at
+		FROM webhook_deliveries
+		WHERE subscription_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`
+	rows, err := r.db.QueryContext(ctx, q, subscriptionID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.WebhookDelivery, 0)
+	for rows.Next() {
+		var (
+			d          domain.WebhookDelivery
+			durationMS int64
+		)
+		if err := rows.Scan(
+			&d.ID,
+			&d.SubscriptionID,
+			&d.EventID,
+			&d.EventType,
+			&d.Attempt,
+			&d.StatusCode,
+			&d.Error,
+			&d.Succeeded,
+			&durationMS,
+			&d.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		d.Duration = time.Duration(durationMS) * time.Millisecond
+		res = append(res, d)
+	}
+
+	return res, rows.Err()
+}
@@ -0,0 +1,34 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Poster posts the recurring transactions due on or before asOf.
+type Poster interface {
+	PostDueRecurring(ctx context.Context, asOf time.Time) (int, error)
+}
+
+// Run posts due recurring transactions right away, to catch up after
+// downtime, and then every interval until ctx is done.
+func Run(ctx context.Context, p Poster, interval time.Duration, logger *slog.Logger) {
+	logger.Info("recurring scheduler started", slog.Duration("interval", interval))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.PostDueRecurring(ctx, time.Now()); err != nil && ctx.Err() == nil {
+			logger.Error("recurring scheduler run failed", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("recurring scheduler stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+// anomalyWindowDays is how far back the history of a category reaches
+// when scoring a transaction.
+const anomalyWindowDays = 180
+
+// detectAnomaly scores t against the transactions of its category in the
+// window before it. t itself must not be stored yet.
+func (svc *ledger) detectAnomaly(ctx context.Context, t domain.Transaction) (*domain.Anomaly, error) {
+	history, err := svc.transactions.ListByCategoryAndPeriod(
+		ctx,
+		t.Category,
+		t.Date.AddDate(0, 0, -anomalyWindowDays),
+		t.Date,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	amounts := make([]float64, 0, len(history))
+	for _, h := range history {
+		amounts = append(amounts, h.Amount)
+	}
+
+	return svc.detector.Check(t.Amount, amounts), nil
+}
+
+// GetAnomalies returns the transactions between from and to that were
+// anomalous when they were added, i.e. scored against the history that
+// preceded each of them.
+func (svc *ledger) GetAnomalies(ctx context.Context, from, to time.Time) ([]domain.Transaction, error) {
+	svc.log.Info(
+		"anomalies requested",
+		slog.String("from", from.Format("2006-01-02")),
+		slog.String("to", to.Format("2006-01-02")),
+	)
+
+	txs, err := svc.transactions.ListByPeriod(ctx, from.AddDate(0, 0, -anomalyWindowDays), to)
+	if err != nil {
+		return nil, err
+	}
+
+	byCategory := make(map[string][]domain.Transaction)
+	for _, tx := range txs {
+		byCategory[tx.Category] = append(byCategory[tx.Category], tx)
+	}
+
+	res := make([]domain.Transaction, 0)
+	for _, tx := range txs {
+		if tx.Date.Before(from) {
+			continue
+		}
+
+		windowStart := tx.Date.AddDate(0, 0, -anomalyWindowDays)
+
+		var amounts []float64
+		for _, h := range byCategory[tx.Category] {
+			if h.Date.Before(windowStart) {
+				continue
+			}
+			if h.Date.After(tx.Date) || (h.Date.Equal(tx.Date) && h.ID >= tx.ID) {
+				break
+			}
+			amounts = append(amounts, h.Amount)
+		}
+
+		if a := svc.detector.Check(tx.Amount, amounts); a != nil {
+			tx.Anomaly = a
+			res = append(res, tx)
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+func TestAddTransaction_FlagsAnomaly(t *testing.T) {
+	ctx := context.Background()
+	txs := &memTransactions{}
+	svc := newTestLedger(&memBudgets{}, txs)
+
+	start := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	for i, amount := range []float64{3.5, 3.8, 3.5, 4.2, 3.9, 3.6} {
+		res, err := svc.AddTransaction(ctx, domain.Transaction{
+			Amount:   amount,
+			Category: "coffee",
+			Date:     start.AddDate(0, 0, i),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.Anomaly != nil {
+			t.Fatalf("usual amount %v flagged: %+v", amount, res.Anomaly)
+		}
+	}
+
+	res, err := svc.AddTransaction(ctx, domain.Transaction{
+		Amount:   38,
+		Category: "coffee",
+		Date:     start.AddDate(0, 0, 10),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Anomaly == nil || res.Anomaly.Score <= 0 {
+		t.Fatalf("expected a high anomaly, got %+v", res.Anomaly)
+	}
+
+	anomalies, err := svc.GetAnomalies(ctx, start, start.AddDate(0, 1, 0))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(anomalies) != 1 || anomalies[0].ID != res.ID {
+		t.Fatalf("expected transaction %d only, got %+v", res.ID, anomalies)
+	}
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path"
+	"strings"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+var ErrAttachmentNotFound = errors.New("attachment not found")
+
+// AddAttachment stores data as a file of the transaction a.TransactionID.
+// The blob is written first and removed again if the metadata cannot be
+// saved, so a listed attachment can always be downloaded.
+func (svc *ledger) AddAttachment(ctx context.Context, a domain.Attachment, data []byte) (domain.Attachment, error) {
+	a.Filename = path.Base(strings.ReplaceAll(strings.TrimSpace(a.Filename), "\\", "/"))
+	a.Size = int64(len(data))
+	if err := a.Validate(); err != nil {
+		return a, err
+	}
+
+	key, err := attachmentKey(a.TransactionID)
+	if err != nil {
+		return a, err
+	}
+	a.Key = key
+
+	if err := svc.blobs.Put(ctx, a.Key, data, a.ContentType); err != nil {
+		return a, err
+	}
+
+	if err := svc.attachments.Create(ctx, &a); err != nil {
+		if delErr := svc.blobs.Delete(ctx, a.Key); delErr != nil {
+			svc.log.Warn(
+				"orphaned attachment blob",
+				slog.String("key", a.Key),
+				slog.String("error", delErr.Error()),
+			)
+		}
+		return a, err
+	}
+
+	svc.log.Info(
+		"attachment added",
+		slog.Int("id", a.ID),
+		slog.Int("transaction_id", a.TransactionID),
+		slog.Int64("size", a.Size),
+	)
+
+	return a, nil
+}
+
+func (svc *ledger) ListAttachments(ctx context.Context, transactionID int) ([]domain.Attachment, error) {
+	return svc.attachments.ListByTransaction(ctx, transactionID)
+}
+
+// GetAttachment returns an attachment with its content.
+func (svc *ledger) GetAttachment(ctx context.Context, id int) (domain.Attachment, []byte, error) {
+	a, ok, err := svc.attachments.Get(ctx, id)
+	if err != nil {
+		return a, nil, err
+	}
+	if !ok {
+		return a, nil, ErrAttachmentNotFound
+	}
+
+	data, err := svc.blobs.Get(ctx, a.Key)
+	if err != nil {
+		return a, nil, err
+	}
+
+	return a, data, nil
+}
+
+// attachmentKey returns a fresh blob key under the transaction's prefix.
+func attachmentKey(transactionID int) (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("transactions/%d/%s", transactionID, hex.EncodeToString(b[:])), nil
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/blob"
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+func TestAddAttachment_StoresBlobAndMetadata(t *testing.T) {
+	ctx := context.Background()
+	txs := &memTransactions{}
+	svc := newTestLedger(&memBudgets{}, txs)
+	svc.attachments = &memAttachments{txs: txs}
+	svc.blobs = blob.NewFS(t.TempDir())
+
+	tx := domain.Transaction{Amount: 12, Category: "food", Date: time.Now()}
+	_ = txs.Add(ctx, &tx)
+
+	a, err := svc.AddAttachment(ctx, domain.Attachment{
+		TransactionID: tx.ID,
+		Filename:      `C:\scans\receipt.png`,
+		ContentType:   "image/png",
+	}, []byte("png"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.Filename != "receipt.png" || a.Size != 3 {
+		t.Fatalf("unexpected attachment: %+v", a)
+	}
+
+	got, data, err := svc.GetAttachment(ctx, a.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Key != a.Key || string(data) != "png" {
+		t.Fatalf("unexpected download: %+v %q", got, data)
+	}
+
+	list, _ := svc.ListAttachments(ctx, tx.ID)
+	if len(list) != 1 {
+		t.Fatalf("expected one attachment, got %d", len(list))
+	}
+
+	if _, _, err := svc.GetAttachment(ctx, a.ID+1); !errors.Is(err, ErrAttachmentNotFound) {
+		t.Fatalf("expected ErrAttachmentNotFound, got %v", err)
+	}
+}
+
+func TestAddAttachment_RemovesBlobOfUnknownTransaction(t *testing.T) {
+	ctx := context.Background()
+	txs := &memTransactions{}
+	svc := newTestLedger(&memBudgets{}, txs)
+	svc.attachments = &memAttachments{txs: txs}
+	dir := t.TempDir()
+	svc.blobs = blob.NewFS(dir)
+
+	_, err := svc.AddAttachment(ctx, domain.Attachment{
+		TransactionID: 42,
+		Filename:      "receipt.pdf",
+		ContentType:   "application/pdf",
+	}, []byte("%PDF"))
+	if !errors.Is(err, domain.ErrTransactionNotFound) {
+		t.Fatalf("expected ErrTransactionNotFound, got %v", err)
+	}
+
+	entries, _ := os.ReadDir(filepath.Join(dir, "transactions", "42"))
+	if len(entries) != 0 {
+		t.Fatalf("expected the blob to be removed, found %d files", len(entries))
+	}
+}
+
+func TestAddAttachment_RejectsDisallowedType(t *testing.T) {
+	svc := newTestLedger(&memBudgets{}, &memTransactions{})
+	svc.blobs = blob.NewFS(t.TempDir())
+
+	_, err := svc.AddAttachment(context.Background(), domain.Attachment{
+		TransactionID: 1,
+		Filename:      "run.sh",
+		ContentType:   "text/x-shellscript",
+	}, []byte("#!/bin/sh"))
+	if err == nil {
+		t.Fatal("expected a validation error")
+	}
+}
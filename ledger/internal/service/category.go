@@ -0,0 +1,318 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+var (
+	ErrCategoryNotFound = errors.New("category not found")
+	ErrCategoryExists   = errors.New("category already exists")
+)
+
+func (svc *ledger) categoryTree(ctx context.Context) (domain.CategoryTree, error) {
+	categories, err := svc.categories.List(ctx)
+	if err != nil {
+		return domain.CategoryTree{}, err
+	}
+	return domain.NewCategoryTree(categories), nil
+}
+
+// resolveCategory maps name to the canonical spelling of the category it
+// names or aliases. Unknown names are only normalized.
+func (svc *ledger) resolveCategory(ctx context.Context, name string) (string, error) {
+	name = domain.NormalizeCategory(name)
+	if name == "" {
+		return name, nil
+	}
+
+	canonical, ok, err := svc.categories.Resolve(ctx, name)
+	if err != nil {
+		return name, err
+	}
+	if !ok {
+		return name, nil
+	}
+
+	return canonical, nil
+}
+
+// existingCategory is resolveCategory for names that must exist.
+func (svc *ledger) existingCategory(ctx context.Context, name string) (string, error) {
+	canonical, ok, err := svc.categories.Resolve(ctx, domain.NormalizeCategory(name))
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrCategoryNotFound, name)
+	}
+	return canonical, nil
+}
+
+func (svc *ledger) CreateCategory(ctx context.Context, c domain.Category) (domain.Category, error) {
+	c.Name = domain.NormalizeCategory(c.Name)
+	if err := c.Validate(); err != nil {
+		return c, err
+	}
+
+	_, ok, err := svc.categories.Resolve(ctx, c.Name)
+	if err != nil {
+		return c, err
+	}
+	if ok {
+		return c, ErrCategoryExists
+	}
+
+	if c.Parent != "" {
+		if c.Parent, err = svc.existingCategory(ctx, c.Parent); err != nil {
+			return c, err
+		}
+	}
+
+	if err := svc.categories.Create(ctx, &c); err != nil {
+		return c, err
+	}
+
+	svc.log.Info(
+		"category created",
+		slog.String("name", c.Name),
+		slog.String("parent", c.Parent),
+	)
+
+	return c, nil
+}
+
+func (svc *ledger) ListCategories(ctx context.Context) ([]domain.Category, error) {
+	return svc.categories.List(ctx)
+}
+
+// UpdateCategory moves c.Name under c.Parent. A category cannot be moved
+// under itself or one of its descendants.
+func (svc *ledger) UpdateCategory(ctx context.Context, c domain.Category) (domain.Category, error) {
+	var err error
+	if c.Name, err = svc.existingCategory(ctx, c.Name); err != nil {
+		return c, err
+	}
+	if c.Parent != "" {
+		if c.Parent, err = svc.existingCategory(ctx, c.Parent); err != nil {
+			return c, err
+		}
+	}
+	if err := c.Validate(); err != nil {
+		return c, err
+	}
+
+	if c.Parent != "" {
+		tree, err := svc.categoryTree(ctx)
+		if err != nil {
+			return c, err
+		}
+		if slices.Contains(tree.Subtree(c.Name), c.Parent) {
+			return c, errors.New("validation failed: category cannot be moved under its own subcategory")
+		}
+	}
+
+	ok, err := svc.categories.SetParent(ctx, c.Name, c.Parent)
+	if err != nil {
+		return c, err
+	}
+	if !ok {
+		return c, ErrCategoryNotFound
+	}
+
+	svc.log.Info(
+		"category moved",
+		slog.String("name", c.Name),
+		slog.String("parent", c.Parent),
+	)
+
+	return svc.getCategory(ctx, c.Name)
+}
+
+// DeleteCategory removes a category without subcategories from the tree.
+// Transactions and budgets in it are left alone.
+func (svc *ledger) DeleteCategory(ctx context.Context, name string) error {
+	name, err := svc.existingCategory(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	tree, err := svc.categoryTree(ctx)
+	if err != nil {
+		return err
+	}
+	if tree.HasChildren(name) {
+		return errors.New("validation failed: category has subcategories")
+	}
+
+	ok, err := svc.categories.Delete(ctx, name)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrCategoryNotFound
+	}
+
+	svc.log.Info("category deleted", slog.String("name", name))
+
+	return nil
+}
+
+// AddCategoryAlias makes alias resolve to category, case-insensitively.
+func (svc *ledger) AddCategoryAlias(ctx context.Context, alias, category string) (domain.Category, error) {
+	alias = domain.NormalizeCategory(alias)
+	if alias == "" {
+		return domain.Category{}, errors.New("validation failed: alias cannot be empty")
+	}
+
+	category, err := svc.existingCategory(ctx, category)
+	if err != nil {
+		return domain.Category{}, err
+	}
+
+	taken, ok, err := svc.categories.Resolve(ctx, alias)
+	if err != nil {
+		return domain.Category{}, err
+	}
+	if ok && taken != category {
+		return domain.Category{}, fmt.Errorf("%w: %q is %q", ErrCategoryExists, alias, taken)
+	}
+
+	if err := svc.categories.AddAlias(ctx, alias, category); err != nil {
+		return domain.Category{}, err
+	}
+
+	svc.log.Info(
+		"category alias added",
+		slog.String("alias", alias),
+		slog.String("category", category),
+	)
+
+	return svc.getCategory(ctx, category)
+}
+
+// RenameCategory gives a category a new canonical name and refiles its
+// transactions and budgets under it. The old name stays as an alias.
+func (svc *ledger) RenameCategory(ctx context.Context, from, to string) (domain.Category, error) {
+	from, err := svc.existingCategory(ctx, from)
+	if err != nil {
+		return domain.Category{}, err
+	}
+
+	to = domain.NormalizeCategory(to)
+	if err := (domain.Category{Name: to}).Validate(); err != nil {
+		return domain.Category{}, err
+	}
+
+	taken, ok, err := svc.categories.Resolve(ctx, to)
+	if err != nil {
+		return domain.Category{}, err
+	}
+	if ok && taken != from {
+		return domain.Category{}, fmt.Errorf("%w: %q, merge the categories instead", ErrCategoryExists, taken)
+	}
+
+	if err := svc.categories.Rename(ctx, from, to); err != nil {
+		return domain.Category{}, err
+	}
+
+	svc.log.Info(
+		"category renamed",
+		slog.String("from", from),
+		slog.String("to", to),
+	)
+
+	svc.invalidateAllReports(ctx)
+	svc.forgetModel()
+
+	return svc.getCategory(ctx, to)
+}
+
+// MergeCategories folds sources into target: their transactions, budgets,
+// subcategories and aliases move to target and the source names become
+// aliases of it. Sources that are not registered categories, e.g. old
+// misspellings, only have their transactions and budgets moved.
+func (svc *ledger) MergeCategories(ctx context.Context, sources []string, target string) (domain.Category, error) {
+	target, err := svc.existingCategory(ctx, target)
+	if err != nil {
+		return domain.Category{}, err
+	}
+
+	tree, err := svc.categoryTree(ctx)
+	if err != nil {
+		return domain.Category{}, err
+	}
+
+	names := make([]string, 0, len(sources))
+	for _, source := range sources {
+		name, err := svc.resolveCategory(ctx, source)
+		if err != nil {
+			return domain.Category{}, err
+		}
+		if name == "" {
+			return domain.Category{}, errors.New("validation failed: source category cannot be empty")
+		}
+		if name != target && slices.Contains(tree.Ancestors(target), name) {
+			return domain.Category{}, fmt.Errorf("validation failed: %q is a parent of %q", name, target)
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return domain.Category{}, errors.New("validation failed: no source categories")
+	}
+
+	if err := svc.categories.Merge(ctx, names, target); err != nil {
+		return domain.Category{}, err
+	}
+
+	svc.log.Info(
+		"categories merged",
+		slog.Any("sources", names),
+		slog.String("target", target),
+	)
+
+	svc.invalidateAllReports(ctx)
+	svc.forgetModel()
+
+	return svc.getCategory(ctx, target)
+}
+
+func (svc *ledger) getCategory(ctx context.Context, name string) (domain.Category, error) {
+	c, ok, err := svc.categories.GetByName(ctx, name)
+	if err != nil {
+		return domain.Category{}, err
+	}
+	if !ok {
+		return domain.Category{}, ErrCategoryNotFound
+	}
+	return c, nil
+}
+
+// GetRollUpSummary is GetReportSummary with every category's total also
+// added to all of its parents.
+func (svc *ledger) GetRollUpSummary(
+	ctx context.Context,
+	from, to time.Time,
+	statuses []domain.TransactionStatus,
+) (domain.ReportSummary, error) {
+	totals, err := svc.GetReportSummary(ctx, from, to, statuses)
+	if err != nil {
+		return domain.ReportSummary{}, err
+	}
+
+	tree, err := svc.categoryTree(ctx)
+	if err != nil {
+		return domain.ReportSummary{}, err
+	}
+
+	return domain.ReportSummary{
+		Totals: totals,
+		RollUp: tree.RollUp(totals),
+	}, nil
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"sync"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+// ErrDuplicate is returned under the reject policy for a transaction that
+// looks like one already in the ledger.
+var ErrDuplicate = errors.New("duplicate transaction")
+
+// duplicateLocks serializes the duplicate check and the insert of
+// transactions with the same amount, so two import workers cannot both
+// miss each other. Only amounts that share a stripe wait for each other.
+type duplicateLocks [64]sync.Mutex
+
+func (l *duplicateLocks) lock(amount float64) func() {
+	mu := &l[uint64(math.Round(amount*100))%uint64(len(l))]
+	mu.Lock()
+	return mu.Unlock
+}
+
+// duplicatePolicy is the policy for t. Occurrences of recurring
+// transactions are always allowed: they look alike by design and the
+// unique index on their occurrence already keeps them from being posted
+// twice.
+func (svc *ledger) duplicatePolicy(t domain.Transaction) domain.DuplicatePolicy {
+	if t.RecurringID != 0 {
+		return domain.DuplicateAllow
+	}
+	if t.DuplicatePolicy != "" {
+		return t.DuplicatePolicy
+	}
+	return svc.dupes.Policy
+}
+
+// checkDuplicate compares t with the stored transactions around its date
+// and with pending, e.g. earlier rows of a dry-run batch. Under the flag
+// policy it sets t.DuplicateOf; under the reject policy it returns
+// ErrDuplicate.
+func (svc *ledger) checkDuplicate(ctx context.Context, t *domain.Transaction, pending []domain.Transaction) error {
+	policy := svc.duplicatePolicy(*t)
+	if policy == domain.DuplicateAllow {
+		return nil
+	}
+
+	from, to := svc.dupes.Window(t.Date)
+	candidates, err := svc.transactions.ListByPeriod(ctx, from, to)
+	if err != nil {
+		return err
+	}
+
+	match, ok := svc.dupes.FindDuplicate(*t, append(candidates, pending...))
+	if !ok {
+		return nil
+	}
+
+	svc.log.Info(
+		"suspected duplicate transaction",
+		slog.Int("duplicate_of", match.ID),
+		slog.Float64("amount", t.Amount),
+		slog.String("policy", string(policy)),
+	)
+
+	if policy == domain.DuplicateReject {
+		if match.ID == 0 {
+			return fmt.Errorf("%w of an earlier row", ErrDuplicate)
+		}
+		return fmt.Errorf("%w of transaction %d", ErrDuplicate, match.ID)
+	}
+	t.DuplicateOf = match.ID
+
+	return nil
+}
+
+// GetDuplicateGroups returns the groups of stored transactions that look
+// like the same one recorded several times, leaving out dismissed pairs
+// and voided transactions.
+func (svc *ledger) GetDuplicateGroups(ctx context.Context) ([][]domain.Transaction, error) {
+	txs, err := svc.transactions.List(ctx, domain.TransactionFilter{
+		Statuses: []domain.TransactionStatus{
+			domain.StatusPending,
+			domain.StatusCleared,
+			domain.StatusReconciled,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pairs, err := svc.duplicates.ListDismissed(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dismissed := make(map[[2]int]bool, len(pairs))
+	for _, p := range pairs {
+		dismissed[p] = true
+	}
+
+	return svc.dupes.Groups(txs, func(a, b int) bool {
+		return dismissed[[2]int{min(a, b), max(a, b)}]
+	}), nil
+}
+
+// MergeDuplicates keeps one transaction of a duplicate group and deletes
+// the others. Their tags, attachments and refunds move to the one kept;
+// the merge is refused when the one kept cannot take the refunds.
+func (svc *ledger) MergeDuplicates(ctx context.Context, keep int, duplicates []int) error {
+	if keep <= 0 {
+		return errors.New("validation failed: transaction to keep is required")
+	}
+	ids, err := transactionIDs(duplicates)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return errors.New("validation failed: at least one duplicate is required")
+	}
+	for _, id := range ids {
+		if id == keep {
+			return errors.New("validation failed: cannot merge a transaction into itself")
+		}
+	}
+
+	dates, err := svc.duplicates.Merge(ctx, keep, ids)
+	if err != nil {
+		return err
+	}
+
+	svc.log.Info(
+		"duplicates merged",
+		slog.Int("kept", keep),
+		slog.Int("deleted", len(ids)),
+	)
+
+	svc.invalidateReports(ctx, dates...)
+	svc.forgetModel()
+
+	return nil
+}
+
+// DismissDuplicates records that ids are separate transactions, so they
+// are no longer reported as duplicates of each other.
+func (svc *ledger) DismissDuplicates(ctx context.Context, ids []int) error {
+	ids, err := transactionIDs(ids)
+	if err != nil {
+		return err
+	}
+	if len(ids) < 2 {
+		return errors.New("validation failed: at least two transactions are required")
+	}
+
+	if err := svc.duplicates.Dismiss(ctx, ids); err != nil {
+		return err
+	}
+
+	svc.log.Info("duplicates dismissed", slog.Int("transactions", len(ids)))
+
+	return nil
+}
+
+// transactionIDs checks ids and drops repeated ones.
+func transactionIDs(ids []int) ([]int, error) {
+	seen := make(map[int]bool, len(ids))
+	res := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, fmt.Errorf("validation failed: invalid transaction id %d", id)
+		}
+		if !seen[id] {
+			seen[id] = true
+			res = append(res, id)
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+func TestAddTransaction_DuplicatePolicy(t *testing.T) {
+	ctx := context.Background()
+	txs := &memTransactions{}
+	svc := newTestLedger(&memBudgets{}, txs)
+	day := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+
+	first, err := svc.AddTransaction(ctx, domain.Transaction{
+		Amount: 42.5, Category: "food", Description: "LIDL #88 - weekly shop", Date: day,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	again := domain.Transaction{
+		Amount: 42.5, Category: "food", Description: "Lidl 88 weekly shop", Date: day.AddDate(0, 0, 1),
+	}
+
+	flagged, err := svc.AddTransaction(ctx, again)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if flagged.DuplicateOf != first.ID {
+		t.Fatalf("expected a duplicate of %d, got %+v", first.ID, flagged)
+	}
+
+	again.DuplicatePolicy = domain.DuplicateReject
+	if _, err := svc.AddTransaction(ctx, again); !errors.Is(err, ErrDuplicate) {
+		t.Fatalf("expected ErrDuplicate, got %v", err)
+	}
+
+	again.DuplicatePolicy = domain.DuplicateAllow
+	allowed, err := svc.AddTransaction(ctx, again)
+	if err != nil || allowed.DuplicateOf != 0 {
+		t.Fatalf("unexpected result: %+v, %v", allowed, err)
+	}
+
+	if all, _ := txs.List(ctx, domain.TransactionFilter{}); len(all) != 3 {
+		t.Fatalf("expected the rejected transaction not to be stored, got %d", len(all))
+	}
+}
+
+func TestImportTransactions_RejectsDuplicatesWithinBatch(t *testing.T) {
+	ctx := context.Background()
+	txs := &memTransactions{}
+	svc := newTestLedger(&memBudgets{}, txs)
+	day := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+
+	batch := make([]domain.Transaction, 0, 8)
+	for range 8 {
+		batch = append(batch, domain.Transaction{
+			Amount:          12,
+			Category:        "food",
+			Description:     "Coffee Bar",
+			Date:            day,
+			DuplicatePolicy: domain.DuplicateReject,
+		})
+	}
+
+	res, err := svc.ImportTransactions(ctx, batch, 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Accepted != 1 || res.Rejected != 7 {
+		t.Fatalf("expected one row to be kept, got %+v", res)
+	}
+
+	dry, err := svc.DryRunImport(ctx, batch[:1])
+	if err != nil || dry.Rejected != 1 {
+		t.Fatalf("expected the dry run to reject the stored duplicate, got %+v, %v", dry, err)
+	}
+}
+
+func TestDryRunImport_FlagsDuplicatesWithinBatch(t *testing.T) {
+	svc := newTestLedger(&memBudgets{}, &memTransactions{})
+	day := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+
+	res, err := svc.DryRunImport(context.Background(), []domain.Transaction{
+		{Amount: 12, Category: "food", Description: "Coffee Bar", Date: day, DuplicatePolicy: domain.DuplicateReject},
+		{Amount: 12, Category: "food", Description: "COFFEE BAR", Date: day.AddDate(0, 0, 1), DuplicatePolicy: domain.DuplicateReject},
+		{Amount: 12, Category: "food", Description: "Bakery", Date: day, DuplicatePolicy: domain.DuplicateReject},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Accepted != 2 || res.Rejected != 1 || res.Errors[0].Index != 1 {
+		t.Fatalf("expected the second row to be rejected, got %+v", res)
+	}
+}
+
+func TestDuplicateGroups_MergeAndDismiss(t *testing.T) {
+	ctx := context.Background()
+	txs := &memTransactions{}
+	svc := newTestLedger(&memBudgets{}, txs)
+	day := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+
+	for _, tx := range []domain.Transaction{
+		{Amount: 12, Category: "food", Description: "Coffee Bar", Date: day},
+		{Amount: 12, Category: "food", Description: "coffee bar", Date: day.AddDate(0, 0, 1)},
+		{Amount: 99, Category: "home", Description: "Rent", Date: day},
+		{Amount: 99, Category: "home", Description: "Rent", Date: day.AddDate(0, 0, 2)},
+	} {
+		tx.DuplicatePolicy = domain.DuplicateAllow
+		if _, err := svc.AddTransaction(ctx, tx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	groups, err := svc.GetDuplicateGroups(ctx)
+	if err != nil || len(groups) != 2 {
+		t.Fatalf("expected 2 groups, got %+v, %v", groups, err)
+	}
+
+	if err := svc.DismissDuplicates(ctx, []int{3, 4}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if groups, _ = svc.GetDuplicateGroups(ctx); len(groups) != 1 || groups[0][0].ID != 1 {
+		t.Fatalf("expected the dismissed group to be gone, got %+v", groups)
+	}
+
+	if err := svc.MergeDuplicates(ctx, 1, []int{1}); err == nil {
+		t.Fatal("expected an error merging a transaction into itself")
+	}
+	if err := svc.MergeDuplicates(ctx, 1, []int{7}); !errors.Is(err, domain.ErrTransactionNotFound) {
+		t.Fatalf("expected ErrTransactionNotFound, got %v", err)
+	}
+
+	if err := svc.MergeDuplicates(ctx, 1, []int{2, 2}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if groups, _ = svc.GetDuplicateGroups(ctx); len(groups) != 0 {
+		t.Fatalf("expected no groups after the merge, got %+v", groups)
+	}
+	if all, _ := txs.List(ctx, domain.TransactionFilter{}); len(all) != 3 {
+		t.Fatalf("expected the duplicate to be deleted, got %d transactions", len(all))
+	}
+}
+
+func TestMergeDuplicates_MovesRefunds(t *testing.T) {
+	ctx := context.Background()
+	txs := &memTransactions{}
+	svc := newTestLedger(&memBudgets{}, txs)
+	day := time.Now().AddDate(0, 0, -3)
+
+	keep, _ := svc.AddTransaction(ctx, domain.Transaction{Amount: 50, Category: "shoes", Date: day})
+	dup, _ := svc.AddTransaction(ctx, domain.Transaction{Amount: 50, Category: "shoes", Date: day})
+	if _, err := svc.CreateRefund(ctx, domain.Refund{TransactionID: dup.ID, Amount: 20}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := svc.MergeDuplicates(ctx, keep.ID, []int{dup.ID}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	refunds, _ := svc.ListRefunds(ctx, keep.ID)
+	if len(refunds) != 1 || refunds[0].Amount != 20 {
+		t.Fatalf("expected the refund to move to the kept transaction, got %+v", refunds)
+	}
+
+	// The kept transaction cannot take refunds of more than it cost.
+	other, _ := svc.AddTransaction(ctx, domain.Transaction{Amount: 50, Category: "shoes", Date: day})
+	if _, err := svc.CreateRefund(ctx, domain.Refund{TransactionID: other.ID, Amount: 40}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.MergeDuplicates(ctx, keep.ID, []int{other.ID}); !errors.Is(err, domain.ErrRefundExceedsOriginal) {
+		t.Fatalf("expected ErrRefundExceedsOriginal, got %v", err)
+	}
+	if !txs.exist(other.ID) {
+		t.Fatal("expected the refused merge to keep the duplicate")
+	}
+	if refunds, _ := svc.ListRefunds(ctx, other.ID); len(refunds) != 1 {
+		t.Fatalf("expected the refused merge to keep the refund, got %+v", refunds)
+	}
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+const (
+	// forecastHistoryPeriods is how many past periods the history-based
+	// projection averages over.
+	forecastHistoryPeriods = 3
+	// recurringMinPeriods is how many of the most recent past periods an
+	// item must appear in to be treated as recurring.
+	recurringMinPeriods = 2
+)
+
+type pastPeriod struct {
+	start, end time.Time
+	// sameDay is the point of the period matching AsOf in the current one.
+	sameDay time.Time
+}
+
+// GetForecast projects each category's spend at the end of the period
+// containing q.AsOf. The part still to come is the average of the pace and
+// history estimates, but never less than the recurring items still due.
+// A budget on a parent category is checked against the projected spend of
+// its whole subtree, so a budgeted parent gets a line even when all of
+// its spend is booked on subcategories.
+func (svc *ledger) GetForecast(ctx context.Context, q domain.ForecastQuery) (domain.Forecast, error) {
+	if q.AsOf.IsZero() {
+		q.AsOf = time.Now()
+	}
+	if q.Period == "" {
+		q.Period = domain.GranularityMonth
+	}
+	if err := q.Validate(); err != nil {
+		return domain.Forecast{}, err
+	}
+
+	asOf := domain.GranularityDay.Truncate(q.AsOf)
+	start := q.Period.Truncate(asOf)
+	end := q.Period.Next(start).AddDate(0, 0, -1)
+
+	svc.log.Info(
+		"forecast requested",
+		slog.String("period", string(q.Period)),
+		slog.String("as_of", asOf.Format("2006-01-02")),
+	)
+
+	elapsed := days(start, asOf)
+	total := days(start, end)
+
+	spent, err := svc.transactions.SumByCategoriesInPeriod(ctx, start, asOf)
+	if err != nil {
+		return domain.Forecast{}, err
+	}
+
+	past := make([]pastPeriod, 0, forecastHistoryPeriods)
+	for i, s := 0, start; i < forecastHistoryPeriods; i++ {
+		s = q.Period.Prev(s)
+		e := q.Period.Next(s).AddDate(0, 0, -1)
+		sameDay := s.AddDate(0, 0, elapsed-1)
+		if sameDay.After(e) {
+			sameDay = e
+		}
+		past = append(past, pastPeriod{start: s, end: e, sameDay: sameDay})
+	}
+
+	// historyRest[c] sums, over past periods with any spend in c, what was
+	// spent after the same point of the period.
+	historyRest := make(map[string]float64)
+	historyCount := make(map[string]int)
+	for _, p := range past {
+		full, err := svc.transactions.SumByCategoriesInPeriod(ctx, p.start, p.end)
+		if err != nil {
+			return domain.Forecast{}, err
+		}
+		sofar, err := svc.transactions.SumByCategoriesInPeriod(ctx, p.start, p.sameDay)
+		if err != nil {
+			return domain.Forecast{}, err
+		}
+		for c, sum := range full {
+			if sum <= 0 {
+				continue
+			}
+			historyRest[c] += sum - sofar[c]
+			historyCount[c]++
+		}
+	}
+
+	recurring, err := svc.pendingRecurring(ctx, past, start, asOf)
+	if err != nil {
+		return domain.Forecast{}, err
+	}
+
+	budgets, err := svc.budgets.List(ctx)
+	if err != nil {
+		return domain.Forecast{}, err
+	}
+	tree, err := svc.categoryTree(ctx)
+	if err != nil {
+		return domain.Forecast{}, err
+	}
+
+	categories := make(map[string]bool)
+	for c := range spent {
+		categories[c] = true
+	}
+	for c := range historyCount {
+		categories[c] = true
+	}
+	for c := range recurring {
+		categories[c] = true
+	}
+
+	forecast := domain.Forecast{
+		Period:      q.Period,
+		PeriodStart: start,
+		PeriodEnd:   end,
+		AsOf:        asOf,
+		Lines:       make([]domain.ForecastLine, 0, len(categories)),
+	}
+
+	for c := range categories {
+		line := domain.ForecastLine{
+			Category:         c,
+			Spent:            spent[c],
+			PaceProjection:   spent[c] / float64(elapsed) * float64(total),
+			RecurringPending: recurring[c],
+		}
+
+		rest := line.PaceProjection - line.Spent
+		if n := historyCount[c]; n > 0 {
+			line.HasHistory = true
+			line.HistoryProjection = line.Spent + historyRest[c]/float64(n)
+			rest = (rest + historyRest[c]/float64(n)) / 2
+		}
+		line.Projected = line.Spent + math.Max(rest, line.RecurringPending)
+
+		forecast.Lines = append(forecast.Lines, line)
+	}
+
+	projected := make(map[string]float64, len(forecast.Lines))
+	for _, line := range forecast.Lines {
+		projected[line.Category] = line.Projected
+	}
+	rollUp := tree.RollUp(projected)
+
+	for _, b := range budgets {
+		i := slices.IndexFunc(forecast.Lines, func(l domain.ForecastLine) bool { return l.Category == b.Category })
+		if i < 0 {
+			if _, ok := rollUp[b.Category]; !ok {
+				continue
+			}
+			forecast.Lines = append(forecast.Lines, domain.ForecastLine{Category: b.Category})
+			i = len(forecast.Lines) - 1
+		}
+		line := &forecast.Lines[i]
+		line.Limit = b.Limit
+		line.HasBudget = true
+		line.OverBudget = rollUp[b.Category] > b.Limit
+	}
+
+	sort.Slice(forecast.Lines, func(i, j int) bool {
+		return forecast.Lines[i].Category < forecast.Lines[j].Category
+	})
+
+	return forecast, nil
+}
+
+// pendingRecurring finds items posted in each of the most recent past
+// periods but not yet in the current one, and sums them per category.
+// Items are matched on category, description and amount.
+func (svc *ledger) pendingRecurring(
+	ctx context.Context,
+	past []pastPeriod,
+	start, asOf time.Time,
+) (map[string]float64, error) {
+	type itemKey struct {
+		category    string
+		description string
+		cents       int64
+	}
+	keyOf := func(tx domain.Transaction) (itemKey, bool) {
+		d := strings.ToLower(strings.TrimSpace(tx.Description))
+		if d == "" {
+			return itemKey{}, false
+		}
+		return itemKey{tx.Category, d, int64(math.Round(tx.Amount * 100))}, true
+	}
+
+	lookback := past[:min(recurringMinPeriods, len(past))]
+	if len(lookback) == 0 {
+		return map[string]float64{}, nil
+	}
+
+	txs, err := svc.transactions.ListByPeriod(ctx, lookback[len(lookback)-1].start, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[itemKey]map[int]bool)
+	posted := make(map[itemKey]bool)
+	amounts := make(map[itemKey]float64)
+	for _, tx := range txs {
+		k, ok := keyOf(tx)
+		if !ok {
+			continue
+		}
+		if !tx.Date.Before(start) {
+			posted[k] = true
+			continue
+		}
+		for i, p := range lookback {
+			if !tx.Date.Before(p.start) && !tx.Date.After(p.end) {
+				if seen[k] == nil {
+					seen[k] = make(map[int]bool)
+				}
+				seen[k][i] = true
+				amounts[k] = tx.Amount
+			}
+		}
+	}
+
+	pending := make(map[string]float64)
+	for k, periods := range seen {
+		if len(periods) == len(lookback) && !posted[k] {
+			pending[k.category] += amounts[k]
+		}
+	}
+
+	return pending, nil
+}
+
+// days counts calendar days from start to end, both included. The dates
+// are compared in UTC, where every day has 24 hours, so a DST change in
+// their zone does not lose one.
+func days(start, end time.Time) int {
+	return int(domain.DateOf(end).Sub(domain.DateOf(start)).Hours()/24) + 1
+}
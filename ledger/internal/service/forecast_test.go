@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+func TestDays_AcrossDST(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("no time zone data: %v", err)
+	}
+
+	// Clocks go forward on the last Sunday of March.
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, loc)
+	end := time.Date(2026, 3, 31, 0, 0, 0, 0, loc)
+	if got := days(start, end); got != 31 {
+		t.Fatalf("expected March to have 31 days, got %d", got)
+	}
+}
+
+func TestGetForecast_ParentBudgetCoversSubcategories(t *testing.T) {
+	ctx := context.Background()
+	budgets := &memBudgets{}
+	txs := &memTransactions{}
+	svc := newTestLedger(budgets, txs)
+
+	_, _ = svc.CreateCategory(ctx, domain.Category{Name: "food"})
+	_, _ = svc.CreateCategory(ctx, domain.Category{Name: "groceries", Parent: "food"})
+	_, _ = svc.CreateCategory(ctx, domain.Category{Name: "restaurants", Parent: "food"})
+	_ = budgets.Upsert(ctx, domain.Budget{Category: "food", Limit: 300})
+	_ = budgets.Upsert(ctx, domain.Budget{Category: "groceries", Limit: 500})
+
+	// Each subcategory is on pace for 200 over April, the two of them for
+	// 400.
+	mid := time.Date(2026, 4, 15, 0, 0, 0, 0, time.UTC)
+	_ = txs.Add(ctx, &domain.Transaction{Amount: 100, Category: "groceries", Date: mid})
+	_ = txs.Add(ctx, &domain.Transaction{Amount: 100, Category: "restaurants", Date: mid})
+
+	forecast, err := svc.GetForecast(ctx, domain.ForecastQuery{AsOf: mid, Period: domain.GranularityMonth})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := make(map[string]domain.ForecastLine)
+	for _, l := range forecast.Lines {
+		lines[l.Category] = l
+	}
+	if food, ok := lines["food"]; !ok || !food.HasBudget || !food.OverBudget || food.Spent != 0 {
+		t.Fatalf("expected the food budget to be exceeded through its subcategories, got %+v", food)
+	}
+	if groceries := lines["groceries"]; !groceries.HasBudget || groceries.OverBudget {
+		t.Fatalf("expected groceries to stay within its own budget, got %+v", groceries)
+	}
+	if restaurants := lines["restaurants"]; restaurants.HasBudget {
+		t.Fatalf("expected restaurants to have no budget of its own, got %+v", restaurants)
+	}
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+var ErrRecipientNotFound = errors.New("notification recipient not found")
+
+// SetNotificationRecipient creates r, or replaces the name and opt-ins of
+// the recipient with the same email.
+func (svc *ledger) SetNotificationRecipient(
+	ctx context.Context,
+	r domain.NotificationRecipient,
+) (domain.NotificationRecipient, error) {
+	r.Name = strings.TrimSpace(r.Name)
+	r.Email = strings.ToLower(strings.TrimSpace(r.Email))
+
+	if err := r.Validate(); err != nil {
+		return r, err
+	}
+
+	if err := svc.notifications.Save(ctx, &r); err != nil {
+		return r, err
+	}
+
+	svc.log.Info(
+		"notification recipient saved",
+		slog.Int("id", r.ID),
+		slog.Bool("budget_alerts", r.BudgetAlerts),
+		slog.Bool("weekly_digest", r.WeeklyDigest),
+	)
+
+	return r, nil
+}
+
+func (svc *ledger) ListNotificationRecipients(ctx context.Context) ([]domain.NotificationRecipient, error) {
+	return svc.notifications.List(ctx)
+}
+
+func (svc *ledger) DeleteNotificationRecipient(ctx context.Context, id int) error {
+	ok, err := svc.notifications.Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrRecipientNotFound
+	}
+
+	svc.log.Info("notification recipient deleted", slog.Int("id", id))
+	return nil
+}
+
+// GetDigest summarizes the spending between from and to. Budgets are
+// reported the way AddTransaction checks them, against all the spend
+// the budget policy counts.
+func (svc *ledger) GetDigest(ctx context.Context, from, to time.Time) (domain.Digest, error) {
+	totals, err := svc.GetReportSummary(ctx, from, to, nil)
+	if err != nil {
+		return domain.Digest{}, err
+	}
+
+	tree, err := svc.categoryTree(ctx)
+	if err != nil {
+		return domain.Digest{}, err
+	}
+
+	digest := domain.Digest{From: from, To: to}
+	for _, spent := range totals {
+		digest.Total += spent
+	}
+	for category, spent := range tree.RollUp(totals) {
+		if spent > 0 && len(tree.Ancestors(category)) == 0 {
+			digest.Categories = append(digest.Categories, domain.CategorySpend{
+				Category: category,
+				Spent:    spent,
+			})
+		}
+	}
+	sort.Slice(digest.Categories, func(i, j int) bool {
+		a, b := digest.Categories[i], digest.Categories[j]
+		if a.Spent != b.Spent {
+			return a.Spent > b.Spent
+		}
+		return a.Category < b.Category
+	})
+
+	budgets, err := svc.budgets.List(ctx)
+	if err != nil {
+		return domain.Digest{}, err
+	}
+	for _, b := range budgets {
+		spent, err := svc.transactions.SumByCategories(ctx, tree.Subtree(b.Category), svc.budgetPolicy.Statuses())
+		if err != nil {
+			return domain.Digest{}, err
+		}
+		digest.Budgets = append(digest.Budgets, domain.NewBudgetLine(b, spent))
+	}
+
+	return digest, nil
+}
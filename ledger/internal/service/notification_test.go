@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+func TestSetNotificationRecipient(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestLedger(&memBudgets{}, &memTransactions{})
+
+	r, err := svc.SetNotificationRecipient(ctx, domain.NotificationRecipient{
+		Name:         " Alex ",
+		Email:        " Alex@Example.com ",
+		BudgetAlerts: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.Name != "Alex" || r.Email != "alex@example.com" {
+		t.Fatalf("expected a trimmed name and lower-case email, got %+v", r)
+	}
+
+	// The same email updates the opt-ins instead of adding a recipient.
+	again, err := svc.SetNotificationRecipient(ctx, domain.NotificationRecipient{
+		Email:        "ALEX@example.com",
+		WeeklyDigest: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	all, _ := svc.ListNotificationRecipients(ctx)
+	if again.ID != r.ID || len(all) != 1 || all[0].BudgetAlerts || !all[0].WeeklyDigest {
+		t.Fatalf("expected the recipient to be updated, got %+v", all)
+	}
+
+	if _, err := svc.SetNotificationRecipient(ctx, domain.NotificationRecipient{Email: "nope"}); err == nil {
+		t.Fatal("expected a validation error")
+	}
+
+	if err := svc.DeleteNotificationRecipient(ctx, r.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.DeleteNotificationRecipient(ctx, r.ID); !errors.Is(err, ErrRecipientNotFound) {
+		t.Fatalf("expected ErrRecipientNotFound, got %v", err)
+	}
+}
+
+func TestGetDigest(t *testing.T) {
+	ctx := context.Background()
+	budgets := &memBudgets{}
+	txs := &memTransactions{}
+	svc := newTestLedger(budgets, txs)
+
+	week := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	_ = budgets.Upsert(ctx, domain.Budget{Category: "food", Limit: 100})
+	for _, tx := range []domain.Transaction{
+		{Amount: 30, Category: "food", Date: week.AddDate(0, 0, -3)},
+		{Amount: 40, Category: "food", Date: week.AddDate(0, 0, 1)},
+		{Amount: 50, Category: "fuel", Date: week.AddDate(0, 0, 2)},
+	} {
+		if _, err := svc.AddTransaction(ctx, tx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	d, err := svc.GetDigest(ctx, week, week.AddDate(0, 0, 6))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if d.Total != 90 {
+		t.Fatalf("expected the week's spend only, got %v", d.Total)
+	}
+	if len(d.Categories) != 2 || d.Categories[0].Category != "fuel" || d.Categories[1].Spent != 40 {
+		t.Fatalf("expected categories by spend, most first, got %+v", d.Categories)
+	}
+	// The budget counts everything, as AddTransaction does.
+	if len(d.Budgets) != 1 || d.Budgets[0].Spent != 70 {
+		t.Fatalf("expected the food budget at 70, got %+v", d.Budgets)
+	}
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+var (
+	ErrPayeeNotFound = errors.New("payee not found")
+	ErrPayeeExists   = errors.New("payee already exists")
+)
+
+func (svc *ledger) CreatePayee(ctx context.Context, p domain.Payee) (domain.Payee, error) {
+	p.Name = domain.NormalizePayee(p.Name)
+	if err := p.Validate(); err != nil {
+		return p, err
+	}
+
+	_, ok, err := svc.payees.GetByName(ctx, p.Name)
+	if err != nil {
+		return p, err
+	}
+	if ok {
+		return p, ErrPayeeExists
+	}
+
+	if err := svc.payees.Create(ctx, &p); err != nil {
+		return p, err
+	}
+
+	svc.log.Info("payee created", slog.Int("id", p.ID), slog.String("name", p.Name))
+
+	return p, nil
+}
+
+func (svc *ledger) ListPayees(ctx context.Context) ([]domain.Payee, error) {
+	return svc.payees.List(ctx)
+}
+
+// GetPayeeReport returns the top payees by spend between from and to.
+// top <= 0 returns every payee.
+func (svc *ledger) GetPayeeReport(
+	ctx context.Context,
+	from, to time.Time,
+	top int,
+) ([]domain.PayeeSpend, error) {
+	if top < 0 {
+		top = 0
+	}
+
+	svc.log.Info(
+		"payee report requested",
+		slog.String("from", from.Format("2006-01-02")),
+		slog.String("to", to.Format("2006-01-02")),
+		slog.Int("top", top),
+	)
+
+	cacheKey := "report:payees:" +
+		from.Format("2006-01-02") + ":" +
+		to.Format("2006-01-02") + ":" +
+		strconv.Itoa(top)
+
+	var lines []domain.PayeeSpend
+	hit, token := svc.cacheGet(ctx, cacheKey, &lines)
+	if hit {
+		return lines, nil
+	}
+
+	svc.log.Info("report cache miss", slog.String("key", cacheKey))
+
+	lines, err := svc.transactions.SumByPayeeInPeriod(ctx, from, to, top)
+	if err != nil {
+		svc.log.Error("payee report failed", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	svc.cacheSet(ctx, token, cacheKey, from, to, lines)
+
+	return lines, nil
+}
+
+// assignPayee fills in both the ID and the name of the payee of t. A
+// name without an ID is normalized and, if create is set, added as a new
+// payee when there is none by that name yet.
+func (svc *ledger) assignPayee(ctx context.Context, t *domain.Transaction, create bool) error {
+	if t.PayeeID != 0 {
+		p, ok, err := svc.payees.Get(ctx, t.PayeeID)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ErrPayeeNotFound
+		}
+		t.Payee = p.Name
+		return nil
+	}
+
+	name := domain.NormalizePayee(t.Payee)
+	if name == "" {
+		t.Payee = ""
+		return nil
+	}
+
+	if !create {
+		p, ok, err := svc.payees.GetByName(ctx, name)
+		if err != nil {
+			return err
+		}
+		t.PayeeID, t.Payee = p.ID, name
+		if ok {
+			t.Payee = p.Name
+		}
+		return nil
+	}
+
+	p, err := svc.payees.Ensure(ctx, name)
+	if err != nil {
+		return err
+	}
+	t.PayeeID, t.Payee = p.ID, p.Name
+
+	return nil
+}
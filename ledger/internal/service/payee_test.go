@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+func TestImportTransactions_CreatesPayeesFromDescriptions(t *testing.T) {
+	ctx := context.Background()
+	txs := &memTransactions{}
+	svc := newTestLedger(&memBudgets{}, txs)
+	date := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+
+	res, err := svc.ImportTransactions(ctx, []domain.Transaction{
+		{Amount: 40, Category: "food", Description: "LIDL #88 - weekly shop", Date: date},
+		{Amount: 25, Category: "food", Description: "lidl: snacks", Date: date},
+		{Amount: 30, Category: "taxi", Description: "SQ *UBER", Date: date},
+		{Amount: 5, Category: "food", Description: "Bakery", Date: date},
+		{Amount: 9, Category: "misc", Description: "", Date: date},
+	}, 2)
+	if err != nil || res.Accepted != 5 {
+		t.Fatalf("unexpected import result: %+v, %v", res, err)
+	}
+
+	payees, _ := svc.ListPayees(ctx)
+	if len(payees) != 3 {
+		t.Fatalf("expected Lidl, Uber and Bakery, got %+v", payees)
+	}
+
+	report, err := svc.GetPayeeReport(ctx, date, date, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(report) != 2 {
+		t.Fatalf("expected the top 2 payees, got %+v", report)
+	}
+	if report[0].Payee != "Lidl" || report[0].Spent != 65 || report[0].Count != 2 {
+		t.Fatalf("unexpected top payee: %+v", report[0])
+	}
+	if report[1].Payee != "Uber" {
+		t.Fatalf("unexpected second payee: %+v", report[1])
+	}
+}
+
+func TestAddTransaction_UnknownPayee(t *testing.T) {
+	svc := newTestLedger(&memBudgets{}, &memTransactions{})
+
+	_, err := svc.AddTransaction(context.Background(), domain.Transaction{
+		Amount:   10,
+		Category: "food",
+		PayeeID:  7,
+	})
+	if !errors.Is(err, ErrPayeeNotFound) {
+		t.Fatalf("expected ErrPayeeNotFound, got %v", err)
+	}
+}
+
+func TestCreatePayee_RejectsDuplicates(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestLedger(&memBudgets{}, &memTransactions{})
+
+	p, err := svc.CreatePayee(ctx, domain.Payee{Name: "  COFFEE   BAR "})
+	if err != nil || p.Name != "Coffee Bar" {
+		t.Fatalf("unexpected payee: %+v, %v", p, err)
+	}
+
+	if _, err := svc.CreatePayee(ctx, domain.Payee{Name: "coffee bar"}); !errors.Is(err, ErrPayeeExists) {
+		t.Fatalf("expected ErrPayeeExists, got %v", err)
+	}
+}
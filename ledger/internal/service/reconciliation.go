@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+var ErrReconciliationNotFound = errors.New("reconciliation not found")
+
+// StartReconciliation opens a reconciliation of r.Account against a
+// statement. After the first reconciliation of an account, the opening
+// balance is the statement balance of the previous one.
+func (svc *ledger) StartReconciliation(ctx context.Context, r domain.Reconciliation) (domain.Reconciliation, error) {
+	r.StatementDate = domain.DateOf(r.StatementDate)
+	if err := r.Validate(); err != nil {
+		return r, err
+	}
+
+	last, ok, err := svc.reconciliations.LastCompleted(ctx, r.Account)
+	if err != nil {
+		return r, err
+	}
+	if ok {
+		if !r.StatementDate.After(last.StatementDate) {
+			return r, fmt.Errorf(
+				"validation failed: statement date must be after %s, the last reconciled statement",
+				last.StatementDate.Format("2006-01-02"),
+			)
+		}
+		r.OpeningBalance = last.StatementBalance
+	}
+
+	if err := svc.reconciliations.Create(ctx, &r); err != nil {
+		return r, err
+	}
+
+	svc.log.Info(
+		"reconciliation started",
+		slog.Int("id", r.ID),
+		slog.String("account", r.Account),
+		slog.String("statement_date", r.StatementDate.Format("2006-01-02")),
+	)
+
+	return svc.withTransactions(ctx, r)
+}
+
+func (svc *ledger) ListReconciliations(ctx context.Context) ([]domain.Reconciliation, error) {
+	return svc.reconciliations.List(ctx)
+}
+
+// GetReconciliation returns the reconciliation with its difference to the
+// statement and, while it is open, the transactions left to clear.
+func (svc *ledger) GetReconciliation(ctx context.Context, id int) (domain.Reconciliation, error) {
+	r, ok, err := svc.reconciliations.Get(ctx, id)
+	if err != nil {
+		return r, err
+	}
+	if !ok {
+		return r, ErrReconciliationNotFound
+	}
+
+	return svc.withTransactions(ctx, r)
+}
+
+// SetCleared marks transactions as seen on a bank statement, or moves
+// them back to pending. Reconciled transactions cannot be changed.
+func (svc *ledger) SetCleared(ctx context.Context, ids []int, cleared bool) error {
+	status := domain.StatusPending
+	if cleared {
+		status = domain.StatusCleared
+	}
+
+	return svc.SetTransactionStatus(ctx, ids, status)
+}
+
+// CompleteReconciliation reconciles and locks the cleared transactions
+// once they add up to the statement balance.
+func (svc *ledger) CompleteReconciliation(ctx context.Context, id int) (domain.Reconciliation, error) {
+	r, ok, err := svc.reconciliations.Get(ctx, id)
+	if err != nil {
+		return r, err
+	}
+	if !ok {
+		return r, ErrReconciliationNotFound
+	}
+
+	locked, err := svc.transactions.Reconcile(ctx, &r)
+	if errors.Is(err, domain.ErrReconciliationUnbalanced) {
+		return r, fmt.Errorf("%w: difference is %.2f", err, r.Difference())
+	}
+	if err != nil {
+		return r, err
+	}
+
+	svc.log.Info(
+		"reconciliation completed",
+		slog.Int("id", r.ID),
+		slog.String("account", r.Account),
+		slog.Int("transactions", locked),
+	)
+
+	// Reports filtered by status saw the locked transactions as cleared.
+	if locked > 0 {
+		svc.invalidateAllReports(ctx)
+	}
+
+	return r, nil
+}
+
+// withTransactions fills in the cleared total of an open reconciliation
+// and the transactions it covers. A completed one balanced by definition.
+func (svc *ledger) withTransactions(ctx context.Context, r domain.Reconciliation) (domain.Reconciliation, error) {
+	if !r.CompletedAt.IsZero() {
+		r.ClearedTotal = r.OpeningBalance - r.StatementBalance
+		return r, nil
+	}
+
+	txs, err := svc.transactions.ListUnreconciled(ctx, r.Account, r.StatementDate)
+	if err != nil {
+		return r, err
+	}
+
+	r.ClearedTotal = 0
+	for _, tx := range txs {
+		if tx.Status == domain.StatusCleared {
+			r.ClearedTotal += tx.Amount
+		}
+	}
+	r.Transactions = txs
+
+	return r, nil
+}
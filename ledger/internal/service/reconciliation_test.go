@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+func TestReconciliation_Workflow(t *testing.T) {
+	ctx := context.Background()
+	txs := &memTransactions{}
+	svc := newTestLedger(&memBudgets{}, txs)
+	day := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tx := range []domain.Transaction{
+		{Amount: 10, Category: "food", Description: "Bakery", Date: day, Account: "checking"},
+		{Amount: 20, Category: "fuel", Description: "Shell", Date: day.AddDate(0, 0, 1), Account: "checking"},
+		{Amount: 5, Category: "food", Description: "Coffee", Date: day.AddDate(0, 0, 9), Account: "checking"},
+		{Amount: 7, Category: "food", Description: "Lunch", Date: day, Account: "savings"},
+	} {
+		if _, err := svc.AddTransaction(ctx, tx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	rec, err := svc.StartReconciliation(ctx, domain.Reconciliation{
+		Account:          "checking",
+		StatementDate:    day.AddDate(0, 0, 4),
+		StatementBalance: 70,
+		OpeningBalance:   100,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rec.Transactions) != 2 || rec.Difference() != -30 {
+		t.Fatalf("expected two transactions to clear and a difference of -30, got %+v", rec)
+	}
+
+	if _, err := svc.CompleteReconciliation(ctx, rec.ID); !errors.Is(err, domain.ErrReconciliationUnbalanced) {
+		t.Fatalf("expected ErrReconciliationUnbalanced, got %v", err)
+	}
+
+	if err := svc.SetCleared(ctx, []int{1, 2}, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rec, _ = svc.GetReconciliation(ctx, rec.ID); !rec.Balanced() {
+		t.Fatalf("expected the cleared transactions to balance, got %+v", rec)
+	}
+
+	rec, err = svc.CompleteReconciliation(ctx, rec.ID)
+	if err != nil || rec.CompletedAt.IsZero() {
+		t.Fatalf("unexpected result: %+v, %v", rec, err)
+	}
+	if _, err := svc.CompleteReconciliation(ctx, rec.ID); !errors.Is(err, domain.ErrReconciliationCompleted) {
+		t.Fatalf("expected ErrReconciliationCompleted, got %v", err)
+	}
+
+	all, _ := txs.List(ctx, domain.TransactionFilter{})
+	for _, tx := range all {
+		if locked := tx.ReconciliationID == rec.ID; locked != (tx.ID == 1 || tx.ID == 2) {
+			t.Fatalf("unexpected lock on %+v", tx)
+		}
+	}
+
+	if err := svc.SetCleared(ctx, []int{1}, false); !errors.Is(err, domain.ErrTransactionLocked) {
+		t.Fatalf("expected ErrTransactionLocked, got %v", err)
+	}
+	if err := svc.MergeDuplicates(ctx, 3, []int{2}); !errors.Is(err, domain.ErrTransactionLocked) {
+		t.Fatalf("expected ErrTransactionLocked, got %v", err)
+	}
+
+	if _, err := svc.StartReconciliation(ctx, domain.Reconciliation{
+		Account:       "checking",
+		StatementDate: day.AddDate(0, 0, 4),
+	}); err == nil {
+		t.Fatal("expected an error for a statement not after the last one")
+	}
+
+	next, err := svc.StartReconciliation(ctx, domain.Reconciliation{
+		Account:          "checking",
+		StatementDate:    day.AddDate(0, 0, 30),
+		StatementBalance: 65,
+		OpeningBalance:   1000,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if next.OpeningBalance != 70 || len(next.Transactions) != 1 {
+		t.Fatalf("expected to open at the last statement balance, got %+v", next)
+	}
+
+	if _, err := svc.StartReconciliation(ctx, domain.Reconciliation{
+		Account:       "checking",
+		StatementDate: day.AddDate(0, 0, 31),
+	}); !errors.Is(err, domain.ErrReconciliationOpen) {
+		t.Fatalf("expected ErrReconciliationOpen, got %v", err)
+	}
+}
+
+func TestApplyRules_SkipsReconciledTransactions(t *testing.T) {
+	ctx := context.Background()
+	txs := &memTransactions{}
+	svc := newTestLedger(&memBudgets{}, txs)
+	day := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	if _, err := svc.AddTransaction(ctx, domain.Transaction{Amount: 10, Category: "misc", Description: "Uber", Date: day}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.SetCleared(ctx, []int{1}, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rec, err := svc.StartReconciliation(ctx, domain.Reconciliation{StatementDate: day, StatementBalance: 90, OpeningBalance: 100})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.CompleteReconciliation(ctx, rec.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := svc.CreateRule(ctx, domain.Rule{Name: "taxi", DescriptionContains: "uber", SetCategory: "taxi"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	changes, err := svc.ApplyRules(ctx, false)
+	if err != nil || len(changes) != 0 {
+		t.Fatalf("expected the reconciled transaction to be left alone, got %+v, %v", changes, err)
+	}
+}
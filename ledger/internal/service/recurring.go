@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+var ErrRecurringNotFound = errors.New("recurring transaction not found")
+
+// CreateRecurring stores a recurring transaction. Occurrences between its
+// start date and today are posted by the next scheduler run.
+func (svc *ledger) CreateRecurring(ctx context.Context, r domain.RecurringTransaction) (domain.RecurringTransaction, error) {
+	if err := svc.prepareRecurring(ctx, &r); err != nil {
+		return r, err
+	}
+
+	r.Next = r.NextAfter(r.Start.AddDate(0, 0, -1))
+	if r.Next.IsZero() {
+		return r, errors.New("validation failed: schedule has no dates before the end date")
+	}
+
+	if err := svc.recurring.Create(ctx, &r); err != nil {
+		return r, err
+	}
+
+	svc.log.Info(
+		"recurring transaction created",
+		slog.Int("id", r.ID),
+		slog.String("schedule", r.Schedule.String()),
+		slog.String("next", r.Next.Format("2006-01-02")),
+	)
+
+	return r, nil
+}
+
+func (svc *ledger) ListRecurring(ctx context.Context) ([]domain.RecurringTransaction, error) {
+	return svc.recurring.List(ctx)
+}
+
+// UpdateRecurring replaces a recurring transaction. Dates before the
+// occurrence that was due next are never posted, so changing the start
+// date or schedule does not backfill.
+func (svc *ledger) UpdateRecurring(ctx context.Context, r domain.RecurringTransaction) (domain.RecurringTransaction, error) {
+	current, ok, err := svc.recurring.Get(ctx, r.ID)
+	if err != nil {
+		return r, err
+	}
+	if !ok {
+		return r, ErrRecurringNotFound
+	}
+
+	if err := svc.prepareRecurring(ctx, &r); err != nil {
+		return r, err
+	}
+
+	from := current.Next
+	if from.IsZero() {
+		from = domain.DateOf(time.Now())
+	}
+	if r.Start.After(from) {
+		from = r.Start
+	}
+	r.Next = r.NextAfter(from.AddDate(0, 0, -1))
+
+	ok, err = svc.recurring.Update(ctx, r)
+	if err != nil {
+		return r, err
+	}
+	if !ok {
+		return r, ErrRecurringNotFound
+	}
+
+	svc.log.Info(
+		"recurring transaction updated",
+		slog.Int("id", r.ID),
+		slog.String("schedule", r.Schedule.String()),
+	)
+
+	return r, nil
+}
+
+// DeleteRecurring stops a recurring transaction. Occurrences already
+// posted stay in the ledger.
+func (svc *ledger) DeleteRecurring(ctx context.Context, id int) error {
+	ok, err := svc.recurring.Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrRecurringNotFound
+	}
+
+	svc.log.Info("recurring transaction deleted", slog.Int("id", id))
+
+	return nil
+}
+
+func (svc *ledger) prepareRecurring(ctx context.Context, r *domain.RecurringTransaction) error {
+	category, err := svc.resolveCategory(ctx, r.Category)
+	if err != nil {
+		return err
+	}
+	r.Category = category
+
+	r.Start = domain.DateOf(r.Start)
+	if !r.End.IsZero() {
+		r.End = domain.DateOf(r.End)
+	}
+	r.LastError = ""
+
+	return r.Validate()
+}
+
+// PostDueRecurring posts every occurrence due on or before asOf through
+// AddTransaction and returns how many were posted. Occurrences missed
+// while the ledger was down are posted with their own dates. An
+// occurrence that is rejected, e.g. over budget, is skipped and the
+// reason kept in LastError.
+//
+// Posting cannot happen twice: the ledger rejects a second transaction
+// for the same occurrence, and next is only moved on if nobody else
+// moved it first.
+func (svc *ledger) PostDueRecurring(ctx context.Context, asOf time.Time) (int, error) {
+	asOf = domain.DateOf(asOf)
+
+	due, err := svc.recurring.ListDue(ctx, asOf)
+	if err != nil {
+		return 0, err
+	}
+
+	posted := 0
+	for _, r := range due {
+		for !r.Next.IsZero() && !r.Next.After(asOf) {
+			if err := ctx.Err(); err != nil {
+				return posted, err
+			}
+
+			lastError := ""
+			_, err := svc.AddTransaction(ctx, r.Transaction(r.Next))
+			switch {
+			case err == nil:
+				posted++
+			case errors.Is(err, domain.ErrAlreadyPosted):
+			case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+				return posted, err
+			case errors.Is(err, ErrBudgetExceeded), errors.Is(err, ErrDuplicate), strings.Contains(err.Error(), "validation failed"):
+				lastError = err.Error()
+				svc.log.Warn(
+					"recurring transaction skipped",
+					slog.Int("id", r.ID),
+					slog.String("date", r.Next.Format("2006-01-02")),
+					slog.String("error", lastError),
+				)
+			default:
+				// Leave next alone so the occurrence is retried.
+				return posted, err
+			}
+
+			next := r.NextAfter(r.Next)
+			ok, err := svc.recurring.Advance(ctx, r.ID, r.Next, next, lastError)
+			if err != nil {
+				return posted, err
+			}
+			if !ok {
+				break
+			}
+			r.Next = next
+		}
+	}
+
+	if posted > 0 {
+		svc.log.Info("recurring transactions posted", slog.Int("count", posted))
+	}
+
+	return posted, nil
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+func TestPostDueRecurring_CatchesUpOnce(t *testing.T) {
+	ctx := context.Background()
+	txs := &memTransactions{}
+	svc := newTestLedger(&memBudgets{}, txs)
+
+	schedule, _ := domain.ParseSchedule("FREQ=MONTHLY;BYMONTHDAY=1")
+	r, err := svc.CreateRecurring(ctx, domain.RecurringTransaction{
+		Amount:   900,
+		Category: "rent",
+		Schedule: schedule,
+		Start:    time.Date(2026, 7, 15, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	asOf := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	posted, err := svc.PostDueRecurring(ctx, asOf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if posted != 3 {
+		t.Fatalf("expected August to October to be posted, got %d", posted)
+	}
+
+	// A crash after posting but before moving next on must not post the
+	// same occurrence again.
+	stored, _, _ := svc.recurring.Get(ctx, r.ID)
+	_, _ = svc.recurring.Advance(ctx, r.ID, stored.Next, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), "")
+
+	posted, err = svc.PostDueRecurring(ctx, asOf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if posted != 0 {
+		t.Fatalf("expected nothing new to post, got %d", posted)
+	}
+
+	all, _ := txs.List(ctx, domain.TransactionFilter{})
+	if len(all) != 3 {
+		t.Fatalf("expected 3 transactions, got %d", len(all))
+	}
+
+	stored, _, _ = svc.recurring.Get(ctx, r.ID)
+	if want := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC); !stored.Next.Equal(want) {
+		t.Fatalf("expected next occurrence %s, got %s", want, stored.Next)
+	}
+}
+
+func TestPostDueRecurring_SkipsRejectedOccurrences(t *testing.T) {
+	ctx := context.Background()
+	budgets := &memBudgets{}
+	svc := newTestLedger(budgets, &memTransactions{})
+	_ = budgets.Upsert(ctx, domain.Budget{Category: "gym", Limit: 50})
+
+	schedule, _ := domain.ParseSchedule("FREQ=WEEKLY")
+	r, _ := svc.CreateRecurring(ctx, domain.RecurringTransaction{
+		Amount:   30,
+		Category: "gym",
+		Schedule: schedule,
+		Start:    time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		End:      time.Date(2026, 10, 10, 0, 0, 0, 0, time.UTC),
+	})
+
+	posted, err := svc.PostDueRecurring(ctx, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if posted != 1 {
+		t.Fatalf("expected 1 posted, got %d", posted)
+	}
+
+	stored, _, _ := svc.recurring.Get(ctx, r.ID)
+	if !stored.Next.IsZero() {
+		t.Fatalf("expected the schedule to be over, next is %s", stored.Next)
+	}
+	if stored.LastError == "" {
+		t.Fatal("expected the over-budget occurrence to be recorded")
+	}
+}
+
+func TestPostDueRecurring_IgnoresDuplicatePolicy(t *testing.T) {
+	ctx := context.Background()
+	txs := &memTransactions{}
+	svc := newTestLedger(&memBudgets{}, txs)
+	svc.dupes.Policy = domain.DuplicateReject
+
+	schedule, _ := domain.ParseSchedule("FREQ=DAILY")
+	r, _ := svc.CreateRecurring(ctx, domain.RecurringTransaction{
+		Amount:   4.5,
+		Category: "coffee",
+		Schedule: schedule,
+		Start:    time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	posted, err := svc.PostDueRecurring(ctx, time.Date(2026, 10, 5, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if posted != 5 {
+		t.Fatalf("expected 5 daily occurrences to be posted, got %d", posted)
+	}
+
+	stored, _, _ := svc.recurring.Get(ctx, r.ID)
+	if stored.LastError != "" {
+		t.Fatalf("expected no error, got %q", stored.LastError)
+	}
+	if want := time.Date(2026, 10, 6, 0, 0, 0, 0, time.UTC); !stored.Next.Equal(want) {
+		t.Fatalf("expected next occurrence %s, got %s", want, stored.Next)
+	}
+}
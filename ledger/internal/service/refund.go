@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+// CreateRefund gives back part or all of a transaction. The refund takes
+// spend off the original's category on the original's date, so budgets
+// and reports for that period go down, however late the money came back.
+func (svc *ledger) CreateRefund(ctx context.Context, r domain.Refund) (domain.Refund, error) {
+	if err := r.Validate(); err != nil {
+		return r, err
+	}
+
+	if r.Date.IsZero() {
+		r.Date = time.Now()
+	}
+	r.Date = domain.DateOf(r.Date)
+	r.Description = strings.TrimSpace(r.Description)
+
+	category, err := svc.resolveCategory(ctx, r.Category)
+	if err != nil {
+		return r, err
+	}
+	r.Category = category
+
+	if err := svc.refunds.Create(ctx, &r); err != nil {
+		return r, err
+	}
+
+	svc.log.Info(
+		"refund created",
+		slog.Int("id", r.ID),
+		slog.Int("transaction_id", r.TransactionID),
+		slog.String("category", r.Category),
+		slog.Float64("amount", r.Amount),
+	)
+
+	svc.invalidateReports(ctx, r.OriginalDate)
+
+	return r, nil
+}
+
+// ListRefunds returns the refunds of a transaction, oldest first.
+func (svc *ledger) ListRefunds(ctx context.Context, transactionID int) ([]domain.Refund, error) {
+	if transactionID <= 0 {
+		return nil, errors.New("validation failed: transaction id is required")
+	}
+
+	return svc.refunds.ListByTransaction(ctx, transactionID)
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+func TestCreateRefund(t *testing.T) {
+	ctx := context.Background()
+	budgets := &memBudgets{}
+	txs := &memTransactions{}
+	svc := newTestLedger(budgets, txs)
+	day := time.Now().AddDate(0, 0, -10)
+
+	_ = budgets.Upsert(ctx, domain.Budget{Category: "food", Limit: 100})
+	original, err := svc.AddTransaction(ctx, domain.Transaction{Amount: 80, Category: "food", Date: day})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.AddTransaction(ctx, domain.Transaction{Amount: 40, Category: "food"}); !errors.Is(err, ErrBudgetExceeded) {
+		t.Fatalf("expected ErrBudgetExceeded before the refund, got %v", err)
+	}
+
+	refund, err := svc.CreateRefund(ctx, domain.Refund{TransactionID: original.ID, Amount: 30, Description: " returned "})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if refund.Category != "food" || refund.Description != "returned" ||
+		!refund.OriginalDate.Equal(original.Date) || refund.Date.IsZero() {
+		t.Fatalf("unexpected refund: %+v", refund)
+	}
+
+	if _, err := svc.AddTransaction(ctx, domain.Transaction{Amount: 40, Category: "food"}); err != nil {
+		t.Fatalf("expected the refund to free up the budget, got %v", err)
+	}
+
+	totals, err := svc.GetReportSummary(ctx, day.AddDate(0, 0, -1), day.AddDate(0, 0, 1), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if totals["food"] != 50 {
+		t.Fatalf("expected the refund to come off the original's period, got %v", totals)
+	}
+
+	if _, err := svc.CreateRefund(ctx, domain.Refund{TransactionID: original.ID, Amount: 60}); !errors.Is(err, domain.ErrRefundExceedsOriginal) {
+		t.Fatalf("expected ErrRefundExceedsOriginal, got %v", err)
+	}
+	if _, err := svc.CreateRefund(ctx, domain.Refund{TransactionID: 99, Amount: 1}); !errors.Is(err, domain.ErrTransactionNotFound) {
+		t.Fatalf("expected ErrTransactionNotFound, got %v", err)
+	}
+
+	refunds, err := svc.ListRefunds(ctx, original.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(refunds) != 1 || refunds[0].ID != refund.ID {
+		t.Fatalf("unexpected refunds: %+v", refunds)
+	}
+}
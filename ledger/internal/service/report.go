@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/cache"
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+// cacheGet loads a cached report into v. On a miss the returned token
+// lets cacheSet store the freshly computed report.
+func (svc *ledger) cacheGet(ctx context.Context, key string, v any) (bool, cache.Token) {
+	if svc.reports == nil {
+		return false, ""
+	}
+
+	hit, token := svc.reports.Lookup(ctx, key, v)
+	if hit {
+		svc.log.Info("report cache hit", slog.String("key", key))
+	}
+	return hit, token
+}
+
+// cacheSet caches the report for the days from..to, unless a write
+// covering those days happened since the cacheGet that issued token.
+func (svc *ledger) cacheSet(ctx context.Context, token cache.Token, key string, from, to time.Time, v any) {
+	if svc.reports == nil {
+		return
+	}
+
+	stored, err := svc.reports.Store(ctx, token, key, from, to, v)
+	if err != nil {
+		svc.log.Warn("report cache store failed", slog.String("error", err.Error()))
+		return
+	}
+	if stored {
+		svc.log.Info("report cached", slog.String("key", key))
+	}
+}
+
+// invalidateReports evicts cached reports that cover any of dates. It
+// runs even if ctx was cancelled, since the writes already happened.
+func (svc *ledger) invalidateReports(ctx context.Context, dates ...time.Time) {
+	if svc.reports == nil || len(dates) == 0 {
+		return
+	}
+
+	if err := svc.reports.Invalidate(context.WithoutCancel(ctx), dates...); err != nil {
+		svc.log.Warn("report cache invalidation failed", slog.String("error", err.Error()))
+	}
+}
+
+// invalidateAllReports evicts every cached report, after writes that
+// rewrite history rather than touch particular days.
+func (svc *ledger) invalidateAllReports(ctx context.Context) {
+	if svc.reports == nil {
+		return
+	}
+
+	if err := svc.reports.InvalidateAll(context.WithoutCancel(ctx)); err != nil {
+		svc.log.Warn("report cache invalidation failed", slog.String("error", err.Error()))
+	}
+}
+
+func (svc *ledger) GetSpendingSeries(
+	ctx context.Context,
+	q domain.SeriesQuery,
+) (domain.SpendingSeries, error) {
+	if err := q.Validate(); err != nil {
+		return domain.SpendingSeries{}, err
+	}
+
+	categories := append([]string(nil), q.Categories...)
+	sort.Strings(categories)
+
+	svc.log.Info(
+		"series requested",
+		slog.String("from", q.From.Format("2006-01-02")),
+		slog.String("to", q.To.Format("2006-01-02")),
+		slog.String("granularity", string(q.Granularity)),
+		slog.Int("categories", len(categories)),
+	)
+
+	cacheKey := "report:series:" +
+		string(q.Granularity) + ":" +
+		q.From.Format("2006-01-02") + ":" +
+		q.To.Format("2006-01-02") + ":" +
+		strings.Join(categories, ",")
+
+	var series domain.SpendingSeries
+	hit, token := svc.cacheGet(ctx, cacheKey, &series)
+	if hit {
+		return series, nil
+	}
+
+	svc.log.Info("report cache miss", slog.String("key", cacheKey))
+
+	totals, err := svc.transactions.SumByCategoryAndBucket(ctx, q.Granularity, q.From, q.To, categories)
+	if err != nil {
+		svc.log.Error("series failed", slog.String("error", err.Error()))
+		return domain.SpendingSeries{}, err
+	}
+
+	series = buildSeries(q.Granularity, q.Granularity.Buckets(q.From, q.To), categories, totals)
+	svc.cacheSet(ctx, token, cacheKey, q.From, q.To, series)
+
+	return series, nil
+}
+
+// buildSeries spreads totals over buckets, filling the gaps with zeros.
+// Requested categories are always present, even without any spend.
+func buildSeries(
+	granularity domain.Granularity,
+	buckets []time.Time,
+	categories []string,
+	totals []domain.BucketTotal,
+) domain.SpendingSeries {
+	index := make(map[string]int, len(buckets))
+	for i, b := range buckets {
+		index[b.Format("2006-01-02")] = i
+	}
+
+	amounts := make(map[string][]float64)
+	for _, c := range categories {
+		amounts[c] = make([]float64, len(buckets))
+	}
+
+	for _, t := range totals {
+		i, ok := index[t.Start.Format("2006-01-02")]
+		if !ok {
+			continue
+		}
+		if _, ok := amounts[t.Category]; !ok {
+			amounts[t.Category] = make([]float64, len(buckets))
+		}
+		amounts[t.Category][i] += t.Amount
+	}
+
+	names := make([]string, 0, len(amounts))
+	for c := range amounts {
+		names = append(names, c)
+	}
+	sort.Strings(names)
+
+	series := domain.SpendingSeries{
+		Granularity: granularity,
+		Buckets:     buckets,
+		Categories:  make([]domain.CategorySeries, 0, len(names)),
+	}
+	for _, c := range names {
+		series.Categories = append(series.Categories, domain.CategorySeries{
+			Category: c,
+			Amounts:  amounts[c],
+		})
+	}
+
+	return series
+}
+
+func (svc *ledger) GetBudgetReport(
+	ctx context.Context,
+	from, to time.Time,
+) (domain.BudgetReport, error) {
+	svc.log.Info(
+		"budget report requested",
+		slog.String("from", from.Format("2006-01-02")),
+		slog.String("to", to.Format("2006-01-02")),
+	)
+
+	budgets, err := svc.budgets.List(ctx)
+	if err != nil {
+		svc.log.Error("failed to list budgets", slog.String("error", err.Error()))
+		return domain.BudgetReport{}, err
+	}
+
+	totals, err := svc.transactions.SumByCategoriesInPeriod(ctx, from, to)
+	if err != nil {
+		svc.log.Error("budget report failed", slog.String("error", err.Error()))
+		return domain.BudgetReport{}, err
+	}
+
+	tree, err := svc.categoryTree(ctx)
+	if err != nil {
+		return domain.BudgetReport{}, err
+	}
+	rollUp := tree.RollUp(totals)
+
+	report := domain.BudgetReport{
+		From:       from,
+		To:         to,
+		Budgets:    make([]domain.BudgetLine, 0, len(budgets)),
+		Unbudgeted: make([]domain.CategorySpend, 0),
+	}
+
+	// A budget on a parent category covers the spend of all of its
+	// subcategories.
+	budgeted := make(map[string]bool, len(budgets))
+	for _, b := range budgets {
+		budgeted[b.Category] = true
+		report.Budgets = append(report.Budgets, domain.NewBudgetLine(b, rollUp[b.Category]))
+	}
+
+	for category, spent := range totals {
+		if budgeted[category] || spent <= 0 {
+			continue
+		}
+		covered := false
+		for _, a := range tree.Ancestors(category) {
+			covered = covered || budgeted[a]
+		}
+		if covered {
+			continue
+		}
+		report.Unbudgeted = append(report.Unbudgeted, domain.CategorySpend{
+			Category: category,
+			Spent:    spent,
+		})
+	}
+	sort.Slice(report.Unbudgeted, func(i, j int) bool {
+		return report.Unbudgeted[i].Category < report.Unbudgeted[j].Category
+	})
+
+	return report, nil
+}
+
+// GetTagSummary totals spend per tag. A transaction with several tags
+// counts towards each of them; untagged ones are left out.
+func (svc *ledger) GetTagSummary(
+	ctx context.Context,
+	from, to time.Time,
+) (map[string]float64, error) {
+	svc.log.Info(
+		"tag report requested",
+		slog.String("from", from.Format("2006-01-02")),
+		slog.String("to", to.Format("2006-01-02")),
+	)
+
+	cacheKey := "report:tags:" +
+		from.Format("2006-01-02") + ":" +
+		to.Format("2006-01-02")
+
+	var totals map[string]float64
+	hit, token := svc.cacheGet(ctx, cacheKey, &totals)
+	if hit {
+		return totals, nil
+	}
+
+	svc.log.Info("report cache miss", slog.String("key", cacheKey))
+
+	totals, err := svc.transactions.SumByTagInPeriod(ctx, from, to)
+	if err != nil {
+		svc.log.Error("tag report failed", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	svc.cacheSet(ctx, token, cacheKey, from, to, totals)
+
+	return totals, nil
+}
@@ -0,0 +1,424 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/lyagu5h/finScope/ledger/internal/cache"
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+	"github.com/redis/go-redis/v9"
+)
+
+// pooledTransactions makes every query hold one of a fixed number of
+// connections for a fixed latency, like a database/sql pool does.
+type pooledTransactions struct {
+	*memTransactions
+	conns   chan struct{}
+	latency time.Duration
+}
+
+func newPooledTransactions(txs *memTransactions, maxConns int, latency time.Duration) *pooledTransactions {
+	return &pooledTransactions{
+		memTransactions: txs,
+		conns:           make(chan struct{}, maxConns),
+		latency:         latency,
+	}
+}
+
+func (r *pooledTransactions) query(ctx context.Context) error {
+	select {
+	case r.conns <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-r.conns }()
+
+	select {
+	case <-time.After(r.latency):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *pooledTransactions) ListCategories(ctx context.Context) ([]string, error) {
+	if err := r.query(ctx); err != nil {
+		return nil, err
+	}
+	return r.memTransactions.ListCategories(ctx)
+}
+
+func (r *pooledTransactions) SumByCategoryAndPeriod(
+	ctx context.Context,
+	category string,
+	from, to time.Time,
+) (float64, error) {
+	if err := r.query(ctx); err != nil {
+		return 0, err
+	}
+	return r.memTransactions.SumByCategoryAndPeriod(ctx, category, from, to)
+}
+
+func (r *pooledTransactions) SumByCategoriesInPeriod(
+	ctx context.Context,
+	from, to time.Time,
+) (map[string]float64, error) {
+	if err := r.query(ctx); err != nil {
+		return nil, err
+	}
+	return r.memTransactions.SumByCategoriesInPeriod(ctx, from, to)
+}
+
+// perCategorySummary is the report as it was computed before
+// SumByCategoriesInPeriod: one query per category, fanned out.
+func perCategorySummary(
+	ctx context.Context,
+	repo domain.TransactionRepository,
+	from, to time.Time,
+) (map[string]float64, error) {
+	categories, err := repo.ListCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+	)
+	result := make(map[string]float64)
+
+	for _, category := range categories {
+		wg.Add(1)
+		go func(cat string) {
+			defer wg.Done()
+
+			sum, err := repo.SumByCategoryAndPeriod(ctx, cat, from, to)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			if sum > 0 {
+				result[cat] = sum
+			}
+		}(category)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return result, nil
+}
+
+func seedTransactions(categories, perCategory int) *memTransactions {
+	txs := &memTransactions{}
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for c := 0; c < categories; c++ {
+		for i := 0; i < perCategory; i++ {
+			_ = txs.Add(context.Background(), &domain.Transaction{
+				Amount:   float64(i + 1),
+				Category: fmt.Sprintf("category-%03d", c),
+				Date:     start.AddDate(0, 0, (c+i)%60),
+			})
+		}
+	}
+	return txs
+}
+
+func TestGetReportSummary_MatchesPerCategoryQueries(t *testing.T) {
+	ctx := context.Background()
+	txs := seedTransactions(20, 10)
+	svc := newTestLedger(&memBudgets{}, txs)
+
+	from := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	got, err := svc.GetReportSummary(ctx, from, to, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want, err := perCategorySummary(ctx, txs, from, to)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("report mismatch:\ngot  %v\nwant %v", got, want)
+	}
+}
+
+func TestGetReportSummary_Cancelled(t *testing.T) {
+	txs := newPooledTransactions(seedTransactions(5, 5), 1, time.Second)
+	svc := newTestLedger(&memBudgets{}, txs.memTransactions)
+	svc.transactions = txs
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := svc.GetReportSummary(ctx, time.Time{}, time.Now(), nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func benchmarkSummary(
+	b *testing.B,
+	categories int,
+	report func(context.Context, domain.TransactionRepository, time.Time, time.Time) (map[string]float64, error),
+) {
+	// 10 connections matches db.InitDB's SetMaxOpenConns.
+	repo := newPooledTransactions(seedTransactions(categories, 5), 10, 200*time.Microsecond)
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := report(context.Background(), repo, from, to); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func groupedSummary(
+	ctx context.Context,
+	repo domain.TransactionRepository,
+	from, to time.Time,
+) (map[string]float64, error) {
+	svc := newTestLedger(&memBudgets{}, nil)
+	svc.transactions = repo
+	return svc.GetReportSummary(ctx, from, to, nil)
+}
+
+func BenchmarkReportSummary(b *testing.B) {
+	for _, categories := range []int{10, 100, 500} {
+		b.Run(fmt.Sprintf("per-category/%d", categories), func(b *testing.B) {
+			benchmarkSummary(b, categories, perCategorySummary)
+		})
+		b.Run(fmt.Sprintf("grouped/%d", categories), func(b *testing.B) {
+			benchmarkSummary(b, categories, groupedSummary)
+		})
+	}
+}
+
+func TestGetSpendingSeries_ZeroFilled(t *testing.T) {
+	ctx := context.Background()
+	txs := &memTransactions{}
+	svc := newTestLedger(&memBudgets{}, txs)
+
+	day := func(m time.Month, d int) time.Time {
+		return time.Date(2026, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	_ = txs.Add(ctx, &domain.Transaction{Amount: 10, Category: "food", Date: day(1, 5)})
+	_ = txs.Add(ctx, &domain.Transaction{Amount: 5, Category: "food", Date: day(1, 20)})
+	_ = txs.Add(ctx, &domain.Transaction{Amount: 7, Category: "food", Date: day(3, 2)})
+	_ = txs.Add(ctx, &domain.Transaction{Amount: 3, Category: "taxi", Date: day(2, 14)})
+
+	series, err := svc.GetSpendingSeries(ctx, domain.SeriesQuery{
+		From:        day(1, 1),
+		To:          day(4, 30),
+		Granularity: domain.GranularityMonth,
+		Categories:  []string{"food", "gifts"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(series.Buckets) != 4 {
+		t.Fatalf("expected 4 buckets, got %v", series.Buckets)
+	}
+
+	want := []domain.CategorySeries{
+		{Category: "food", Amounts: []float64{15, 0, 7, 0}},
+		{Category: "gifts", Amounts: []float64{0, 0, 0, 0}},
+	}
+	if !reflect.DeepEqual(series.Categories, want) {
+		t.Fatalf("expected %v, got %v", want, series.Categories)
+	}
+}
+
+func TestGetBudgetReport(t *testing.T) {
+	ctx := context.Background()
+	budgets := &memBudgets{}
+	txs := &memTransactions{}
+	svc := newTestLedger(budgets, txs)
+
+	day := func(d int) time.Time {
+		return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC)
+	}
+	_ = budgets.Upsert(ctx, domain.Budget{Category: "food", Limit: 100})
+	_ = budgets.Upsert(ctx, domain.Budget{Category: "gifts", Limit: 50})
+	_ = txs.Add(ctx, &domain.Transaction{Amount: 120, Category: "food", Date: day(3)})
+	_ = txs.Add(ctx, &domain.Transaction{Amount: 30, Category: "taxi", Date: day(4)})
+	_ = txs.Add(ctx, &domain.Transaction{Amount: 99, Category: "gifts", Date: day(28).AddDate(0, 1, 0)})
+
+	report, err := svc.GetBudgetReport(ctx, day(1), day(31))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := make(map[string]domain.BudgetLine)
+	for _, l := range report.Budgets {
+		lines[l.Category] = l
+	}
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 budget lines, got %+v", report.Budgets)
+	}
+	if l := lines["food"]; l.Spent != 120 || l.Status != domain.BudgetStatusOver {
+		t.Fatalf("unexpected food line: %+v", l)
+	}
+	if l := lines["gifts"]; l.Spent != 0 || l.Remaining != 50 || l.Status != domain.BudgetStatusUnder {
+		t.Fatalf("unexpected gifts line: %+v", l)
+	}
+
+	want := []domain.CategorySpend{{Category: "taxi", Spent: 30}}
+	if !reflect.DeepEqual(report.Unbudgeted, want) {
+		t.Fatalf("expected %v, got %v", want, report.Unbudgeted)
+	}
+}
+
+func TestGetForecast(t *testing.T) {
+	ctx := context.Background()
+	budgets := &memBudgets{}
+	txs := &memTransactions{}
+	svc := newTestLedger(budgets, txs)
+
+	day := func(m time.Month, d int) time.Time {
+		return time.Date(2026, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	add := func(amount float64, category, description string, date time.Time) {
+		_ = txs.Add(ctx, &domain.Transaction{
+			Amount: amount, Category: category, Description: description, Date: date,
+		})
+	}
+
+	_ = budgets.Upsert(ctx, domain.Budget{Category: "food", Limit: 150})
+	_ = budgets.Upsert(ctx, domain.Budget{Category: "rent", Limit: 1200})
+
+	add(100, "food", "market", day(1, 10))
+	add(80, "food", "market", day(1, 25))
+	add(120, "food", "market", day(2, 12))
+	add(80, "food", "market", day(2, 26))
+	add(100, "food", "market", day(3, 9))
+	add(1000, "rent", "Rent", day(1, 20))
+	add(1000, "rent", "Rent", day(2, 20))
+
+	forecast, err := svc.GetForecast(ctx, domain.ForecastQuery{
+		AsOf:   day(3, 15),
+		Period: domain.GranularityMonth,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !forecast.PeriodStart.Equal(day(3, 1)) || !forecast.PeriodEnd.Equal(day(3, 31)) {
+		t.Fatalf("unexpected period %v - %v", forecast.PeriodStart, forecast.PeriodEnd)
+	}
+
+	lines := make(map[string]domain.ForecastLine)
+	for _, l := range forecast.Lines {
+		lines[l.Category] = l
+	}
+
+	food := lines["food"]
+	if math.Abs(food.PaceProjection-100.0/15*31) > 1e-9 {
+		t.Fatalf("unexpected pace projection: %+v", food)
+	}
+	if !food.HasHistory || food.HistoryProjection != 180 {
+		t.Fatalf("unexpected history projection: %+v", food)
+	}
+	if math.Abs(food.Projected-(100+(100.0/15*31-100+80)/2)) > 1e-9 || !food.OverBudget {
+		t.Fatalf("unexpected food forecast: %+v", food)
+	}
+
+	rent := lines["rent"]
+	if rent.RecurringPending != 1000 || rent.Projected != 1000 || rent.OverBudget {
+		t.Fatalf("unexpected rent forecast: %+v", rent)
+	}
+}
+
+func TestGetReportSummary_SeesFreshInsert(t *testing.T) {
+	ctx := context.Background()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+
+	txs := &memTransactions{}
+	svc := newTestLedger(&memBudgets{}, txs)
+	svc.reports = cache.NewReportCache(rdb, time.Hour)
+
+	from := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 5, 31, 0, 0, 0, 0, time.UTC)
+
+	if _, err := svc.AddTransaction(ctx, domain.Transaction{Amount: 10, Category: "food", Date: from}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	first, err := svc.GetReportSummary(ctx, from, to, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first["food"] != 10 {
+		t.Fatalf("unexpected first report: %v", first)
+	}
+
+	if _, err := svc.AddTransaction(ctx, domain.Transaction{Amount: 5, Category: "food", Date: from.AddDate(0, 0, 9)}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	second, err := svc.GetReportSummary(ctx, from, to, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if second["food"] != 15 {
+		t.Fatalf("insert not visible in the next report: %v", second)
+	}
+}
+
+func TestGetTagSummary(t *testing.T) {
+	ctx := context.Background()
+	txs := &memTransactions{}
+	svc := newTestLedger(&memBudgets{}, txs)
+
+	date := time.Date(2026, 6, 10, 0, 0, 0, 0, time.UTC)
+	for _, tx := range []domain.Transaction{
+		{Amount: 300, Category: "travel", Date: date, Tags: []string{"Vacation 2026"}},
+		{Amount: 40, Category: "food", Date: date, Tags: []string{"vacation-2026", "Business"}},
+		{Amount: 12, Category: "food", Date: date},
+	} {
+		if _, err := svc.AddTransaction(ctx, tx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	totals, err := svc.GetTagSummary(ctx, date, date)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]float64{"vacation-2026": 340, "business": 40}
+	if !reflect.DeepEqual(totals, want) {
+		t.Fatalf("expected %v, got %v", want, totals)
+	}
+
+	listed, err := svc.ListTransactions(ctx, domain.TransactionFilter{Tags: []string{"VACATION 2026", "business"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(listed) != 1 || listed[0].Amount != 40 {
+		t.Fatalf("expected the business trip meal only, got %+v", listed)
+	}
+}
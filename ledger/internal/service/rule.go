@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+var ErrRuleNotFound = errors.New("rule not found")
+
+func (svc *ledger) CreateRule(ctx context.Context, r domain.Rule) (domain.Rule, error) {
+	if err := svc.prepareRule(ctx, &r); err != nil {
+		return r, err
+	}
+
+	if err := svc.rules.Create(ctx, &r); err != nil {
+		return r, err
+	}
+
+	svc.log.Info("rule created", slog.Int("id", r.ID), slog.String("name", r.Name))
+
+	return r, nil
+}
+
+func (svc *ledger) ListRules(ctx context.Context) ([]domain.Rule, error) {
+	return svc.rules.List(ctx)
+}
+
+// UpdateRule replaces a rule. Past transactions keep what the old
+// version set until the rules are re-applied.
+func (svc *ledger) UpdateRule(ctx context.Context, r domain.Rule) (domain.Rule, error) {
+	if err := svc.prepareRule(ctx, &r); err != nil {
+		return r, err
+	}
+
+	ok, err := svc.rules.Update(ctx, r)
+	if err != nil {
+		return r, err
+	}
+	if !ok {
+		return r, ErrRuleNotFound
+	}
+
+	svc.log.Info("rule updated", slog.Int("id", r.ID), slog.String("name", r.Name))
+
+	return r, nil
+}
+
+func (svc *ledger) DeleteRule(ctx context.Context, id int) error {
+	ok, err := svc.rules.Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrRuleNotFound
+	}
+
+	svc.log.Info("rule deleted", slog.Int("id", id))
+
+	return nil
+}
+
+// ApplyRules re-applies the rules to every stored transaction and
+// returns the changes. With dryRun nothing is saved. Split transactions
+// keep their category; the rest of a rule still applies to them.
+func (svc *ledger) ApplyRules(ctx context.Context, dryRun bool) ([]domain.RuleChange, error) {
+	set, err := svc.ruleSet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := svc.transactions.List(ctx, domain.TransactionFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]domain.RuleChange, 0)
+	for _, before := range txs {
+		// Reconciled transactions are locked.
+		if before.ReconciliationID != 0 {
+			continue
+		}
+
+		after := before
+		matched := set.Apply(&after)
+		if len(matched) == 0 {
+			continue
+		}
+
+		if after.Category, err = svc.resolveCategory(ctx, after.Category); err != nil {
+			return nil, err
+		}
+		after.Tags = domain.NormalizeTags(after.Tags)
+
+		// A split transaction is filed under its largest line, and rules
+		// do not touch the lines, so Apply left its category alone. The
+		// preview still says when a rule would have set another one.
+		categoryKept := false
+		if len(before.Splits) > 0 {
+			after.Category = before.Category
+			unsplit := before
+			unsplit.Splits = nil
+			set.Apply(&unsplit)
+			categoryKept = !strings.EqualFold(unsplit.Category, before.Category)
+		}
+
+		if after.Category == before.Category &&
+			after.Description == before.Description &&
+			slices.Equal(after.Tags, before.Tags) {
+			continue
+		}
+
+		changes = append(changes, domain.RuleChange{
+			TransactionID: before.ID,
+			RuleIDs:       matched,
+			Before:        before,
+			After:         after,
+			CategoryKept:  categoryKept,
+		})
+	}
+
+	svc.log.Info(
+		"rules re-applied",
+		slog.Int("transactions", len(txs)),
+		slog.Int("changed", len(changes)),
+		slog.Bool("dry_run", dryRun),
+	)
+
+	if dryRun {
+		return changes, nil
+	}
+
+	dates := make([]time.Time, 0, len(changes))
+	for _, c := range changes {
+		if err := svc.transactions.Reclassify(ctx, c.After); err != nil {
+			svc.invalidateReports(ctx, dates...)
+			svc.forgetModel()
+			return nil, err
+		}
+		dates = append(dates, c.After.Date)
+	}
+
+	svc.invalidateReports(ctx, dates...)
+	if len(changes) > 0 {
+		svc.forgetModel()
+	}
+
+	return changes, nil
+}
+
+func (svc *ledger) prepareRule(ctx context.Context, r *domain.Rule) error {
+	r.Name = strings.TrimSpace(r.Name)
+	r.Payee = domain.NormalizePayee(r.Payee)
+	r.SetTags = domain.NormalizeTags(r.SetTags)
+
+	var err error
+	if r.SetCategory, err = svc.resolveCategory(ctx, r.SetCategory); err != nil {
+		return err
+	}
+
+	return r.Validate()
+}
+
+func (svc *ledger) ruleSet(ctx context.Context) (domain.RuleSet, error) {
+	rules, err := svc.rules.List(ctx)
+	if err != nil {
+		return domain.RuleSet{}, err
+	}
+
+	return domain.NewRuleSet(rules)
+}
+
+// applyRules runs the stored rules against a new transaction. The payee
+// is looked up first so payee conditions see its stored name.
+func (svc *ledger) applyRules(ctx context.Context, t *domain.Transaction) error {
+	if err := svc.assignPayee(ctx, t, false); err != nil {
+		return err
+	}
+
+	set, err := svc.ruleSet(ctx)
+	if err != nil {
+		return err
+	}
+
+	set.Apply(t)
+
+	return nil
+}
+
+// prepareImport fills in what bank lines usually lack: the payee from
+// the start of the description, then whatever the rules set.
+func prepareImport(t *domain.Transaction, rules domain.RuleSet) {
+	if t.PayeeID == 0 && t.Payee == "" {
+		t.Payee = domain.PayeeFromDescription(t.Description)
+	}
+
+	rules.Apply(t)
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+func TestAddTransaction_AppliesRulesBeforeValidation(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestLedger(&memBudgets{}, &memTransactions{})
+
+	_, err := svc.CreateRule(ctx, domain.Rule{
+		Name:                "coffee",
+		DescriptionContains: "starbucks",
+		SetCategory:         "coffee",
+		SetTags:             []string{"Treats"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Without the rule there is no category and validation would fail.
+	tx, err := svc.AddTransaction(ctx, domain.Transaction{
+		Amount:      4.5,
+		Description: "STARBUCKS #1234",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tx.Category != "coffee" || !slices.Equal(tx.Tags, []string{"treats"}) {
+		t.Fatalf("rule not applied: %+v", tx)
+	}
+}
+
+func TestImportTransactions_MatchesRulesOnDetectedPayee(t *testing.T) {
+	ctx := context.Background()
+	txs := &memTransactions{}
+	svc := newTestLedger(&memBudgets{}, txs)
+
+	_, _ = svc.CreateRule(ctx, domain.Rule{Name: "lidl", Payee: "LIDL", SetCategory: "groceries"})
+
+	res, err := svc.ImportTransactions(ctx, []domain.Transaction{
+		{Amount: 30, Description: "LIDL #88 - weekly shop", Date: time.Now()},
+	}, 1)
+	if err != nil || res.Accepted != 1 {
+		t.Fatalf("unexpected import result: %+v, %v", res, err)
+	}
+
+	all, _ := txs.List(ctx, domain.TransactionFilter{})
+	if all[0].Category != "groceries" {
+		t.Fatalf("expected the rule to set the category, got %q", all[0].Category)
+	}
+}
+
+func TestApplyRules_PreviewThenApply(t *testing.T) {
+	ctx := context.Background()
+	txs := &memTransactions{}
+	svc := newTestLedger(&memBudgets{}, txs)
+
+	_ = txs.Add(ctx, &domain.Transaction{Amount: 12, Category: "misc", Description: "Netflix", Date: time.Now()})
+	_ = txs.Add(ctx, &domain.Transaction{Amount: 8, Category: "food", Description: "Bakery", Date: time.Now()})
+
+	_, _ = svc.CreateRule(ctx, domain.Rule{
+		Name:             "streaming",
+		DescriptionRegex: `(?i)netflix|spotify`,
+		SetCategory:      "subscriptions",
+	})
+
+	preview, err := svc.ApplyRules(ctx, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(preview) != 1 || preview[0].Before.Category != "misc" || preview[0].After.Category != "subscriptions" {
+		t.Fatalf("unexpected preview: %+v", preview)
+	}
+
+	all, _ := txs.List(ctx, domain.TransactionFilter{})
+	if all[0].Category != "misc" {
+		t.Fatal("a dry run must not change transactions")
+	}
+
+	if _, err := svc.ApplyRules(ctx, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	all, _ = txs.List(ctx, domain.TransactionFilter{})
+	if all[0].Category != "subscriptions" || all[1].Category != "food" {
+		t.Fatalf("unexpected categories after apply: %q, %q", all[0].Category, all[1].Category)
+	}
+
+	again, _ := svc.ApplyRules(ctx, true)
+	if len(again) != 0 {
+		t.Fatalf("expected nothing left to change, got %+v", again)
+	}
+}
+
+func TestApplyRules_KeepsCategoryOfSplitTransactions(t *testing.T) {
+	ctx := context.Background()
+	txs := &memTransactions{}
+	svc := newTestLedger(&memBudgets{}, txs)
+
+	_ = txs.Add(ctx, &domain.Transaction{
+		Amount:      50,
+		Category:    "groceries",
+		Description: "Costco",
+		Date:        time.Now(),
+		Splits: []domain.Split{
+			{Category: "groceries", Amount: 30},
+			{Category: "household", Amount: 20},
+		},
+	})
+
+	_, _ = svc.CreateRule(ctx, domain.Rule{
+		Name:                "costco",
+		DescriptionContains: "costco",
+		SetCategory:         "shopping",
+		SetTags:             []string{"bulk"},
+	})
+
+	changes, err := svc.ApplyRules(ctx, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 1 || !changes[0].CategoryKept || changes[0].After.Category != "groceries" {
+		t.Fatalf("expected the tags to change and the category to be kept, got %+v", changes)
+	}
+
+	all, _ := txs.List(ctx, domain.TransactionFilter{})
+	if all[0].Category != "groceries" || !slices.Equal(all[0].Tags, []string{"bulk"}) {
+		t.Fatalf("unexpected transaction after apply: %+v", all[0])
+	}
+
+	// Only the category would still differ, which is not a change.
+	if again, _ := svc.ApplyRules(ctx, true); len(again) != 0 {
+		t.Fatalf("expected nothing left to change, got %+v", again)
+	}
+}
@@ -2,15 +2,17 @@ package service
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"log/slog"
+	"slices"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/lyagu5h/finScope/ledger/internal/blob"
+	"github.com/lyagu5h/finScope/ledger/internal/cache"
 	"github.com/lyagu5h/finScope/ledger/internal/domain"
-	"github.com/redis/go-redis/v9"
 )
 
 var ErrBudgetExceeded = errors.New("budget exceeded")
@@ -18,6 +20,11 @@ type BulkImportResult struct {
 	Accepted int `json:"accepted"`
 	Rejected int `json:"rejected"`
 	Errors   []BulkImportError `json:"errors"`
+
+	// CategorySpend is only filled by DryRunImport: the spend the budget
+	// of every category in the batch is checked against, subcategories
+	// included, once the accepted rows are added.
+	CategorySpend map[string]float64 `json:"category_spend,omitempty"`
 }
 
 type BulkImportError struct {
@@ -26,21 +33,99 @@ type BulkImportError struct {
 }
 
 type LedgerService interface {
-	SetBudget(ctx context.Context, b domain.Budget) error
+	SetBudget(ctx context.Context, b domain.Budget) (domain.Budget, error)
 	ListBudgets(ctx context.Context) ([]domain.Budget, error)
 
 	AddTransaction(ctx context.Context, t domain.Transaction) (domain.Transaction, error) 
-	ListTransactions(ctx context.Context) ([]domain.Transaction, error)
-
-	GetReportSummary(ctx context.Context, from, to time.Time) (map[string]float64, error)
+	ListTransactions(ctx context.Context, filter domain.TransactionFilter) ([]domain.Transaction, error)
+	SetTransactionStatus(ctx context.Context, ids []int, status domain.TransactionStatus) error
+	ListStatusChanges(ctx context.Context, id int) ([]domain.StatusChange, error)
+	CreateRefund(ctx context.Context, r domain.Refund) (domain.Refund, error)
+	ListRefunds(ctx context.Context, transactionID int) ([]domain.Refund, error)
+
+	CreateWebhook(ctx context.Context, s domain.WebhookSubscription) (domain.WebhookSubscription, error)
+	ListWebhooks(ctx context.Context) ([]domain.WebhookSubscription, error)
+	DeleteWebhook(ctx context.Context, id int) error
+	ListWebhookDeliveries(ctx context.Context, id, limit int) ([]domain.WebhookDelivery, error)
+
+	SetNotificationRecipient(ctx context.Context, r domain.NotificationRecipient) (domain.NotificationRecipient, error)
+	ListNotificationRecipients(ctx context.Context) ([]domain.NotificationRecipient, error)
+	DeleteNotificationRecipient(ctx context.Context, id int) error
+	GetDigest(ctx context.Context, from, to time.Time) (domain.Digest, error)
+
+	CreateCategory(ctx context.Context, c domain.Category) (domain.Category, error)
+	ListCategories(ctx context.Context) ([]domain.Category, error)
+	UpdateCategory(ctx context.Context, c domain.Category) (domain.Category, error)
+	DeleteCategory(ctx context.Context, name string) error
+	AddCategoryAlias(ctx context.Context, alias, category string) (domain.Category, error)
+	RenameCategory(ctx context.Context, from, to string) (domain.Category, error)
+	MergeCategories(ctx context.Context, sources []string, target string) (domain.Category, error)
+
+	CreateRecurring(ctx context.Context, r domain.RecurringTransaction) (domain.RecurringTransaction, error)
+	ListRecurring(ctx context.Context) ([]domain.RecurringTransaction, error)
+	UpdateRecurring(ctx context.Context, r domain.RecurringTransaction) (domain.RecurringTransaction, error)
+	DeleteRecurring(ctx context.Context, id int) error
+	PostDueRecurring(ctx context.Context, asOf time.Time) (int, error)
+
+	CreatePayee(ctx context.Context, p domain.Payee) (domain.Payee, error)
+	ListPayees(ctx context.Context) ([]domain.Payee, error)
+
+	CreateRule(ctx context.Context, r domain.Rule) (domain.Rule, error)
+	ListRules(ctx context.Context) ([]domain.Rule, error)
+	UpdateRule(ctx context.Context, r domain.Rule) (domain.Rule, error)
+	DeleteRule(ctx context.Context, id int) error
+	ApplyRules(ctx context.Context, dryRun bool) ([]domain.RuleChange, error)
+	SuggestCategory(ctx context.Context, t domain.Transaction, n int) ([]domain.CategorySuggestion, error)
+
+	AddAttachment(ctx context.Context, a domain.Attachment, data []byte) (domain.Attachment, error)
+	ListAttachments(ctx context.Context, transactionID int) ([]domain.Attachment, error)
+	GetAttachment(ctx context.Context, id int) (domain.Attachment, []byte, error)
+
+	GetReportSummary(ctx context.Context, from, to time.Time, statuses []domain.TransactionStatus) (map[string]float64, error)
+	GetRollUpSummary(ctx context.Context, from, to time.Time, statuses []domain.TransactionStatus) (domain.ReportSummary, error)
+	GetTagSummary(ctx context.Context, from, to time.Time) (map[string]float64, error)
+	GetPayeeReport(ctx context.Context, from, to time.Time, top int) ([]domain.PayeeSpend, error)
+	GetSpendingSeries(ctx context.Context, q domain.SeriesQuery) (domain.SpendingSeries, error)
+	GetBudgetReport(ctx context.Context, from, to time.Time) (domain.BudgetReport, error)
+	GetForecast(ctx context.Context, q domain.ForecastQuery) (domain.Forecast, error)
+	GetAnomalies(ctx context.Context, from, to time.Time) ([]domain.Transaction, error)
+	StartReconciliation(ctx context.Context, r domain.Reconciliation) (domain.Reconciliation, error)
+	ListReconciliations(ctx context.Context) ([]domain.Reconciliation, error)
+	GetReconciliation(ctx context.Context, id int) (domain.Reconciliation, error)
+	SetCleared(ctx context.Context, ids []int, cleared bool) error
+	CompleteReconciliation(ctx context.Context, id int) (domain.Reconciliation, error)
+
+	GetDuplicateGroups(ctx context.Context) ([][]domain.Transaction, error)
+	MergeDuplicates(ctx context.Context, keep int, duplicates []int) error
+	DismissDuplicates(ctx context.Context, ids []int) error
 	ImportTransactions(ctx context.Context, txs []domain.Transaction, workers int,) (BulkImportResult, error)
+
+	DryRunTransaction(ctx context.Context, t domain.Transaction) (domain.Transaction, error)
+	DryRunImport(ctx context.Context, txs []domain.Transaction) (BulkImportResult, error)
 }
 
 type ledger struct {
 	budgets domain.BudgetRepository
 	transactions domain.TransactionRepository
+	categories domain.CategoryRepository
+	recurring domain.RecurringRepository
+	payees domain.PayeeRepository
+	rules domain.RuleRepository
+	attachments domain.AttachmentRepository
+	duplicates domain.DuplicateRepository
+	reconciliations domain.ReconciliationRepository
+	refunds domain.RefundRepository
+	webhooks domain.WebhookRepository
+	notifications domain.NotificationRepository
+	outbox domain.OutboxRepository
+	blobs blob.Store
 	log *slog.Logger
-	cache *redis.Client
+	reports *cache.ReportCache
+	detector domain.AnomalyDetector
+	suggest *suggester
+	dupes domain.DuplicateDetector
+	dupLocks duplicateLocks
+	budgetPolicy domain.BudgetPolicy
 }
 
 type importJob struct {
@@ -50,6 +135,7 @@ type importJob struct {
 
 type importResult struct {
 	Index int
+	Date  time.Time
 	Err   error
 }
 
@@ -57,83 +143,327 @@ type importResult struct {
 func New(
 	budgetsRepo domain.BudgetRepository,
 	transactionsRepo domain.TransactionRepository,
+	categoriesRepo domain.CategoryRepository,
+	recurringRepo domain.RecurringRepository,
+	payeesRepo domain.PayeeRepository,
+	rulesRepo domain.RuleRepository,
+	attachmentsRepo domain.AttachmentRepository,
+	duplicatesRepo domain.DuplicateRepository,
+	reconciliationsRepo domain.ReconciliationRepository,
+	refundsRepo domain.RefundRepository,
+	webhooksRepo domain.WebhookRepository,
+	notificationsRepo domain.NotificationRepository,
+	dupes domain.DuplicateDetector,
+	budgetPolicy domain.BudgetPolicy,
+	outbox domain.OutboxRepository,
+	blobs blob.Store,
 	logger *slog.Logger,
-	redisClient *redis.Client,
+	reports *cache.ReportCache,
 ) LedgerService {
 	return &ledger{
 		budgets:      budgetsRepo,
 		transactions: transactionsRepo,
+		categories: categoriesRepo,
+		recurring: recurringRepo,
+		payees: payeesRepo,
+		rules: rulesRepo,
+		attachments: attachmentsRepo,
+		duplicates: duplicatesRepo,
+		reconciliations: reconciliationsRepo,
+		refunds: refundsRepo,
+		webhooks: webhooksRepo,
+		notifications: notificationsRepo,
+		outbox: outbox,
+		blobs: blobs,
 		log: logger,
-		cache: redisClient,
+		reports: reports,
+		detector: domain.DefaultAnomalyDetector(),
+		suggest: &suggester{},
+		dupes: dupes,
+		budgetPolicy: budgetPolicy,
 	}
 }
 
 func (svc *ledger) AddTransaction(ctx context.Context, t domain.Transaction) (domain.Transaction, error) {
+	if err := svc.applyRules(ctx, &t); err != nil {
+		return t, err
+	}
+
+	t, err := svc.addTransaction(ctx, t)
+	if err != nil {
+		return t, err
+	}
+
+	svc.invalidateReports(ctx, t.Date)
+
+	return t, nil
+}
+
+// addTransaction is AddTransaction without the report cache invalidation,
+// which ImportTransactions does once for the whole batch.
+func (svc *ledger) addTransaction(ctx context.Context, t domain.Transaction) (domain.Transaction, error) {
 	svc.log.Info(
 		"transaction add requested",
 		slog.String("category", t.Category),
 		slog.Float64("amount", t.Amount),
 	)
 
+	svc.suggestMissingCategory(ctx, &t)
+
+	budgets, err := svc.checkTransaction(ctx, &t, nil)
+	var exceeded *budgetExceededError
+	if errors.As(err, &exceeded) {
+		svc.publish(ctx, domain.EventBudgetExceeded, exceeded.budget)
+	}
+	if err != nil {
+		return t, err
+	}
+
+	if svc.duplicatePolicy(t) != domain.DuplicateAllow {
+		defer svc.dupLocks.lock(t.Amount)()
+		if err := svc.checkDuplicate(ctx, &t, nil); err != nil {
+			return t, err
+		}
+	}
+
+	// Payees are only created once the transaction passed its checks.
+	if err := svc.assignPayee(ctx, &t, true); err != nil {
+		return t, err
+	}
+
+	anomaly, err := svc.detectAnomaly(ctx, t)
+	if err != nil {
+		svc.log.Warn("anomaly detection failed", slog.String("error", err.Error()))
+	}
+
+	// The budget events go to the outbox along with t.
+	events := make([]domain.Event, 0, len(budgets))
+	for _, b := range budgets {
+		events = append(events, domain.NewEvent(domain.EventBudgetThresholdCrossed, b))
+	}
+	if err := svc.transactions.Add(ctx, &t, events...); err != nil {
+		return t, err
+	}
+	svc.learn(t)
+
+	if anomaly != nil {
+		t.Anomaly = anomaly
+		svc.log.Info(
+			"anomalous transaction",
+			slog.Int("id", t.ID),
+			slog.String("category", t.Category),
+			slog.Float64("amount", t.Amount),
+			slog.Float64("score", anomaly.Score),
+		)
+	}
+
+	return t, nil
+}
+
+// checkTransaction runs the validation and budget checks AddTransaction
+// applies before writing. Every line of a split transaction counts
+// against the budget of its own category, and a budget covers its
+// category and every subcategory below it. Only transactions in a status
+// the budget policy counts are checked, against the spend in those
+// statuses. batch is counted spend per category that is not in the
+// repository yet, e.g. earlier rows of a dry-run batch.
+//
+// It returns the spend of every budget t counts towards, before and with
+// t. When t would exceed a budget, the error is a budgetExceededError.
+func (svc *ledger) checkTransaction(
+	ctx context.Context,
+	t *domain.Transaction,
+	batch map[string]float64,
+) ([]domain.BudgetEvent, error) {
 	if t.Date.IsZero() {
 		t.Date = time.Now()
 	}
+	t.Tags = domain.NormalizeTags(t.Tags)
+	t.Account = strings.TrimSpace(t.Account)
+	if t.Status == "" {
+		t.Status = domain.StatusPending
+	}
+
+	if err := svc.resolveCategories(ctx, t); err != nil {
+		return nil, err
+	}
+
 	if err := t.Validate(); err != nil {
-		return t, err
+		return nil, err
+	}
+	if t.Status != domain.StatusPending && t.Status != domain.StatusCleared {
+		return nil, errors.New("validation failed: a new transaction must be pending or cleared")
 	}
 
-	budget, ok, err := svc.budgets.GetByCategory(ctx, t.Category)
+	if !svc.budgetPolicy.Counts(t.Status) {
+		return nil, nil
+	}
+
+	tree, err := svc.categoryTree(ctx)
 	if err != nil {
-		return t, err
+		return nil, err
 	}
 
-	if ok {
-		current, err := svc.transactions.SumByCategory(ctx, t.Category)
+	// spend is what t adds to each category, parents included.
+	spend := make(map[string]float64)
+	var categories []string
+	for _, line := range t.Lines() {
+		for _, category := range append([]string{line.Category}, tree.Ancestors(line.Category)...) {
+			if _, ok := spend[category]; !ok {
+				categories = append(categories, category)
+			}
+			spend[category] += line.Amount
+		}
+	}
+
+	var budgets []domain.BudgetEvent
+	for _, category := range categories {
+		budget, ok, err := svc.budgets.GetByCategory(ctx, category)
 		if err != nil {
-			return t, err
+			return nil, err
+		}
+		if !ok {
+			continue
 		}
 
-		if current+t.Amount > budget.Limit {
+		subtree := tree.Subtree(category)
+		current, err := svc.transactions.SumByCategories(ctx, subtree, svc.budgetPolicy.Statuses())
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range subtree {
+			current += batch[c]
+		}
+
+		use := domain.BudgetEvent{
+			Category: category,
+			Limit:    budget.Limit,
+			Before:   current,
+			After:    current + spend[category],
+			Amount:   spend[category],
+		}
+
+		if use.After > budget.Limit {
 			svc.log.Info(
 				"budget exceeded",
+				slog.String("category", category),
 				slog.String("error", ErrBudgetExceeded.Error()),
 			)
 
-			return t, ErrBudgetExceeded
-		}	
+			return nil, &budgetExceededError{budget: use}
+		}
+		budgets = append(budgets, use)
+	}
+
+	return budgets, nil
+}
+
+// budgetExceededError is ErrBudgetExceeded with the budget that would
+// have been exceeded.
+type budgetExceededError struct {
+	budget domain.BudgetEvent
+}
+
+func (e *budgetExceededError) Error() string { return ErrBudgetExceeded.Error() }
+
+func (e *budgetExceededError) Unwrap() error { return ErrBudgetExceeded }
+
+// resolveCategories resolves the category of t and of its split lines.
+// A split transaction is filed under its largest line.
+func (svc *ledger) resolveCategories(ctx context.Context, t *domain.Transaction) error {
+	t.Splits = slices.Clone(t.Splits)
+	for i := range t.Splits {
+		category, err := svc.resolveCategory(ctx, t.Splits[i].Category)
+		if err != nil {
+			return err
+		}
+		t.Splits[i].Category = category
+	}
+	if len(t.Splits) > 0 {
+		t.Category = domain.MainCategory(t.Splits)
+		return nil
+	}
+
+	category, err := svc.resolveCategory(ctx, t.Category)
+	if err != nil {
+		return err
+	}
+	t.Category = category
+
+	return nil
+}
+
+func (svc *ledger) DryRunTransaction(ctx context.Context, t domain.Transaction) (domain.Transaction, error) {
+	svc.log.Info(
+		"transaction dry run requested",
+		slog.String("category", t.Category),
+		slog.Float64("amount", t.Amount),
+	)
+
+	if err := svc.applyRules(ctx, &t); err != nil {
+		return t, err
+	}
+
+	svc.suggestMissingCategory(ctx, &t)
+
+	if _, err := svc.checkTransaction(ctx, &t, nil); err != nil {
+		return t, err
 	}
 
-	if err := svc.transactions.Add(ctx, &t); err != nil {
+	if err := svc.checkDuplicate(ctx, &t, nil); err != nil {
+		return t, err
+	}
+
+	if err := svc.assignPayee(ctx, &t, false); err != nil {
 		return t, err
 	}
 
 	return t, nil
 }
 
-func (svc *ledger) ListTransactions(ctx context.Context) ([]domain.Transaction, error) {
-	return svc.transactions.List(ctx)
+func (svc *ledger) ListTransactions(ctx context.Context, filter domain.TransactionFilter) ([]domain.Transaction, error) {
+	filter.Tags = domain.NormalizeTags(filter.Tags)
+	filter.Statuses = normalizeStatuses(filter.Statuses)
+	return svc.transactions.List(ctx, filter)
 }
 
-func (svc *ledger) SetBudget(ctx context.Context, b domain.Budget) error {
+// SetBudget stores b under the canonical name of its category and
+// returns it as stored.
+func (svc *ledger) SetBudget(ctx context.Context, b domain.Budget) (domain.Budget, error) {
+	category, err := svc.resolveCategory(ctx, b.Category)
+	if err != nil {
+		return b, err
+	}
+	b.Category = category
+
 	if err := b.Validate(); err != nil {
-		return err
+		return b, err
 	}
 	svc.log.Info(
 		"budget set",
 		slog.String("category", b.Category),
 		slog.Float64("limit", b.Limit),
 	)
-	return svc.budgets.Upsert(ctx, b)
+	return b, svc.budgets.Upsert(ctx, b)
 }
 
 func (svc *ledger) ListBudgets(ctx context.Context) ([]domain.Budget, error) {
 	return svc.budgets.List(ctx)
 }
 
+// GetReportSummary returns the spend of every category between from and
+// to. With statuses only the transactions in them count; voided ones
+// never do.
 func (svc *ledger) GetReportSummary(
 	ctx context.Context,
 	from, to time.Time,
+	statuses []domain.TransactionStatus,
 ) (map[string]float64, error) {
+	statuses = normalizeStatuses(statuses)
+	if slices.Contains(statuses, domain.StatusVoided) {
+		return nil, errors.New("validation failed: voided transactions are not reported")
+	}
+
 	svc.log.Info(
 		"report requested",
 		slog.String("from", from.Format("2006-01-02")),
@@ -143,102 +473,50 @@ func (svc *ledger) GetReportSummary(
 	cacheKey := "report:summary:" +
 		from.Format("2006-01-02") + ":" +
 		to.Format("2006-01-02")
-
-	if svc.cache != nil {
-		if data, err := svc.cache.Get(ctx, cacheKey).Bytes(); err == nil {
-			var cached map[string]float64
-			if err := json.Unmarshal(data, &cached); err == nil {
-				svc.log.Info("report cache hit", slog.String("key", cacheKey))
-				return cached, nil
-			}
-		}
+	for _, st := range statuses {
+		cacheKey += ":" + string(st)
 	}
 
-	svc.log.Info("report cache miss", slog.String("key", cacheKey))
-
-	categories, err := svc.transactions.ListCategories(ctx)
-	if err != nil {
-		svc.log.Error("failed to list categories", slog.String("error", err.Error()))
-		return nil, err
+	var cached map[string]float64
+	hit, token := svc.cacheGet(ctx, cacheKey, &cached)
+	if hit {
+		return cached, nil
 	}
 
-	result := make(map[string]float64)
+	svc.log.Info("report cache miss", slog.String("key", cacheKey))
 
 	type item struct {
-		category string
-		sum      float64
+		totals map[string]float64
+		err    error
 	}
 
-	resultsCh := make(chan item, len(categories))
-	errCh := make(chan error, 1)
-
-	var wg sync.WaitGroup
-	var once sync.Once
+	resultCh := make(chan item, 1)
 
 	ticker := time.NewTicker(400 * time.Millisecond)
-	done := make(chan struct{})
+	defer ticker.Stop()
 
 	go func() {
-		defer ticker.Stop()
-		for {
-			select {
-			case <-ticker.C:
-				svc.log.Debug(
-					"report in progress",
-					slog.String("from", from.Format("2006-01-02")),
-					slog.String("to", to.Format("2006-01-02")),
-				)
-			case <-done:
-				return
-			case <-ctx.Done():
-				return
-			}
+		var (
+			totals map[string]float64
+			err    error
+		)
+		if len(statuses) > 0 {
+			totals, err = svc.transactions.SumByCategoriesInPeriodByStatus(ctx, from, to, statuses)
+		} else {
+			totals, err = svc.transactions.SumByCategoriesInPeriod(ctx, from, to)
 		}
-	}()
-
-	for _, category := range categories {
-		wg.Add(1)
-
-		go func(cat string) {
-			defer wg.Done()
-
-			select {
-			case <-ctx.Done():
-				return
-			default:
-			}
-
-			sum, err := svc.transactions.SumByCategoryAndPeriod(
-				ctx,
-				cat,
-				from,
-				to,
-			)
-			if err != nil {
-				once.Do(func() {
-					errCh <- err
-				})
-				return
-			}
-
-			if sum > 0 {
-				select {
-				case resultsCh <- item{category: cat, sum: sum}:
-				case <-ctx.Done():
-					return
-				}
-			}
-		}(category)
-	}
-
-	go func() {
-		wg.Wait()
-		close(resultsCh)
-		close(done)
+		resultCh <- item{totals: totals, err: err}
 	}()
 
 	for {
 		select {
+		case <-ticker.C:
+			svc.log.Debug(
+				"report in progress",
+				slog.String("from", from.Format("2006-01-02")),
+				slog.String("to", to.Format("2006-01-02")),
+			)
+
 		case <-ctx.Done():
 			svc.log.Warn(
 				"report cancelled",
@@ -246,35 +524,40 @@ func (svc *ledger) GetReportSummary(
 			)
 			return nil, ctx.Err()
 
-		case err := <-errCh:
-			if err != nil {
+		case it := <-resultCh:
+			if it.err != nil {
+				if ctx.Err() != nil {
+					svc.log.Warn(
+						"report cancelled",
+						slog.String("reason", ctx.Err().Error()),
+					)
+					return nil, ctx.Err()
+				}
+
 				svc.log.Error(
 					"report failed",
-					slog.String("error", err.Error()),
+					slog.String("error", it.err.Error()),
 				)
-				return nil, err
+				return nil, it.err
 			}
 
-		case it, ok := <-resultsCh:
-			if !ok {
-				if svc.cache != nil {
-					if data, err := json.Marshal(result); err == nil {
-						_ = svc.cache.Set(ctx, cacheKey, data, 30*time.Second).Err()
-						svc.log.Info("report cached", slog.String("key", cacheKey))
-					}
+			result := make(map[string]float64, len(it.totals))
+			for category, sum := range it.totals {
+				if sum > 0 {
+					result[category] = sum
 				}
+			}
 
-				svc.log.Info(
-					"report completed",
-					slog.Int("categories", len(result)),
-					slog.String("from", from.Format("2006-01-02")),
-					slog.String("to", to.Format("2006-01-02")),
-				)
+			svc.cacheSet(ctx, token, cacheKey, from, to, result)
 
-				return result, nil
-			}
+			svc.log.Info(
+				"report completed",
+				slog.Int("categories", len(result)),
+				slog.String("from", from.Format("2006-01-02")),
+				slog.String("to", to.Format("2006-01-02")),
+			)
 
-			result[it.category] = it.sum
+			return result, nil
 		}
 	}
 }
@@ -286,6 +569,11 @@ func (svc *ledger) ImportTransactions(
 	workers int,
 ) (BulkImportResult, error) {
 
+	rules, err := svc.ruleSet(ctx)
+	if err != nil {
+		return BulkImportResult{}, err
+	}
+
 	jobs := make(chan importJob)
 	results := make(chan importResult)
 
@@ -305,9 +593,10 @@ func (svc *ledger) ImportTransactions(
 						return
 					}
 
-					_, err := svc.AddTransaction(ctx, job.Tx)
+					tx, err := svc.addTransaction(ctx, job.Tx)
 					results <- importResult{
 						Index: job.Index,
+						Date:  tx.Date,
 						Err:   err,
 					}
 				}
@@ -317,6 +606,8 @@ func (svc *ledger) ImportTransactions(
 
 	go func() {
 		for i, tx := range txs {
+			prepareImport(&tx, rules)
+
 			select {
 			case <-ctx.Done():
 				return
@@ -338,9 +629,12 @@ func (svc *ledger) ImportTransactions(
 		Errors: make([]BulkImportError, 0),
 	}
 
+	var dates []time.Time
+
 	for res := range results {
 		if res.Err == nil {
 			atomic.AddInt64(&accepted, 1)
+			dates = append(dates, res.Date)
 			continue
 		}
 
@@ -354,9 +648,121 @@ func (svc *ledger) ImportTransactions(
 	summary.Accepted = int(accepted)
 	summary.Rejected = int(rejected)
 
+	svc.invalidateReports(ctx, dates...)
+
 	if ctx.Err() != nil {
 		return summary, ctx.Err()
 	}
 
+	svc.publish(ctx, domain.EventImportCompleted, domain.ImportEvent{
+		Accepted: summary.Accepted,
+		Rejected: summary.Rejected,
+	})
+
+	return summary, nil
+}
+
+// DryRunImport reports what ImportTransactions would do with txs without
+// writing anything. Rows are checked one by one in input order, so every
+// accepted row counts against the budget of the rows after it.
+func (svc *ledger) DryRunImport(
+	ctx context.Context,
+	txs []domain.Transaction,
+) (BulkImportResult, error) {
+	svc.log.Info("import dry run requested", slog.Int("transactions", len(txs)))
+
+	summary := BulkImportResult{
+		Errors:        make([]BulkImportError, 0),
+		CategorySpend: make(map[string]float64),
+	}
+
+	rules, err := svc.ruleSet(ctx)
+	if err != nil {
+		return summary, err
+	}
+
+	tree, err := svc.categoryTree(ctx)
+	if err != nil {
+		return summary, err
+	}
+
+	batch := make(map[string]float64)
+	var accepted []domain.Transaction
+
+	for i, tx := range txs {
+		if err := ctx.Err(); err != nil {
+			return summary, err
+		}
+
+		prepareImport(&tx, rules)
+		svc.suggestMissingCategory(ctx, &tx)
+
+		_, err := svc.checkTransaction(ctx, &tx, batch)
+		var exceeded *budgetExceededError
+		if err == nil || errors.As(err, &exceeded) {
+			// The categories are resolved by now.
+			if seedErr := svc.seedCategorySpend(ctx, summary.CategorySpend, tree, tx, batch); seedErr != nil {
+				return summary, seedErr
+			}
+		}
+		if err == nil {
+			err = svc.checkDuplicate(ctx, &tx, accepted)
+		}
+		if err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				return summary, err
+			}
+
+			summary.Rejected++
+			summary.Errors = append(summary.Errors, BulkImportError{
+				Index: i,
+				Error: err.Error(),
+			})
+			continue
+		}
+
+		if svc.budgetPolicy.Counts(tx.Status) {
+			for _, line := range tx.Lines() {
+				batch[line.Category] += line.Amount
+				for _, c := range append([]string{line.Category}, tree.Ancestors(line.Category)...) {
+					if _, ok := summary.CategorySpend[c]; ok {
+						summary.CategorySpend[c] += line.Amount
+					}
+				}
+			}
+		}
+		accepted = append(accepted, tx)
+		summary.Accepted++
+	}
+
 	return summary, nil
-}
\ No newline at end of file
+}
+
+// seedCategorySpend adds the categories of t missing from spend, with the
+// spend their budgets are checked against: that of their subtree in the
+// statuses the budget policy counts, earlier rows of batch included.
+func (svc *ledger) seedCategorySpend(
+	ctx context.Context,
+	spend map[string]float64,
+	tree domain.CategoryTree,
+	t domain.Transaction,
+	batch map[string]float64,
+) error {
+	for _, line := range t.Lines() {
+		if _, ok := spend[line.Category]; ok || line.Category == "" {
+			continue
+		}
+
+		subtree := tree.Subtree(line.Category)
+		current, err := svc.transactions.SumByCategories(ctx, subtree, svc.budgetPolicy.Statuses())
+		if err != nil {
+			return err
+		}
+		for _, c := range subtree {
+			current += batch[c]
+		}
+		spend[line.Category] = current
+	}
+
+	return nil
+}
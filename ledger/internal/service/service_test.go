@@ -0,0 +1,1325 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+type memBudgets struct {
+	mu      sync.Mutex
+	budgets map[string]domain.Budget
+}
+
+func (r *memBudgets) Upsert(_ context.Context, b domain.Budget) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.budgets == nil {
+		r.budgets = make(map[string]domain.Budget)
+	}
+	r.budgets[b.Category] = b
+	return nil
+}
+
+func (r *memBudgets) GetByCategory(_ context.Context, category string) (domain.Budget, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	b, ok := r.budgets[category]
+	return b, ok, nil
+}
+
+func (r *memBudgets) List(_ context.Context) ([]domain.Budget, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	res := make([]domain.Budget, 0, len(r.budgets))
+	for _, b := range r.budgets {
+		res = append(res, b)
+	}
+	return res, nil
+}
+
+type memTransactions struct {
+	mu     sync.Mutex
+	txs    []domain.Transaction
+	lastID int
+	// reconciliations is completed by Reconcile, like the reconciliations
+	// table.
+	reconciliations *memReconciliations
+	changes         []domain.StatusChange
+	// refunds are stored by memRefunds and are negative lines, like in
+	// the expense_lines view.
+	refunds []domain.Refund
+	outbox  *memOutbox
+}
+
+func (r *memTransactions) Add(ctx context.Context, tx *domain.Transaction, events ...domain.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, stored := range r.txs {
+		if tx.RecurringID != 0 && stored.RecurringID == tx.RecurringID && stored.Date.Equal(tx.Date) {
+			return domain.ErrAlreadyPosted
+		}
+	}
+	r.lastID++
+	tx.ID = r.lastID
+	tx.StatusChangedAt = time.Now()
+	r.txs = append(r.txs, *tx)
+	r.changes = append(r.changes, domain.StatusChange{TransactionID: tx.ID, To: tx.Status, ChangedAt: tx.StatusChangedAt})
+	if r.outbox != nil {
+		event := domain.NewEvent(domain.EventTransactionCreated, domain.NewTransactionEvent(*tx))
+		for _, e := range append([]domain.Event{event}, events...) {
+			_ = r.outbox.Write(ctx, e)
+		}
+	}
+	return nil
+}
+
+func (r *memTransactions) Reclassify(_ context.Context, tx domain.Transaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, stored := range r.txs {
+		if stored.ID != tx.ID {
+			continue
+		}
+		if stored.ReconciliationID != 0 {
+			return domain.ErrTransactionLocked
+		}
+		r.txs[i].Category = tx.Category
+		r.txs[i].Description = tx.Description
+		for _, tag := range tx.Tags {
+			if !slices.Contains(stored.Tags, tag) {
+				r.txs[i].Tags = append(r.txs[i].Tags, tag)
+			}
+		}
+	}
+	return nil
+}
+
+func (r *memTransactions) List(_ context.Context, filter domain.TransactionFilter) ([]domain.Transaction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var res []domain.Transaction
+	for _, tx := range r.txs {
+		if !hasAllTags(tx, filter.Tags) {
+			continue
+		}
+		if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, tx.Status) {
+			continue
+		}
+		res = append(res, tx)
+	}
+	return res, nil
+}
+
+func hasAllTags(tx domain.Transaction, tags []string) bool {
+	for _, tag := range tags {
+		if !slices.Contains(tx.Tags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *memTransactions) SumByTagInPeriod(
+	_ context.Context,
+	from, to time.Time,
+) (map[string]float64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	totals := make(map[string]float64)
+	for _, tx := range r.txs {
+		if tx.Status == domain.StatusVoided || tx.Date.Before(from) || tx.Date.After(to) {
+			continue
+		}
+		for _, tag := range tx.Tags {
+			totals[tag] += tx.Amount
+		}
+	}
+	return totals, nil
+}
+
+func (r *memTransactions) SumByPayeeInPeriod(
+	_ context.Context,
+	from, to time.Time,
+	limit int,
+) ([]domain.PayeeSpend, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	byPayee := make(map[int]*domain.PayeeSpend)
+	for _, tx := range r.txs {
+		if tx.PayeeID == 0 || tx.Status == domain.StatusVoided || tx.Date.Before(from) || tx.Date.After(to) {
+			continue
+		}
+		ps, ok := byPayee[tx.PayeeID]
+		if !ok {
+			ps = &domain.PayeeSpend{PayeeID: tx.PayeeID, Payee: tx.Payee}
+			byPayee[tx.PayeeID] = ps
+		}
+		ps.Spent += tx.Amount
+		ps.Count++
+	}
+	var out []domain.PayeeSpend
+	for _, ps := range byPayee {
+		out = append(out, *ps)
+	}
+	slices.SortFunc(out, func(a, b domain.PayeeSpend) int {
+		if a.Spent != b.Spent {
+			if a.Spent > b.Spent {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Payee, b.Payee)
+	})
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (r *memTransactions) ListByPeriod(
+	_ context.Context,
+	from, to time.Time,
+) ([]domain.Transaction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var res []domain.Transaction
+	for _, tx := range r.txs {
+		if tx.Status != domain.StatusVoided && !tx.Date.Before(from) && !tx.Date.After(to) {
+			res = append(res, tx)
+		}
+	}
+	return res, nil
+}
+
+func (r *memTransactions) ListByCategoryAndPeriod(
+	ctx context.Context,
+	category string,
+	from, to time.Time,
+) ([]domain.Transaction, error) {
+	txs, _ := r.ListByPeriod(ctx, from, to)
+	var res []domain.Transaction
+	for _, tx := range txs {
+		if tx.Category == category {
+			res = append(res, tx)
+		}
+	}
+	return res, nil
+}
+
+// lines flattens split transactions into one transaction per line, the
+// way the ledger sums spend per category, leaving out voided ones. r.mu
+// must be held.
+func (r *memTransactions) lines() []domain.Transaction {
+	var res []domain.Transaction
+	for _, tx := range r.txs {
+		if tx.Status == domain.StatusVoided {
+			continue
+		}
+		for _, line := range tx.Lines() {
+			tx.Category, tx.Amount = line.Category, line.Amount
+			res = append(res, tx)
+		}
+		for _, refund := range r.refunds {
+			if refund.TransactionID == tx.ID {
+				tx.Category, tx.Amount, tx.Date = refund.Category, -refund.Amount, refund.OriginalDate
+				res = append(res, tx)
+			}
+		}
+	}
+	return res
+}
+
+func (r *memTransactions) SumByCategory(_ context.Context, category string) (float64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var sum float64
+	for _, tx := range r.lines() {
+		if tx.Category == category {
+			sum += tx.Amount
+		}
+	}
+	return sum, nil
+}
+
+func (r *memTransactions) SumByCategories(
+	_ context.Context,
+	categories []string,
+	statuses []domain.TransactionStatus,
+) (float64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var sum float64
+	for _, tx := range r.lines() {
+		if len(statuses) > 0 && !slices.Contains(statuses, tx.Status) {
+			continue
+		}
+		if slices.Contains(categories, tx.Category) {
+			sum += tx.Amount
+		}
+	}
+	return sum, nil
+}
+
+func (r *memTransactions) ListCategories(_ context.Context) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	seen := make(map[string]bool)
+	var res []string
+	for _, tx := range r.lines() {
+		if !seen[tx.Category] {
+			seen[tx.Category] = true
+			res = append(res, tx.Category)
+		}
+	}
+	return res, nil
+}
+
+func (r *memTransactions) SumByCategoryAndPeriod(
+	_ context.Context,
+	category string,
+	from, to time.Time,
+) (float64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var sum float64
+	for _, tx := range r.lines() {
+		if tx.Category == category && !tx.Date.Before(from) && !tx.Date.After(to) {
+			sum += tx.Amount
+		}
+	}
+	return sum, nil
+}
+
+func (r *memTransactions) SumByCategoriesInPeriod(
+	_ context.Context,
+	from, to time.Time,
+) (map[string]float64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	totals := make(map[string]float64)
+	for _, tx := range r.lines() {
+		if !tx.Date.Before(from) && !tx.Date.After(to) {
+			totals[tx.Category] += tx.Amount
+		}
+	}
+	return totals, nil
+}
+
+func (r *memTransactions) SumByCategoriesInPeriodByStatus(
+	_ context.Context,
+	from, to time.Time,
+	statuses []domain.TransactionStatus,
+) (map[string]float64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	totals := make(map[string]float64)
+	for _, tx := range r.lines() {
+		if slices.Contains(statuses, tx.Status) && !tx.Date.Before(from) && !tx.Date.After(to) {
+			totals[tx.Category] += tx.Amount
+		}
+	}
+	return totals, nil
+}
+
+func (r *memTransactions) SumByCategoryAndBucket(
+	_ context.Context,
+	granularity domain.Granularity,
+	from, to time.Time,
+	categories []string,
+) ([]domain.BucketTotal, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	type key struct {
+		category string
+		start    time.Time
+	}
+	sums := make(map[key]float64)
+	for _, tx := range r.lines() {
+		if tx.Date.Before(from) || tx.Date.After(to) {
+			continue
+		}
+		if len(categories) > 0 && !slices.Contains(categories, tx.Category) {
+			continue
+		}
+		sums[key{tx.Category, granularity.Truncate(tx.Date)}] += tx.Amount
+	}
+
+	res := make([]domain.BucketTotal, 0, len(sums))
+	for k, sum := range sums {
+		res = append(res, domain.BucketTotal{Category: k.category, Start: k.start, Amount: sum})
+	}
+	return res, nil
+}
+
+type memCategories struct {
+	mu         sync.Mutex
+	categories []domain.Category
+}
+
+func (r *memCategories) Create(_ context.Context, c *domain.Category) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c.ID = len(r.categories) + 1
+	r.categories = append(r.categories, *c)
+	return nil
+}
+
+func (r *memCategories) Resolve(_ context.Context, name string) (string, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, c := range r.categories {
+		if strings.EqualFold(c.Name, name) {
+			return c.Name, true, nil
+		}
+	}
+	for _, c := range r.categories {
+		if slices.Contains(c.Aliases, strings.ToLower(name)) {
+			return c.Name, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+func (r *memCategories) AddAlias(_ context.Context, alias, category string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.categories {
+		if r.categories[i].Name == category {
+			r.categories[i].Aliases = append(r.categories[i].Aliases, strings.ToLower(alias))
+		}
+	}
+	return nil
+}
+
+func (r *memCategories) Rename(_ context.Context, from, to string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.categories {
+		if r.categories[i].Name == from {
+			r.categories[i].Name = to
+			r.categories[i].Aliases = append(r.categories[i].Aliases, strings.ToLower(from))
+		}
+	}
+	return nil
+}
+
+func (r *memCategories) Merge(_ context.Context, sources []string, target string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.categories = slices.DeleteFunc(r.categories, func(c domain.Category) bool {
+		return c.Name != target && slices.Contains(sources, c.Name)
+	})
+	return nil
+}
+
+func (r *memCategories) SetParent(_ context.Context, name, parent string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.categories {
+		if r.categories[i].Name == name {
+			r.categories[i].Parent = parent
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memCategories) Delete(_ context.Context, name string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, c := range r.categories {
+		if c.Name == name {
+			r.categories = slices.Delete(r.categories, i, i+1)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memCategories) GetByName(_ context.Context, name string) (domain.Category, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, c := range r.categories {
+		if c.Name == name {
+			return c, true, nil
+		}
+	}
+	return domain.Category{}, false, nil
+}
+
+func (r *memCategories) List(_ context.Context) ([]domain.Category, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.categories), nil
+}
+
+type memRecurring struct {
+	mu        sync.Mutex
+	recurring []domain.RecurringTransaction
+}
+
+func (r *memRecurring) Create(_ context.Context, rt *domain.RecurringTransaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rt.ID = len(r.recurring) + 1
+	r.recurring = append(r.recurring, *rt)
+	return nil
+}
+
+func (r *memRecurring) Update(_ context.Context, rt domain.RecurringTransaction) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.recurring {
+		if r.recurring[i].ID == rt.ID {
+			r.recurring[i] = rt
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memRecurring) Delete(_ context.Context, id int) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.recurring {
+		if r.recurring[i].ID == id {
+			r.recurring = slices.Delete(r.recurring, i, i+1)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memRecurring) Get(_ context.Context, id int) (domain.RecurringTransaction, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, rt := range r.recurring {
+		if rt.ID == id {
+			return rt, true, nil
+		}
+	}
+	return domain.RecurringTransaction{}, false, nil
+}
+
+func (r *memRecurring) List(_ context.Context) ([]domain.RecurringTransaction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.recurring), nil
+}
+
+func (r *memRecurring) ListDue(_ context.Context, asOf time.Time) ([]domain.RecurringTransaction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var res []domain.RecurringTransaction
+	for _, rt := range r.recurring {
+		if !rt.Next.IsZero() && !rt.Next.After(asOf) {
+			res = append(res, rt)
+		}
+	}
+	return res, nil
+}
+
+func (r *memRecurring) Advance(_ context.Context, id int, from, to time.Time, lastError string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.recurring {
+		if r.recurring[i].ID == id && r.recurring[i].Next.Equal(from) {
+			r.recurring[i].Next = to
+			r.recurring[i].LastError = lastError
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type memPayees struct {
+	mu     sync.Mutex
+	payees []domain.Payee
+}
+
+func (r *memPayees) Create(_ context.Context, p *domain.Payee) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p.ID = len(r.payees) + 1
+	r.payees = append(r.payees, *p)
+	return nil
+}
+
+func (r *memPayees) Ensure(_ context.Context, name string) (domain.Payee, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, p := range r.payees {
+		if strings.EqualFold(p.Name, name) {
+			return p, nil
+		}
+	}
+	p := domain.Payee{ID: len(r.payees) + 1, Name: name}
+	r.payees = append(r.payees, p)
+	return p, nil
+}
+
+func (r *memPayees) Get(_ context.Context, id int) (domain.Payee, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, p := range r.payees {
+		if p.ID == id {
+			return p, true, nil
+		}
+	}
+	return domain.Payee{}, false, nil
+}
+
+func (r *memPayees) GetByName(_ context.Context, name string) (domain.Payee, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, p := range r.payees {
+		if strings.EqualFold(p.Name, name) {
+			return p, true, nil
+		}
+	}
+	return domain.Payee{}, false, nil
+}
+
+func (r *memPayees) List(_ context.Context) ([]domain.Payee, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.payees), nil
+}
+
+type memRules struct {
+	mu    sync.Mutex
+	rules []domain.Rule
+}
+
+func (r *memRules) Create(_ context.Context, rule *domain.Rule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rule.ID = len(r.rules) + 1
+	r.rules = append(r.rules, *rule)
+	return nil
+}
+
+func (r *memRules) Update(_ context.Context, rule domain.Rule) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.rules {
+		if r.rules[i].ID == rule.ID {
+			r.rules[i] = rule
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memRules) Delete(_ context.Context, id int) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.rules {
+		if r.rules[i].ID == id {
+			r.rules = slices.Delete(r.rules, i, i+1)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memRules) List(_ context.Context) ([]domain.Rule, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.rules), nil
+}
+
+type memAttachments struct {
+	mu          sync.Mutex
+	attachments []domain.Attachment
+	// txs, when set, is checked for the transaction like the foreign key.
+	txs *memTransactions
+}
+
+func (r *memAttachments) Create(ctx context.Context, a *domain.Attachment) error {
+	if r.txs != nil {
+		all, _ := r.txs.List(ctx, domain.TransactionFilter{})
+		if !slices.ContainsFunc(all, func(tx domain.Transaction) bool { return tx.ID == a.TransactionID }) {
+			return domain.ErrTransactionNotFound
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	a.ID = len(r.attachments) + 1
+	a.CreatedAt = time.Now()
+	r.attachments = append(r.attachments, *a)
+	return nil
+}
+
+func (r *memAttachments) Get(_ context.Context, id int) (domain.Attachment, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, a := range r.attachments {
+		if a.ID == id {
+			return a, true, nil
+		}
+	}
+	return domain.Attachment{}, false, nil
+}
+
+func (r *memAttachments) ListByTransaction(_ context.Context, transactionID int) ([]domain.Attachment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var out []domain.Attachment
+	for _, a := range r.attachments {
+		if a.TransactionID == transactionID {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+// memDuplicates keeps dismissed pairs and merges in txs.
+type memDuplicates struct {
+	mu        sync.Mutex
+	dismissed [][2]int
+	txs       *memTransactions
+}
+
+func (r *memDuplicates) ListDismissed(_ context.Context) ([][2]int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.dismissed), nil
+}
+
+func (r *memDuplicates) Dismiss(ctx context.Context, ids []int) error {
+	if !r.txs.exist(ids...) {
+		return domain.ErrTransactionNotFound
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, a := range ids {
+		for _, b := range ids {
+			if a < b && !slices.Contains(r.dismissed, [2]int{a, b}) {
+				r.dismissed = append(r.dismissed, [2]int{a, b})
+			}
+		}
+	}
+	return nil
+}
+
+func (r *memDuplicates) Merge(_ context.Context, keep int, duplicates []int) ([]time.Time, error) {
+	if !r.txs.exist(append([]int{keep}, duplicates...)...) {
+		return nil, domain.ErrTransactionNotFound
+	}
+	if r.txs.reconciled(duplicates...) {
+		return nil, domain.ErrTransactionLocked
+	}
+
+	r.txs.mu.Lock()
+	defer r.txs.mu.Unlock()
+	var dates []time.Time
+
+	kept := r.txs.txs[slices.IndexFunc(r.txs.txs, func(tx domain.Transaction) bool { return tx.ID == keep })]
+	refunds := slices.Clone(r.txs.refunds)
+	for i, refund := range refunds {
+		if !slices.Contains(duplicates, refund.TransactionID) {
+			continue
+		}
+		var earlier []domain.Refund
+		for _, e := range refunds {
+			if e.TransactionID == keep {
+				earlier = append(earlier, e)
+			}
+		}
+		refund.TransactionID = keep
+		if len(kept.Splits) == 0 {
+			refund.Category = ""
+		}
+		if err := domain.CheckRefund(kept, earlier, &refund); err != nil {
+			return nil, err
+		}
+		refunds[i] = refund
+		dates = append(dates, kept.Date)
+	}
+	r.txs.refunds = refunds
+
+	r.txs.txs = slices.DeleteFunc(r.txs.txs, func(tx domain.Transaction) bool {
+		if slices.Contains(duplicates, tx.ID) {
+			dates = append(dates, tx.Date)
+			return true
+		}
+		return false
+	})
+	return dates, nil
+}
+
+func (r *memTransactions) SetStatus(
+	_ context.Context,
+	ids []int,
+	status domain.TransactionStatus,
+) ([]time.Time, error) {
+	if !r.exist(ids...) {
+		return nil, domain.ErrTransactionNotFound
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, tx := range r.txs {
+		if !slices.Contains(ids, tx.ID) || tx.Status == status {
+			continue
+		}
+		if tx.Status == domain.StatusReconciled {
+			return nil, domain.ErrTransactionLocked
+		}
+		if !tx.Status.CanBecome(status) {
+			return nil, domain.ErrStatusChange
+		}
+	}
+
+	var dates []time.Time
+	for i, tx := range r.txs {
+		if slices.Contains(ids, tx.ID) && tx.Status != status {
+			r.setStatus(i, status)
+			dates = append(dates, tx.Date)
+		}
+	}
+	return dates, nil
+}
+
+// setStatus moves r.txs[i] to status and records it. r.mu must be held.
+func (r *memTransactions) setStatus(i int, status domain.TransactionStatus) {
+	now := time.Now()
+	r.changes = append(r.changes, domain.StatusChange{
+		TransactionID: r.txs[i].ID,
+		From:          r.txs[i].Status,
+		To:            status,
+		ChangedAt:     now,
+	})
+	r.txs[i].Status, r.txs[i].StatusChangedAt = status, now
+}
+
+func (r *memTransactions) ListStatusChanges(_ context.Context, id int) ([]domain.StatusChange, error) {
+	if !r.exist(id) {
+		return nil, domain.ErrTransactionNotFound
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	res := make([]domain.StatusChange, 0)
+	for _, c := range r.changes {
+		if c.TransactionID == id {
+			res = append(res, c)
+		}
+	}
+	return res, nil
+}
+
+func (r *memTransactions) ListUnreconciled(
+	_ context.Context,
+	account string,
+	through time.Time,
+) ([]domain.Transaction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var res []domain.Transaction
+	for _, tx := range r.txs {
+		if tx.Account == account && !tx.Date.After(through) && tx.ReconciliationID == 0 && tx.Status != domain.StatusVoided {
+			res = append(res, tx)
+		}
+	}
+	return res, nil
+}
+
+func (r *memTransactions) Reconcile(_ context.Context, rec *domain.Reconciliation) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, _, _ := r.reconciliations.Get(context.Background(), rec.ID)
+	if !stored.CompletedAt.IsZero() {
+		return 0, domain.ErrReconciliationCompleted
+	}
+
+	var covered []int
+	rec.ClearedTotal = 0
+	for i, tx := range r.txs {
+		if tx.Account == rec.Account && !tx.Date.After(rec.StatementDate) && tx.Status == domain.StatusCleared && tx.ReconciliationID == 0 {
+			rec.ClearedTotal += tx.Amount
+			covered = append(covered, i)
+		}
+	}
+	if !rec.Balanced() {
+		return 0, domain.ErrReconciliationUnbalanced
+	}
+
+	for _, i := range covered {
+		r.txs[i].ReconciliationID = rec.ID
+		r.setStatus(i, domain.StatusReconciled)
+	}
+	rec.CompletedAt = time.Now()
+	r.reconciliations.complete(rec.ID, rec.CompletedAt)
+	return len(covered), nil
+}
+
+func (r *memTransactions) reconciled(ids ...int) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.ContainsFunc(r.txs, func(tx domain.Transaction) bool {
+		return tx.ReconciliationID != 0 && slices.Contains(ids, tx.ID)
+	})
+}
+
+type memReconciliations struct {
+	mu   sync.Mutex
+	recs []domain.Reconciliation
+}
+
+func (r *memReconciliations) Create(_ context.Context, rec *domain.Reconciliation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, stored := range r.recs {
+		if stored.Account == rec.Account && stored.CompletedAt.IsZero() {
+			return domain.ErrReconciliationOpen
+		}
+	}
+	rec.ID = len(r.recs) + 1
+	r.recs = append(r.recs, *rec)
+	return nil
+}
+
+func (r *memReconciliations) Get(_ context.Context, id int) (domain.Reconciliation, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, rec := range r.recs {
+		if rec.ID == id {
+			return rec, true, nil
+		}
+	}
+	return domain.Reconciliation{}, false, nil
+}
+
+func (r *memReconciliations) List(_ context.Context) ([]domain.Reconciliation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.recs), nil
+}
+
+func (r *memReconciliations) LastCompleted(_ context.Context, account string) (domain.Reconciliation, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var (
+		last domain.Reconciliation
+		ok   bool
+	)
+	for _, rec := range r.recs {
+		if rec.Account == account && !rec.CompletedAt.IsZero() &&
+			(!ok || rec.StatementDate.After(last.StatementDate)) {
+			last, ok = rec, true
+		}
+	}
+	return last, ok, nil
+}
+
+func (r *memReconciliations) complete(id int, at time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.recs {
+		if r.recs[i].ID == id {
+			r.recs[i].CompletedAt = at
+		}
+	}
+}
+
+func (r *memTransactions) exist(ids ...int) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, id := range ids {
+		if !slices.ContainsFunc(r.txs, func(tx domain.Transaction) bool { return tx.ID == id }) {
+			return false
+		}
+	}
+	return true
+}
+
+type memRefunds struct {
+	txs    *memTransactions
+	lastID int
+}
+
+func (r *memRefunds) Create(_ context.Context, refund *domain.Refund) error {
+	r.txs.mu.Lock()
+	defer r.txs.mu.Unlock()
+	i := slices.IndexFunc(r.txs.txs, func(tx domain.Transaction) bool { return tx.ID == refund.TransactionID })
+	if i < 0 {
+		return domain.ErrTransactionNotFound
+	}
+	if err := domain.CheckRefund(r.txs.txs[i], r.list(refund.TransactionID), refund); err != nil {
+		return err
+	}
+	r.lastID++
+	refund.ID = r.lastID
+	r.txs.refunds = append(r.txs.refunds, *refund)
+	return nil
+}
+
+func (r *memRefunds) ListByTransaction(_ context.Context, transactionID int) ([]domain.Refund, error) {
+	r.txs.mu.Lock()
+	defer r.txs.mu.Unlock()
+	return r.list(transactionID), nil
+}
+
+func (r *memRefunds) list(transactionID int) []domain.Refund {
+	res := make([]domain.Refund, 0)
+	for _, refund := range r.txs.refunds {
+		if refund.TransactionID == transactionID {
+			res = append(res, refund)
+		}
+	}
+	return res
+}
+
+type memWebhooks struct {
+	subs       []domain.WebhookSubscription
+	deliveries []domain.WebhookDelivery
+}
+
+func (r *memWebhooks) Create(_ context.Context, s *domain.WebhookSubscription) error {
+	s.ID = len(r.subs) + 1
+	r.subs = append(r.subs, *s)
+	return nil
+}
+
+func (r *memWebhooks) Delete(_ context.Context, id int) (bool, error) {
+	n := len(r.subs)
+	r.subs = slices.DeleteFunc(r.subs, func(s domain.WebhookSubscription) bool { return s.ID == id })
+	return len(r.subs) < n, nil
+}
+
+func (r *memWebhooks) Get(_ context.Context, id int) (domain.WebhookSubscription, bool, error) {
+	i := slices.IndexFunc(r.subs, func(s domain.WebhookSubscription) bool { return s.ID == id })
+	if i < 0 {
+		return domain.WebhookSubscription{}, false, nil
+	}
+	return r.subs[i], true, nil
+}
+
+func (r *memWebhooks) List(context.Context) ([]domain.WebhookSubscription, error) {
+	return slices.Clone(r.subs), nil
+}
+
+func (r *memWebhooks) ListByEvent(_ context.Context, e domain.EventType) ([]domain.WebhookSubscription, error) {
+	var res []domain.WebhookSubscription
+	for _, s := range r.subs {
+		if slices.Contains(s.Events, e) {
+			res = append(res, s)
+		}
+	}
+	return res, nil
+}
+
+func (r *memWebhooks) LogDelivery(_ context.Context, d *domain.WebhookDelivery) error {
+	d.ID = len(r.deliveries) + 1
+	r.deliveries = append(r.deliveries, *d)
+	return nil
+}
+
+func (r *memWebhooks) ListDeliveries(_ context.Context, subscriptionID, limit int) ([]domain.WebhookDelivery, error) {
+	var res []domain.WebhookDelivery
+	for _, d := range r.deliveries {
+		if d.SubscriptionID == subscriptionID && len(res) < limit {
+			res = append(res, d)
+		}
+	}
+	return res, nil
+}
+
+type memNotifications struct {
+	recipients []domain.NotificationRecipient
+}
+
+func (r *memNotifications) Save(_ context.Context, rcpt *domain.NotificationRecipient) error {
+	i := slices.IndexFunc(r.recipients, func(x domain.NotificationRecipient) bool { return x.Email == rcpt.Email })
+	if i >= 0 {
+		rcpt.ID = r.recipients[i].ID
+		r.recipients[i] = *rcpt
+		return nil
+	}
+	rcpt.ID = len(r.recipients) + 1
+	r.recipients = append(r.recipients, *rcpt)
+	return nil
+}
+
+func (r *memNotifications) Delete(_ context.Context, id int) (bool, error) {
+	n := len(r.recipients)
+	r.recipients = slices.DeleteFunc(r.recipients, func(x domain.NotificationRecipient) bool { return x.ID == id })
+	return len(r.recipients) < n, nil
+}
+
+func (r *memNotifications) List(context.Context) ([]domain.NotificationRecipient, error) {
+	return slices.Clone(r.recipients), nil
+}
+
+func (r *memNotifications) ListOptedIn(_ context.Context, kind domain.NotificationKind) ([]domain.NotificationRecipient, error) {
+	var res []domain.NotificationRecipient
+	for _, rcpt := range r.recipients {
+		if rcpt.Wants(kind) {
+			res = append(res, rcpt)
+		}
+	}
+	return res, nil
+}
+
+// memOutbox records the events written to the outbox. Nothing relays
+// them.
+type memOutbox struct {
+	mu     sync.Mutex
+	events []domain.Event
+}
+
+func (p *memOutbox) Write(_ context.Context, e domain.Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, e)
+	return nil
+}
+
+func (p *memOutbox) Relay(context.Context, int, func(context.Context, []domain.OutboxMessage) error) (int, error) {
+	return 0, nil
+}
+
+func (p *memOutbox) Purge(context.Context, time.Time) (int64, error) { return 0, nil }
+
+func (p *memOutbox) ofType(t domain.EventType) []domain.Event {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var res []domain.Event
+	for _, e := range p.events {
+		if e.Type == t {
+			res = append(res, e)
+		}
+	}
+	return res
+}
+
+func newTestLedger(budgets *memBudgets, txs *memTransactions) *ledger {
+	if txs.reconciliations == nil {
+		txs.reconciliations = &memReconciliations{}
+	}
+	if txs.outbox == nil {
+		txs.outbox = &memOutbox{}
+	}
+	return New(
+		budgets,
+		txs,
+		&memCategories{},
+		&memRecurring{},
+		&memPayees{},
+		&memRules{},
+		&memAttachments{},
+		&memDuplicates{txs: txs},
+		txs.reconciliations,
+		&memRefunds{txs: txs},
+		&memWebhooks{},
+		&memNotifications{},
+		domain.DefaultDuplicateDetector(),
+		domain.DefaultBudgetPolicy(),
+		txs.outbox,
+		nil,
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		nil,
+	).(*ledger)
+}
+
+func TestDryRunImport(t *testing.T) {
+	ctx := context.Background()
+	budgets := &memBudgets{}
+	txs := &memTransactions{}
+	svc := newTestLedger(budgets, txs)
+
+	_ = budgets.Upsert(ctx, domain.Budget{Category: "food", Limit: 100})
+	_ = txs.Add(ctx, &domain.Transaction{Amount: 40, Category: "food", Date: time.Now()})
+
+	res, err := svc.DryRunImport(ctx, []domain.Transaction{
+		{Amount: 30, Category: "food"},
+		{Amount: 40, Category: "food"},
+		{Amount: 0, Category: "food"},
+		{Amount: 25, Category: "food"},
+		{Amount: 15, Category: "taxi"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if res.Accepted != 3 || res.Rejected != 2 {
+		t.Fatalf("expected 3 accepted and 2 rejected, got %d and %d", res.Accepted, res.Rejected)
+	}
+	if res.Errors[0].Index != 1 || res.Errors[1].Index != 2 {
+		t.Fatalf("unexpected rejected rows: %+v", res.Errors)
+	}
+	if res.CategorySpend["food"] != 95 || res.CategorySpend["taxi"] != 15 {
+		t.Fatalf("unexpected category spend: %v", res.CategorySpend)
+	}
+
+	stored, _ := txs.List(ctx, domain.TransactionFilter{})
+	if len(stored) != 1 {
+		t.Fatalf("dry run must not write, got %d transactions", len(stored))
+	}
+}
+
+func TestDryRunImport_SpendMatchesBudgetCheck(t *testing.T) {
+	ctx := context.Background()
+	budgets := &memBudgets{}
+	txs := &memTransactions{}
+	svc := newTestLedger(budgets, txs)
+	svc.budgetPolicy = domain.BudgetPolicy{CountPending: false}
+
+	_, _ = svc.CreateCategory(ctx, domain.Category{Name: "food"})
+	_, _ = svc.CreateCategory(ctx, domain.Category{Name: "groceries", Parent: "food"})
+	_ = budgets.Upsert(ctx, domain.Budget{Category: "food", Limit: 100})
+	_ = txs.Add(ctx, &domain.Transaction{Amount: 30, Category: "groceries", Status: domain.StatusCleared, Date: time.Now()})
+	_ = txs.Add(ctx, &domain.Transaction{Amount: 50, Category: "food", Status: domain.StatusPending, Date: time.Now()})
+
+	res, err := svc.DryRunImport(ctx, []domain.Transaction{
+		{Amount: 10, Category: "groceries", Status: domain.StatusCleared},
+		{Amount: 5, Category: "food", Status: domain.StatusPending},
+		{Amount: 70, Category: "food", Status: domain.StatusCleared},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if res.Accepted != 2 || res.Rejected != 1 || res.Errors[0].Index != 2 {
+		t.Fatalf("expected only the last row to be over budget, got %+v", res)
+	}
+	// Pending spend is not counted, and food covers groceries.
+	if res.CategorySpend["groceries"] != 40 || res.CategorySpend["food"] != 40 {
+		t.Fatalf("unexpected category spend: %v", res.CategorySpend)
+	}
+}
+
+func TestAddTransaction_ParentBudget(t *testing.T) {
+	ctx := context.Background()
+	budgets := &memBudgets{}
+	txs := &memTransactions{}
+	svc := newTestLedger(budgets, txs)
+
+	_, _ = svc.CreateCategory(ctx, domain.Category{Name: "food"})
+	_, _ = svc.CreateCategory(ctx, domain.Category{Name: "groceries", Parent: "food"})
+	_, _ = svc.CreateCategory(ctx, domain.Category{Name: "coffee", Parent: "food"})
+	_ = budgets.Upsert(ctx, domain.Budget{Category: "food", Limit: 100})
+
+	if _, err := svc.AddTransaction(ctx, domain.Transaction{Amount: 70, Category: "groceries"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.AddTransaction(ctx, domain.Transaction{Amount: 40, Category: "coffee"}); !errors.Is(err, ErrBudgetExceeded) {
+		t.Fatalf("expected ErrBudgetExceeded, got %v", err)
+	}
+	if _, err := svc.AddTransaction(ctx, domain.Transaction{Amount: 30, Category: "coffee"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestUpdateCategory_RejectsCycles(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestLedger(&memBudgets{}, &memTransactions{})
+
+	_, _ = svc.CreateCategory(ctx, domain.Category{Name: "food"})
+	_, _ = svc.CreateCategory(ctx, domain.Category{Name: "groceries", Parent: "food"})
+
+	if _, err := svc.UpdateCategory(ctx, domain.Category{Name: "food", Parent: "groceries"}); err == nil {
+		t.Fatal("expected an error moving a category under its subcategory")
+	}
+	if _, err := svc.CreateCategory(ctx, domain.Category{Name: "tea", Parent: "drinks"}); !errors.Is(err, ErrCategoryNotFound) {
+		t.Fatalf("expected ErrCategoryNotFound, got %v", err)
+	}
+	if err := svc.DeleteCategory(ctx, "food"); err == nil {
+		t.Fatal("expected an error deleting a category with subcategories")
+	}
+}
+
+func TestCategoryAliases(t *testing.T) {
+	ctx := context.Background()
+	budgets := &memBudgets{}
+	svc := newTestLedger(budgets, &memTransactions{})
+
+	_, _ = svc.CreateCategory(ctx, domain.Category{Name: "Food"})
+	if _, err := svc.AddCategoryAlias(ctx, "Groceries", "food"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tx, err := svc.AddTransaction(ctx, domain.Transaction{Amount: 10, Category: "  groceries "})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tx.Category != "Food" {
+		t.Fatalf("expected category Food, got %q", tx.Category)
+	}
+
+	b, err := svc.SetBudget(ctx, domain.Budget{Category: "FOOD", Limit: 100})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if b.Category != "Food" {
+		t.Fatalf("expected budget on Food, got %q", b.Category)
+	}
+
+	_, _ = svc.CreateCategory(ctx, domain.Category{Name: "Transport"})
+	if _, err := svc.RenameCategory(ctx, "transport", "groceries"); !errors.Is(err, ErrCategoryExists) {
+		t.Fatalf("expected ErrCategoryExists, got %v", err)
+	}
+	if _, err := svc.CreateCategory(ctx, domain.Category{Name: "food"}); !errors.Is(err, ErrCategoryExists) {
+		t.Fatalf("expected ErrCategoryExists, got %v", err)
+	}
+}
+
+func TestAddTransaction_SplitBudgets(t *testing.T) {
+	ctx := context.Background()
+	budgets := &memBudgets{}
+	txs := &memTransactions{}
+	svc := newTestLedger(budgets, txs)
+
+	_ = budgets.Upsert(ctx, domain.Budget{Category: "household", Limit: 20})
+
+	tx, err := svc.AddTransaction(ctx, domain.Transaction{
+		Amount: 45,
+		Splits: []domain.Split{
+			{Category: "groceries", Amount: 30},
+			{Category: "household", Amount: 15},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tx.Category != "groceries" {
+		t.Fatalf("expected the largest line's category, got %q", tx.Category)
+	}
+
+	_, err = svc.AddTransaction(ctx, domain.Transaction{
+		Amount: 20,
+		Splits: []domain.Split{
+			{Category: "groceries", Amount: 10},
+			{Category: "household", Amount: 10},
+		},
+	})
+	if !errors.Is(err, ErrBudgetExceeded) {
+		t.Fatalf("expected ErrBudgetExceeded for the household line, got %v", err)
+	}
+
+	totals, err := svc.GetReportSummary(ctx, time.Now().AddDate(0, 0, -1), time.Now().AddDate(0, 0, 1), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if totals["groceries"] != 30 || totals["household"] != 15 {
+		t.Fatalf("expected spend per split line, got %v", totals)
+	}
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+// SetTransactionStatus moves transactions to status and records the
+// change. Transactions are reconciled by completing a reconciliation, not
+// here; reconciled and voided ones cannot be changed.
+func (svc *ledger) SetTransactionStatus(ctx context.Context, ids []int, status domain.TransactionStatus) error {
+	ids, err := transactionIDs(ids)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return errors.New("validation failed: at least one transaction is required")
+	}
+	if status == domain.StatusReconciled {
+		return errors.New("validation failed: transactions are reconciled by completing a reconciliation")
+	}
+	if _, err := domain.ParseTransactionStatus(string(status)); err != nil {
+		return err
+	}
+
+	dates, err := svc.transactions.SetStatus(ctx, ids, status)
+	if err != nil {
+		return err
+	}
+
+	svc.log.Info(
+		"transaction status changed",
+		slog.Int("transactions", len(ids)),
+		slog.Int("changed", len(dates)),
+		slog.String("status", string(status)),
+	)
+
+	svc.invalidateReports(ctx, dates...)
+
+	return nil
+}
+
+// ListStatusChanges returns the status history of a transaction, oldest
+// first.
+func (svc *ledger) ListStatusChanges(ctx context.Context, id int) ([]domain.StatusChange, error) {
+	if id <= 0 {
+		return nil, errors.New("validation failed: transaction id is required")
+	}
+
+	return svc.transactions.ListStatusChanges(ctx, id)
+}
+
+// normalizeStatuses sorts statuses and drops repeated ones, so equal
+// filters share a cache key.
+func normalizeStatuses(statuses []domain.TransactionStatus) []domain.TransactionStatus {
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	res := slices.Clone(statuses)
+	slices.Sort(res)
+	return slices.Compact(res)
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+func TestTransactionStatus_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+	txs := &memTransactions{}
+	svc := newTestLedger(&memBudgets{}, txs)
+	day := time.Now()
+
+	for _, tx := range []domain.Transaction{
+		{Amount: 10, Category: "food", Description: "Bakery", Date: day},
+		{Amount: 20, Category: "food", Description: "Market", Date: day, Status: domain.StatusCleared},
+		{Amount: 30, Category: "fuel", Description: "Shell", Date: day},
+	} {
+		if _, err := svc.AddTransaction(ctx, tx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, err := svc.AddTransaction(ctx, domain.Transaction{Amount: 1, Category: "food", Status: domain.StatusVoided}); err == nil {
+		t.Fatal("expected a new voided transaction to be rejected")
+	}
+
+	pending, _ := svc.ListTransactions(ctx, domain.TransactionFilter{Statuses: []domain.TransactionStatus{domain.StatusPending}})
+	if len(pending) != 2 {
+		t.Fatalf("expected new transactions to be pending by default, got %+v", pending)
+	}
+
+	if err := svc.SetTransactionStatus(ctx, []int{3}, domain.StatusVoided); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.SetTransactionStatus(ctx, []int{3}, domain.StatusPending); !errors.Is(err, domain.ErrStatusChange) {
+		t.Fatalf("expected ErrStatusChange, got %v", err)
+	}
+	if err := svc.SetTransactionStatus(ctx, []int{1}, domain.StatusReconciled); err == nil {
+		t.Fatal("expected reconciling outside a reconciliation to be rejected")
+	}
+
+	from, to := day.AddDate(0, 0, -1), day.AddDate(0, 0, 1)
+	totals, err := svc.GetReportSummary(ctx, from, to, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if totals["food"] != 30 || totals["fuel"] != 0 {
+		t.Fatalf("expected voided spend to be left out, got %v", totals)
+	}
+
+	cleared, err := svc.GetReportSummary(ctx, from, to, []domain.TransactionStatus{domain.StatusCleared})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cleared) != 1 || cleared["food"] != 20 {
+		t.Fatalf("expected only cleared spend, got %v", cleared)
+	}
+	if _, err := svc.GetReportSummary(ctx, from, to, []domain.TransactionStatus{domain.StatusVoided}); err == nil {
+		t.Fatal("expected a voided report filter to be rejected")
+	}
+
+	changes, err := svc.ListStatusChanges(ctx, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 2 ||
+		changes[0].From != "" || changes[0].To != domain.StatusPending ||
+		changes[1].From != domain.StatusPending || changes[1].To != domain.StatusVoided ||
+		changes[1].ChangedAt.IsZero() {
+		t.Fatalf("unexpected status history: %+v", changes)
+	}
+	if _, err := svc.ListStatusChanges(ctx, 99); !errors.Is(err, domain.ErrTransactionNotFound) {
+		t.Fatalf("expected ErrTransactionNotFound, got %v", err)
+	}
+}
+
+func TestAddTransaction_BudgetIgnoresPending(t *testing.T) {
+	ctx := context.Background()
+	budgets := &memBudgets{}
+	txs := &memTransactions{}
+	svc := newTestLedger(budgets, txs)
+	svc.budgetPolicy = domain.BudgetPolicy{CountPending: false}
+
+	_ = budgets.Upsert(ctx, domain.Budget{Category: "food", Limit: 100})
+
+	// Pending transactions are not checked, however far over they go.
+	if _, err := svc.AddTransaction(ctx, domain.Transaction{Amount: 90, Category: "food"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.AddTransaction(ctx, domain.Transaction{Amount: 90, Category: "food"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Cleared ones only see cleared spend.
+	if _, err := svc.AddTransaction(ctx, domain.Transaction{Amount: 60, Category: "food", Status: domain.StatusCleared}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.AddTransaction(ctx, domain.Transaction{Amount: 50, Category: "food", Status: domain.StatusCleared}); !errors.Is(err, ErrBudgetExceeded) {
+		t.Fatalf("expected ErrBudgetExceeded, got %v", err)
+	}
+
+	// Clearing a pending transaction makes it count.
+	if err := svc.SetTransactionStatus(ctx, []int{1}, domain.StatusCleared); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.AddTransaction(ctx, domain.Transaction{Amount: 5, Category: "food", Status: domain.StatusCleared}); !errors.Is(err, ErrBudgetExceeded) {
+		t.Fatalf("expected ErrBudgetExceeded, got %v", err)
+	}
+
+	svc.budgetPolicy = domain.DefaultBudgetPolicy()
+	if _, err := svc.AddTransaction(ctx, domain.Transaction{Amount: 1, Category: "food"}); !errors.Is(err, ErrBudgetExceeded) {
+		t.Fatalf("expected pending spend to count by default, got %v", err)
+	}
+}
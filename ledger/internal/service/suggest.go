@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+// MinSuggestionConfidence is the confidence a suggestion needs to be
+// used as the category of a transaction that has none.
+const MinSuggestionConfidence = 0.6
+
+// suggester holds the category classifier. It is trained from the stored
+// transactions on first use and then learns every transaction added.
+type suggester struct {
+	mu    sync.Mutex
+	model *domain.Classifier
+}
+
+// SuggestCategory returns up to n likely categories for t, most likely
+// first. It returns none when nothing similar has been seen.
+func (svc *ledger) SuggestCategory(ctx context.Context, t domain.Transaction, n int) ([]domain.CategorySuggestion, error) {
+	model, err := svc.classifier(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.Suggest(t, n), nil
+}
+
+// suggestMissingCategory fills in the category of t when it has none and
+// the classifier is confident enough.
+func (svc *ledger) suggestMissingCategory(ctx context.Context, t *domain.Transaction) {
+	if t.Category != "" || len(t.Splits) > 0 {
+		return
+	}
+
+	suggestions, err := svc.SuggestCategory(ctx, *t, 1)
+	if err != nil {
+		svc.log.Warn("category suggestion failed", slog.String("error", err.Error()))
+		return
+	}
+	if len(suggestions) == 0 || suggestions[0].Confidence < MinSuggestionConfidence {
+		return
+	}
+
+	t.Category = suggestions[0].Category
+	t.Suggestion = &suggestions[0]
+}
+
+// classifier returns the trained model, training it first if needed.
+func (svc *ledger) classifier(ctx context.Context) (*domain.Classifier, error) {
+	svc.suggest.mu.Lock()
+	defer svc.suggest.mu.Unlock()
+
+	if svc.suggest.model != nil {
+		return svc.suggest.model, nil
+	}
+
+	txs, err := svc.transactions.List(ctx, domain.TransactionFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	model := domain.NewClassifier()
+	for _, tx := range txs {
+		model.Learn(tx)
+	}
+	svc.suggest.model = model
+
+	svc.log.Info("category classifier trained", slog.Int("transactions", len(txs)))
+
+	return model, nil
+}
+
+// learn adds a new transaction to the model. Until the model is trained
+// there is nothing to do: training reads the transaction from storage.
+func (svc *ledger) learn(t domain.Transaction) {
+	svc.suggest.mu.Lock()
+	defer svc.suggest.mu.Unlock()
+
+	if svc.suggest.model != nil {
+		svc.suggest.model.Learn(t)
+	}
+}
+
+// forgetModel drops the model after stored categories were rewritten; it
+// is retrained on next use.
+func (svc *ledger) forgetModel() {
+	svc.suggest.mu.Lock()
+	defer svc.suggest.mu.Unlock()
+
+	svc.suggest.model = nil
+}
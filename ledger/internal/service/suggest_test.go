@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+func TestAddTransaction_FillsMissingCategoryFromHistory(t *testing.T) {
+	ctx := context.Background()
+	txs := &memTransactions{}
+	svc := newTestLedger(&memBudgets{}, txs)
+
+	for _, d := range []string{"Starbucks latte", "Starbucks mocha", "Starbucks #3"} {
+		_ = txs.Add(ctx, &domain.Transaction{Amount: 4, Category: "coffee", Description: d, Date: time.Now()})
+	}
+	_ = txs.Add(ctx, &domain.Transaction{Amount: 50, Category: "groceries", Description: "Lidl shop", Date: time.Now()})
+
+	tx, err := svc.AddTransaction(ctx, domain.Transaction{Amount: 4.2, Description: "STARBUCKS 0042"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tx.Category != "coffee" || tx.Suggestion == nil || tx.Suggestion.Confidence < MinSuggestionConfidence {
+		t.Fatalf("expected a suggested coffee category, got %q %+v", tx.Category, tx.Suggestion)
+	}
+
+	// The model is trained now; new transactions are learned as they come.
+	if _, err := svc.AddTransaction(ctx, domain.Transaction{
+		Amount:      12,
+		Category:    "subscriptions",
+		Description: "Netflix",
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := svc.SuggestCategory(ctx, domain.Transaction{Amount: 12, Description: "netflix"}, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0].Category != "subscriptions" {
+		t.Fatalf("expected the new transaction to be learned, got %+v", got)
+	}
+}
+
+func TestAddTransaction_NoSuggestionForUnknownDescription(t *testing.T) {
+	svc := newTestLedger(&memBudgets{}, &memTransactions{})
+
+	_, err := svc.AddTransaction(context.Background(), domain.Transaction{Amount: 5, Description: "something new"})
+	if err == nil {
+		t.Fatal("expected a validation error without a category")
+	}
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+var ErrWebhookNotFound = errors.New("webhook not found")
+
+const (
+	defaultDeliveryLimit = 50
+	maxDeliveryLimit     = 500
+)
+
+// CreateWebhook subscribes s.URL to s.Events. A secret is generated when
+// s has none; it is only returned here.
+func (svc *ledger) CreateWebhook(ctx context.Context, s domain.WebhookSubscription) (domain.WebhookSubscription, error) {
+	s.URL = strings.TrimSpace(s.URL)
+	if s.Secret == "" {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return s, err
+		}
+		s.Secret = hex.EncodeToString(secret)
+	}
+	if s.Threshold == 0 {
+		s.Threshold = domain.DefaultWebhookThreshold
+	}
+
+	events := make([]domain.EventType, 0, len(s.Events))
+	for _, e := range s.Events {
+		event, err := domain.ParseEventType(string(e))
+		if err != nil {
+			return s, err
+		}
+		events = append(events, event)
+	}
+	slices.Sort(events)
+	s.Events = slices.Compact(events)
+
+	if err := s.Validate(); err != nil {
+		return s, err
+	}
+
+	if err := svc.webhooks.Create(ctx, &s); err != nil {
+		return s, err
+	}
+
+	svc.log.Info(
+		"webhook created",
+		slog.Int("id", s.ID),
+		slog.String("url", s.URL),
+		slog.Int("events", len(s.Events)),
+	)
+
+	return s, nil
+}
+
+// ListWebhooks returns the subscriptions without their secrets.
+func (svc *ledger) ListWebhooks(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	subs, err := svc.webhooks.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range subs {
+		subs[i].Secret = ""
+	}
+
+	return subs, nil
+}
+
+func (svc *ledger) DeleteWebhook(ctx context.Context, id int) error {
+	ok, err := svc.webhooks.Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrWebhookNotFound
+	}
+
+	svc.log.Info("webhook deleted", slog.Int("id", id))
+
+	return nil
+}
+
+// ListWebhookDeliveries returns the latest delivery attempts of a
+// webhook, latest first.
+func (svc *ledger) ListWebhookDeliveries(ctx context.Context, id, limit int) ([]domain.WebhookDelivery, error) {
+	if limit <= 0 {
+		limit = defaultDeliveryLimit
+	}
+	limit = min(limit, maxDeliveryLimit)
+
+	_, ok, err := svc.webhooks.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrWebhookNotFound
+	}
+
+	return svc.webhooks.ListDeliveries(ctx, id, limit)
+}
+
+// publish writes an event of type t that comes with no stored change to
+// the outbox, if there is one. The request it describes is answered
+// either way, so a failed write is only logged.
+func (svc *ledger) publish(ctx context.Context, t domain.EventType, data any) {
+	if svc.outbox == nil {
+		return
+	}
+	if err := svc.outbox.Write(ctx, domain.NewEvent(t, data)); err != nil {
+		svc.log.Error(
+			"event not written to the outbox",
+			slog.String("event", string(t)),
+			slog.String("error", err.Error()),
+		)
+	}
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+func TestWebhookEvents(t *testing.T) {
+	ctx := context.Background()
+	budgets := &memBudgets{}
+	txs := &memTransactions{}
+	svc := newTestLedger(budgets, txs)
+	events := txs.outbox
+
+	_ = budgets.Upsert(ctx, domain.Budget{Category: "food", Limit: 100})
+
+	for _, amount := range []float64{50, 40} {
+		if _, err := svc.AddTransaction(ctx, domain.Transaction{Amount: amount, Category: "food"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, err := svc.AddTransaction(ctx, domain.Transaction{Amount: 20, Category: "food"}); !errors.Is(err, ErrBudgetExceeded) {
+		t.Fatalf("expected ErrBudgetExceeded, got %v", err)
+	}
+
+	if created := events.ofType(domain.EventTransactionCreated); len(created) != 2 {
+		t.Fatalf("expected transaction.created for the stored transactions only, got %+v", created)
+	}
+
+	crossed := events.ofType(domain.EventBudgetThresholdCrossed)
+	if len(crossed) != 2 {
+		t.Fatalf("expected a budget event per transaction, got %+v", crossed)
+	}
+	sub := domain.WebhookSubscription{
+		Events:    []domain.EventType{domain.EventBudgetThresholdCrossed},
+		Threshold: domain.DefaultWebhookThreshold,
+	}
+	if sub.Matches(crossed[0]) || !sub.Matches(crossed[1]) {
+		t.Fatalf("expected only the second transaction to cross 80%%, got %+v", crossed)
+	}
+
+	exceeded := events.ofType(domain.EventBudgetExceeded)
+	if len(exceeded) != 1 {
+		t.Fatalf("expected one budget.exceeded event, got %+v", exceeded)
+	}
+	if b := exceeded[0].Data.(domain.BudgetEvent); b.Category != "food" || b.Before != 90 || b.After != 110 {
+		t.Fatalf("unexpected budget.exceeded event: %+v", b)
+	}
+
+	res, err := svc.ImportTransactions(ctx, []domain.Transaction{
+		{Amount: 5, Category: "fuel"},
+		{Amount: 0, Category: "fuel"},
+	}, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	imported := events.ofType(domain.EventImportCompleted)
+	if len(imported) != 1 || imported[0].Data != (domain.ImportEvent{Accepted: res.Accepted, Rejected: res.Rejected}) {
+		t.Fatalf("unexpected import.completed events: %+v", imported)
+	}
+}
+
+func TestCreateWebhook(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestLedger(&memBudgets{}, &memTransactions{})
+
+	s, err := svc.CreateWebhook(ctx, domain.WebhookSubscription{
+		URL:    " http://localhost:8123/hook ",
+		Events: []domain.EventType{"Budget.Exceeded", domain.EventBudgetExceeded},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s.ID == 0 || len(s.Secret) != 64 || s.Threshold != domain.DefaultWebhookThreshold ||
+		len(s.Events) != 1 || s.URL != "http://localhost:8123/hook" {
+		t.Fatalf("unexpected webhook: %+v", s)
+	}
+
+	for _, bad := range []domain.WebhookSubscription{
+		{URL: "localhost/hook", Events: []domain.EventType{domain.EventBudgetExceeded}},
+		{URL: "http://localhost/hook", Events: []domain.EventType{"budget.deleted"}},
+		{URL: "http://localhost/hook"},
+	} {
+		if _, err := svc.CreateWebhook(ctx, bad); err == nil {
+			t.Fatalf("expected %+v to be rejected", bad)
+		}
+	}
+
+	list, err := svc.ListWebhooks(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list) != 1 || list[0].Secret != "" {
+		t.Fatalf("expected one webhook without its secret, got %+v", list)
+	}
+
+	if _, err := svc.ListWebhookDeliveries(ctx, 99, 0); !errors.Is(err, ErrWebhookNotFound) {
+		t.Fatalf("expected ErrWebhookNotFound, got %v", err)
+	}
+	if err := svc.DeleteWebhook(ctx, s.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.DeleteWebhook(ctx, s.ID); !errors.Is(err, ErrWebhookNotFound) {
+		t.Fatalf("expected ErrWebhookNotFound, got %v", err)
+	}
+}
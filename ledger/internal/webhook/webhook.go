@@ -0,0 +1,287 @@
+// Package webhook delivers ledger events to the HTTP endpoints subscribed
+// to them.
+//
+// Every delivery is a POST of the event as JSON with these headers:
+//
+//	X-FinScope-Event       the event type, e.g. transaction.created
+//	X-FinScope-Delivery    the event ID, the same for every retry
+//	X-FinScope-Timestamp   when the request was signed, in Unix seconds
+//	X-FinScope-Signature   sha256= and the hex HMAC-SHA256 of
+//	                       "<timestamp>.<body>" keyed with the secret
+//
+// Receivers should recompute the signature with Sign and reject old
+// timestamps.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+type Config struct {
+	// Workers is how many events are delivered at the same time.
+	Workers int
+	// QueueSize is how many events wait for a worker before new ones are
+	// dropped.
+	QueueSize int
+	// MaxAttempts is how many times a delivery is tried in all.
+	MaxAttempts int
+	// Backoff is the wait before the first retry; it doubles after every
+	// further attempt.
+	Backoff time.Duration
+	// Timeout bounds a single attempt.
+	Timeout time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Workers:     4,
+		QueueSize:   256,
+		MaxAttempts: 5,
+		Backoff:     time.Second,
+		Timeout:     10 * time.Second,
+	}
+}
+
+// MaxDispatchTime is the longest Dispatch can take: every attempt runs
+// into the timeout and every retry waits out its backoff.
+func (c Config) MaxDispatchTime() time.Duration {
+	total := time.Duration(c.MaxAttempts) * c.Timeout
+	wait := c.Backoff
+	for attempt := 1; attempt < c.MaxAttempts; attempt++ {
+		total += wait
+		wait *= 2
+	}
+	return total
+}
+
+// Dispatcher is a domain.EventPublisher that delivers events to webhooks
+// in the background. Events published while the queue is full are
+// dropped and logged.
+type Dispatcher struct {
+	repo   domain.WebhookRepository
+	client *http.Client
+	cfg    Config
+	log    *slog.Logger
+	queue  chan domain.Event
+}
+
+func NewDispatcher(repo domain.WebhookRepository, cfg Config, logger *slog.Logger) *Dispatcher {
+	return &Dispatcher{
+		repo:   repo,
+		client: &http.Client{Timeout: cfg.Timeout},
+		cfg:    cfg,
+		log:    logger,
+		queue:  make(chan domain.Event, cfg.QueueSize),
+	}
+}
+
+func (d *Dispatcher) Publish(_ context.Context, e domain.Event) {
+	select {
+	case d.queue <- e:
+	default:
+		d.log.Warn(
+			"webhook event dropped",
+			slog.String("event", string(e.Type)),
+			slog.String("event_id", e.ID),
+		)
+	}
+}
+
+// Run delivers published events until ctx is done.
+func (d *Dispatcher) Run(ctx context.Context) {
+	d.log.Info("webhook dispatcher started", slog.Int("workers", d.cfg.Workers))
+
+	var wg sync.WaitGroup
+	for i := 0; i < d.cfg.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case e := <-d.queue:
+					if err := d.Dispatch(ctx, e); err != nil && ctx.Err() == nil {
+						d.log.Error(
+							"webhook dispatch failed",
+							slog.String("event", string(e.Type)),
+							slog.String("error", err.Error()),
+						)
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	d.log.Info("webhook dispatcher stopped")
+}
+
+// Dispatch delivers e right away to every subscription that wants it,
+// side by side so a slow endpoint does not hold up the others. It returns
+// once each of them got e or gave up, which suits an outbox.Handler: an
+// event is only acknowledged once it was delivered. It returns an error
+// when a subscription still failed with a retryable error after its last
+// attempt; dispatching e again then sends it to every subscription once
+// more, with the same X-FinScope-Delivery. Endpoints that reject e for
+// good are not retried.
+func (d *Dispatcher) Dispatch(ctx context.Context, e domain.Event) error {
+	subs, err := d.repo.ListByEvent(ctx, e.Type)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for _, s := range subs {
+		if !s.Matches(e) {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := d.deliver(ctx, s, e, body); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("subscription %d: %w", s.ID, err))
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return errors.Join(errs...)
+}
+
+// deliver tries to deliver body to s until it is accepted, the attempts
+// run out or the endpoint rejects it for good. Every attempt is logged.
+// It returns the last error when the attempts ran out on a failure worth
+// retrying later.
+func (d *Dispatcher) deliver(ctx context.Context, s domain.WebhookSubscription, e domain.Event, body []byte) error {
+	wait := d.cfg.Backoff
+	for attempt := 1; attempt <= d.cfg.MaxAttempts; attempt++ {
+		start := time.Now()
+		code, err := d.send(ctx, s, e, body)
+
+		delivery := domain.WebhookDelivery{
+			SubscriptionID: s.ID,
+			EventID:        e.ID,
+			EventType:      e.Type,
+			Attempt:        attempt,
+			StatusCode:     code,
+			Succeeded:      err == nil,
+			Duration:       time.Since(start),
+		}
+		if err != nil {
+			delivery.Error = err.Error()
+		}
+		// The attempt happened even if ctx was cancelled meanwhile.
+		if logErr := d.repo.LogDelivery(context.WithoutCancel(ctx), &delivery); logErr != nil {
+			d.log.Error("webhook delivery log failed", slog.String("error", logErr.Error()))
+		}
+
+		if err == nil {
+			return nil
+		}
+
+		d.log.Warn(
+			"webhook delivery failed",
+			slog.Int("subscription_id", s.ID),
+			slog.String("event_id", e.ID),
+			slog.Int("attempt", attempt),
+			slog.String("error", err.Error()),
+		)
+
+		if !retryable(code) {
+			return nil
+		}
+		if attempt == d.cfg.MaxAttempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		wait *= 2
+	}
+
+	return nil
+}
+
+func (d *Dispatcher) send(ctx context.Context, s domain.WebhookSubscription, e domain.Event, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "finScope-Webhook/1")
+	req.Header.Set("X-FinScope-Event", string(e.Type))
+	req.Header.Set("X-FinScope-Delivery", e.ID)
+	req.Header.Set("X-FinScope-Timestamp", strconv.FormatInt(timestamp, 10))
+	req.Header.Set("X-FinScope-Signature", Sign(s.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// Drain a little so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// retryable reports whether a failed attempt that got code back, zero
+// for no response, is worth another try. Other client errors would be
+// rejected again.
+func retryable(code int) bool {
+	return code == 0 ||
+		code == http.StatusRequestTimeout ||
+		code == http.StatusTooManyRequests ||
+		code >= 500
+}
+
+// Sign returns the X-FinScope-Signature of body sent at timestamp.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
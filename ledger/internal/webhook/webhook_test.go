@@ -0,0 +1,198 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lyagu5h/finScope/ledger/internal/domain"
+)
+
+type memWebhooks struct {
+	mu         sync.Mutex
+	subs       []domain.WebhookSubscription
+	deliveries []domain.WebhookDelivery
+}
+
+func (r *memWebhooks) Create(_ context.Context, s *domain.WebhookSubscription) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s.ID = len(r.subs) + 1
+	r.subs = append(r.subs, *s)
+	return nil
+}
+
+func (r *memWebhooks) Delete(context.Context, int) (bool, error) { return false, nil }
+
+func (r *memWebhooks) Get(context.Context, int) (domain.WebhookSubscription, bool, error) {
+	return domain.WebhookSubscription{}, false, nil
+}
+
+func (r *memWebhooks) List(context.Context) ([]domain.WebhookSubscription, error) {
+	return r.subs, nil
+}
+
+func (r *memWebhooks) ListByEvent(_ context.Context, e domain.EventType) ([]domain.WebhookSubscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var res []domain.WebhookSubscription
+	for _, s := range r.subs {
+		if slices.Contains(s.Events, e) {
+			res = append(res, s)
+		}
+	}
+	return res, nil
+}
+
+func (r *memWebhooks) LogDelivery(_ context.Context, d *domain.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	d.ID = len(r.deliveries) + 1
+	r.deliveries = append(r.deliveries, *d)
+	return nil
+}
+
+func (r *memWebhooks) ListDeliveries(context.Context, int, int) ([]domain.WebhookDelivery, error) {
+	return r.deliveries, nil
+}
+
+func newTestDispatcher(repo *memWebhooks) *Dispatcher {
+	cfg := DefaultConfig()
+	cfg.Backoff = time.Millisecond
+	cfg.MaxAttempts = 3
+	return NewDispatcher(repo, cfg, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestDispatcher_SignsAndRetries(t *testing.T) {
+	var calls atomic.Int32
+	var got domain.Event
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp, _ := strconv.ParseInt(r.Header.Get("X-FinScope-Timestamp"), 10, 64)
+		if r.Header.Get("X-FinScope-Signature") != Sign("s3cret", timestamp, body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_ = json.Unmarshal(body, &got)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer stub.Close()
+
+	repo := &memWebhooks{}
+	_ = repo.Create(context.Background(), &domain.WebhookSubscription{
+		URL:    stub.URL,
+		Secret: "s3cret",
+		Events: []domain.EventType{domain.EventImportCompleted},
+	})
+
+	e := domain.NewEvent(domain.EventImportCompleted, domain.ImportEvent{Accepted: 2, Rejected: 1})
+	if err := newTestDispatcher(repo).Dispatch(context.Background(), e); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(repo.deliveries) != 3 {
+		t.Fatalf("expected 3 attempts, got %+v", repo.deliveries)
+	}
+	if repo.deliveries[0].Succeeded || repo.deliveries[0].StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected the first attempt to fail with 503, got %+v", repo.deliveries[0])
+	}
+	last := repo.deliveries[2]
+	if !last.Succeeded || last.Attempt != 3 || last.EventID != e.ID || last.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected the third attempt to succeed, got %+v", last)
+	}
+	if got.ID != e.ID || got.Type != domain.EventImportCompleted {
+		t.Fatalf("unexpected event received: %+v", got)
+	}
+}
+
+func TestDispatcher_DoesNotRetryClientErrors(t *testing.T) {
+	var calls atomic.Int32
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusGone)
+	}))
+	defer stub.Close()
+
+	repo := &memWebhooks{}
+	_ = repo.Create(context.Background(), &domain.WebhookSubscription{
+		URL:    stub.URL,
+		Secret: "s3cret",
+		Events: []domain.EventType{domain.EventTransactionCreated},
+	})
+
+	e := domain.NewEvent(domain.EventTransactionCreated, domain.TransactionEvent{ID: 1, Amount: 10})
+	// A rejected event would be rejected again, so it is not handed back.
+	if err := newTestDispatcher(repo).Dispatch(context.Background(), e); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if calls.Load() != 1 || len(repo.deliveries) != 1 || repo.deliveries[0].Error == "" {
+		t.Fatalf("expected one failed attempt, got %d calls and %+v", calls.Load(), repo.deliveries)
+	}
+}
+
+func TestDispatcher_FailsWhenAttemptsRunOut(t *testing.T) {
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer stub.Close()
+
+	repo := &memWebhooks{}
+	_ = repo.Create(context.Background(), &domain.WebhookSubscription{
+		URL:    stub.URL,
+		Secret: "s3cret",
+		Events: []domain.EventType{domain.EventTransactionCreated},
+	})
+
+	d := newTestDispatcher(repo)
+	e := domain.NewEvent(domain.EventTransactionCreated, domain.TransactionEvent{ID: 1, Amount: 10})
+	if err := d.Dispatch(context.Background(), e); err == nil {
+		t.Fatal("expected an error so the event is dispatched again")
+	}
+	if len(repo.deliveries) != d.cfg.MaxAttempts {
+		t.Fatalf("expected %d attempts, got %+v", d.cfg.MaxAttempts, repo.deliveries)
+	}
+}
+
+func TestDispatcher_SkipsSubscriptionsThatDoNotMatch(t *testing.T) {
+	var calls atomic.Int32
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	defer stub.Close()
+
+	repo := &memWebhooks{}
+	_ = repo.Create(context.Background(), &domain.WebhookSubscription{
+		URL:       stub.URL,
+		Secret:    "s3cret",
+		Events:    []domain.EventType{domain.EventTransactionCreated},
+		MinAmount: 500,
+	})
+
+	e := domain.NewEvent(domain.EventTransactionCreated, domain.TransactionEvent{ID: 1, Amount: 10})
+	_ = newTestDispatcher(repo).Dispatch(context.Background(), e)
+
+	if calls.Load() != 0 || len(repo.deliveries) != 0 {
+		t.Fatalf("expected a small transaction to be left out, got %d calls", calls.Load())
+	}
+}
+
+func TestConfig_MaxDispatchTime(t *testing.T) {
+	// 5 attempts of 10s and retries after 1s, 2s, 4s and 8s.
+	if got := DefaultConfig().MaxDispatchTime(); got != 65*time.Second {
+		t.Fatalf("expected 65s, got %s", got)
+	}
+}
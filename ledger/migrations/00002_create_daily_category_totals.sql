@@ -0,0 +1,74 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS daily_category_totals (
+    day DATE NOT NULL,
+    category TEXT NOT NULL,
+    total NUMERIC(14, 2) NOT NULL,
+    tx_count INTEGER NOT NULL,
+    PRIMARY KEY (category, day)
+);
+
+CREATE INDEX IF NOT EXISTS daily_category_totals_day_idx ON daily_category_totals (day);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION apply_daily_category_total(
+    p_day DATE, p_category TEXT, p_amount NUMERIC, p_count INTEGER
+) RETURNS VOID AS $$
+BEGIN
+    INSERT INTO daily_category_totals (day, category, total, tx_count)
+    VALUES (p_day, p_category, p_amount, p_count)
+    ON CONFLICT (category, day) DO UPDATE
+    SET total = daily_category_totals.total + EXCLUDED.total,
+        tx_count = daily_category_totals.tx_count + EXCLUDED.tx_count;
+
+    DELETE FROM daily_category_totals
+    WHERE day = p_day AND category = p_category AND tx_count = 0;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION expenses_daily_totals() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        PERFORM apply_daily_category_total(OLD.date, OLD.category, -OLD.amount, -1);
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        PERFORM apply_daily_category_total(NEW.date, NEW.category, NEW.amount, 1);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION expenses_daily_totals_truncate() RETURNS TRIGGER AS $$
+BEGIN
+    TRUNCATE daily_category_totals;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- The triggers run inside the statement that changes expenses, so the
+-- totals are always updated in the same transaction as the rows.
+CREATE TRIGGER expenses_daily_totals
+AFTER INSERT OR DELETE OR UPDATE OF amount, category, date ON expenses
+FOR EACH ROW EXECUTE FUNCTION expenses_daily_totals();
+
+CREATE TRIGGER expenses_daily_totals_truncate
+AFTER TRUNCATE ON expenses
+FOR EACH STATEMENT EXECUTE FUNCTION expenses_daily_totals_truncate();
+
+INSERT INTO daily_category_totals (day, category, total, tx_count)
+SELECT date, category, SUM(amount), COUNT(*)
+FROM expenses
+GROUP BY date, category;
+
+
+-- +goose Down
+DROP TRIGGER IF EXISTS expenses_daily_totals_truncate ON expenses;
+DROP TRIGGER IF EXISTS expenses_daily_totals ON expenses;
+DROP FUNCTION IF EXISTS expenses_daily_totals_truncate();
+DROP FUNCTION IF EXISTS expenses_daily_totals();
+DROP FUNCTION IF EXISTS apply_daily_category_total(DATE, TEXT, NUMERIC, INTEGER);
+DROP TABLE IF EXISTS daily_category_totals;
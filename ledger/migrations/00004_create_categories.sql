@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS categories (
+    id SERIAL PRIMARY KEY,
+    name TEXT UNIQUE NOT NULL,
+    parent_id INTEGER REFERENCES categories (id) ON DELETE RESTRICT,
+    CHECK (parent_id <> id)
+);
+
+CREATE INDEX IF NOT EXISTS categories_parent_idx ON categories (parent_id);
+
+
+-- +goose Down
+DROP TABLE IF EXISTS categories;
@@ -0,0 +1,79 @@
+-- +goose Up
+-- Register the categories already in use. Spellings that only differ in
+-- case or surrounding spaces share one canonical entry.
+INSERT INTO categories (name)
+SELECT DISTINCT ON (lower(btrim(category))) btrim(category)
+FROM (
+    SELECT category FROM expenses
+    UNION
+    SELECT category FROM budgets
+) used
+WHERE btrim(category) <> ''
+  AND NOT EXISTS (
+      SELECT 1 FROM categories c WHERE lower(btrim(c.name)) = lower(btrim(used.category))
+  )
+ORDER BY lower(btrim(category)), btrim(category)
+ON CONFLICT DO NOTHING;
+
+-- Categories created before names were matched case-insensitively may
+-- have several spellings. The oldest one, trimmed, is kept.
+CREATE TEMP TABLE category_spellings ON COMMIT DROP AS
+SELECT
+    id,
+    first_value(id) OVER same_name AS canonical_id,
+    first_value(btrim(name)) OVER same_name AS canonical_name,
+    lower(btrim(name)) AS key
+FROM categories
+WINDOW same_name AS (PARTITION BY lower(btrim(name)) ORDER BY id);
+
+UPDATE categories c
+SET parent_id = NULLIF(s.canonical_id, c.id)
+FROM category_spellings s
+WHERE c.parent_id = s.id AND s.id <> s.canonical_id;
+
+DELETE FROM categories c
+USING category_spellings s
+WHERE c.id = s.id AND s.id <> s.canonical_id;
+
+UPDATE categories c
+SET name = s.canonical_name
+FROM category_spellings s
+WHERE c.id = s.id AND c.name <> s.canonical_name;
+
+-- Move spend and budgets to the canonical spelling, summing the limits of
+-- budgets that merge as refiling a category does. Splits and refunds come
+-- in later migrations.
+CREATE TEMP TABLE category_canonical ON COMMIT DROP AS
+SELECT DISTINCT key, canonical_name AS name FROM category_spellings;
+
+UPDATE expenses e
+SET category = s.name
+FROM category_canonical s
+WHERE lower(btrim(e.category)) = s.key AND e.category <> s.name;
+
+INSERT INTO budgets (category, limit_amount)
+SELECT s.name, SUM(b.limit_amount)
+FROM budgets b
+JOIN category_canonical s ON lower(btrim(b.category)) = s.key
+GROUP BY s.name
+HAVING COUNT(*) > 1 OR bool_or(b.category <> s.name)
+ON CONFLICT (category) DO UPDATE SET limit_amount = EXCLUDED.limit_amount;
+
+DELETE FROM budgets b
+USING category_canonical s
+WHERE lower(btrim(b.category)) = s.key AND b.category <> s.name;
+
+CREATE UNIQUE INDEX IF NOT EXISTS categories_name_lower_idx ON categories (lower(name));
+
+-- Aliases are stored lower-cased and matched case-insensitively.
+CREATE TABLE IF NOT EXISTS category_aliases (
+    alias TEXT PRIMARY KEY CHECK (alias = lower(alias)),
+    category_id INTEGER NOT NULL REFERENCES categories (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS category_aliases_category_idx ON category_aliases (category_id);
+
+
+-- +goose Down
+DROP TABLE IF EXISTS category_aliases;
+DROP INDEX IF EXISTS categories_name_lower_idx;
@@ -0,0 +1,34 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS recurring_transactions (
+    id SERIAL PRIMARY KEY,
+    amount NUMERIC(14, 2) NOT NULL CHECK (amount > 0),
+    category TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    schedule TEXT NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE,
+    -- next_date is the first occurrence not posted yet, NULL once the
+    -- schedule is over.
+    next_date DATE,
+    last_error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS recurring_transactions_next_idx
+    ON recurring_transactions (next_date)
+    WHERE next_date IS NOT NULL;
+
+ALTER TABLE expenses
+    ADD COLUMN IF NOT EXISTS recurring_id INTEGER
+    REFERENCES recurring_transactions (id) ON DELETE SET NULL;
+
+-- An occurrence can only be posted once, even if the scheduler crashes
+-- between posting it and moving next_date on.
+CREATE UNIQUE INDEX IF NOT EXISTS expenses_recurring_occurrence_idx
+    ON expenses (recurring_id, date)
+    WHERE recurring_id IS NOT NULL;
+
+
+-- +goose Down
+DROP INDEX IF EXISTS expenses_recurring_occurrence_idx;
+ALTER TABLE expenses DROP COLUMN IF EXISTS recurring_id;
+DROP TABLE IF EXISTS recurring_transactions;
@@ -0,0 +1,100 @@
+-- +goose Up
+-- A split transaction spreads its amount over several categories. Its
+-- category spend comes from the split lines instead of the expense row.
+ALTER TABLE expenses ADD COLUMN IF NOT EXISTS split BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS expense_splits (
+    id SERIAL PRIMARY KEY,
+    transaction_id INTEGER NOT NULL REFERENCES expenses (id) ON DELETE CASCADE,
+    category TEXT NOT NULL,
+    amount NUMERIC(14, 2) NOT NULL CHECK (amount > 0),
+    -- date is copied from the transaction so the triggers below do not
+    -- have to look it up, which fails once a cascade delete ran.
+    date DATE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS expense_splits_transaction_idx ON expense_splits (transaction_id);
+CREATE INDEX IF NOT EXISTS expense_splits_category_idx ON expense_splits (category);
+
+-- expense_lines has one row per categorised amount: unsplit transactions
+-- and the lines of split ones.
+CREATE OR REPLACE VIEW expense_lines AS
+SELECT id AS transaction_id, date, category, amount
+FROM expenses
+WHERE NOT split
+UNION ALL
+SELECT transaction_id, date, category, amount
+FROM expense_splits;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION expenses_daily_totals() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') AND NOT OLD.split THEN
+        PERFORM apply_daily_category_total(OLD.date, OLD.category, -OLD.amount, -1);
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') AND NOT NEW.split THEN
+        PERFORM apply_daily_category_total(NEW.date, NEW.category, NEW.amount, 1);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+DROP TRIGGER IF EXISTS expenses_daily_totals ON expenses;
+CREATE TRIGGER expenses_daily_totals
+AFTER INSERT OR DELETE OR UPDATE OF amount, category, date, split ON expenses
+FOR EACH ROW EXECUTE FUNCTION expenses_daily_totals();
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION expense_splits_daily_totals() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        PERFORM apply_daily_category_total(OLD.date, OLD.category, -OLD.amount, -1);
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        PERFORM apply_daily_category_total(NEW.date, NEW.category, NEW.amount, 1);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER expense_splits_daily_totals
+AFTER INSERT OR DELETE OR UPDATE OF amount, category, date ON expense_splits
+FOR EACH ROW EXECUTE FUNCTION expense_splits_daily_totals();
+
+
+-- +goose Down
+DROP TRIGGER IF EXISTS expense_splits_daily_totals ON expense_splits;
+DROP FUNCTION IF EXISTS expense_splits_daily_totals();
+DROP VIEW IF EXISTS expense_lines;
+DROP TABLE IF EXISTS expense_splits;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION expenses_daily_totals() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        PERFORM apply_daily_category_total(OLD.date, OLD.category, -OLD.amount, -1);
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        PERFORM apply_daily_category_total(NEW.date, NEW.category, NEW.amount, 1);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+DROP TRIGGER IF EXISTS expenses_daily_totals ON expenses;
+CREATE TRIGGER expenses_daily_totals
+AFTER INSERT OR DELETE OR UPDATE OF amount, category, date ON expenses
+FOR EACH ROW EXECUTE FUNCTION expenses_daily_totals();
+
+ALTER TABLE expenses DROP COLUMN IF EXISTS split;
+
+-- Dropping the splits fires no triggers, so the daily totals still hold
+-- the split lines. Recompute them from the whole transactions.
+DELETE FROM daily_category_totals;
+INSERT INTO daily_category_totals (day, category, total, tx_count)
+SELECT date, category, SUM(amount), COUNT(*)
+FROM expenses
+GROUP BY date, category;
@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS payees (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS payees_name_lower_idx
+    ON payees (lower(name));
+
+ALTER TABLE expenses
+    ADD COLUMN IF NOT EXISTS payee_id INTEGER
+    REFERENCES payees (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS expenses_payee_date_idx
+    ON expenses (payee_id, date)
+    WHERE payee_id IS NOT NULL;
+
+
+-- +goose Down
+DROP INDEX IF EXISTS expenses_payee_date_idx;
+ALTER TABLE expenses DROP COLUMN IF EXISTS payee_id;
+DROP TABLE IF EXISTS payees;
@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS rules (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    priority INTEGER NOT NULL DEFAULT 0,
+    description_contains TEXT NOT NULL DEFAULT '',
+    description_regex TEXT NOT NULL DEFAULT '',
+    -- 0 means no bound.
+    min_amount NUMERIC(14, 2) NOT NULL DEFAULT 0,
+    max_amount NUMERIC(14, 2) NOT NULL DEFAULT 0,
+    payee TEXT NOT NULL DEFAULT '',
+    set_category TEXT NOT NULL DEFAULT '',
+    set_tags TEXT[] NOT NULL DEFAULT '{}',
+    set_description TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS rules_priority_idx ON rules (priority, id);
+
+
+-- +goose Down
+DROP TABLE IF EXISTS rules;
@@ -0,0 +1,23 @@
+-- +goose Up
+-- duplicate_of is set on transactions stored under the flag policy while
+-- they looked like an earlier one.
+ALTER TABLE expenses
+    ADD COLUMN IF NOT EXISTS duplicate_of INTEGER REFERENCES expenses (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS expenses_duplicate_of_idx
+    ON expenses (duplicate_of) WHERE duplicate_of IS NOT NULL;
+
+-- duplicate_dismissals holds the pairs of transactions confirmed not to be
+-- duplicates of each other, lower ID first.
+CREATE TABLE IF NOT EXISTS duplicate_dismissals (
+    transaction_id INTEGER NOT NULL REFERENCES expenses (id) ON DELETE CASCADE,
+    other_id INTEGER NOT NULL REFERENCES expenses (id) ON DELETE CASCADE,
+    PRIMARY KEY (transaction_id, other_id),
+    CHECK (transaction_id < other_id)
+);
+
+
+-- +goose Down
+DROP TABLE IF EXISTS duplicate_dismissals;
+DROP INDEX IF EXISTS expenses_duplicate_of_idx;
+ALTER TABLE expenses DROP COLUMN IF EXISTS duplicate_of;
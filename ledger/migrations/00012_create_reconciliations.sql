@@ -0,0 +1,35 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS reconciliations (
+    id SERIAL PRIMARY KEY,
+    account TEXT NOT NULL DEFAULT '',
+    statement_date DATE NOT NULL,
+    statement_balance NUMERIC(14, 2) NOT NULL,
+    opening_balance NUMERIC(14, 2) NOT NULL,
+    -- completed_at is NULL while the reconciliation is open.
+    completed_at TIMESTAMPTZ
+);
+
+-- An account has at most one open reconciliation.
+CREATE UNIQUE INDEX IF NOT EXISTS reconciliations_open_idx
+    ON reconciliations (account) WHERE completed_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS reconciliations_account_idx
+    ON reconciliations (account, statement_date);
+
+-- A transaction with a reconciliation_id is locked against edits.
+ALTER TABLE expenses
+    ADD COLUMN IF NOT EXISTS account TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS cleared BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS reconciliation_id INTEGER REFERENCES reconciliations (id);
+
+CREATE INDEX IF NOT EXISTS expenses_unreconciled_idx
+    ON expenses (account, date) WHERE reconciliation_id IS NULL;
+
+
+-- +goose Down
+DROP INDEX IF EXISTS expenses_unreconciled_idx;
+ALTER TABLE expenses
+    DROP COLUMN IF EXISTS reconciliation_id,
+    DROP COLUMN IF EXISTS cleared,
+    DROP COLUMN IF EXISTS account;
+DROP TABLE IF EXISTS reconciliations;
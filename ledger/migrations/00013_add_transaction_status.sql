@@ -0,0 +1,149 @@
+-- +goose Up
+-- status replaces the cleared flag: a transaction is pending until it
+-- shows on a bank statement, cleared once it does and reconciled once a
+-- reconciliation locked it. A voided transaction is kept for the record
+-- but no longer counts anywhere.
+ALTER TABLE expenses
+    ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'cleared', 'reconciled', 'voided')),
+    ADD COLUMN IF NOT EXISTS status_changed_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+UPDATE expenses SET status = CASE
+    WHEN reconciliation_id IS NOT NULL THEN 'reconciled'
+    WHEN cleared THEN 'cleared'
+    ELSE 'pending'
+END;
+
+ALTER TABLE expenses DROP COLUMN IF EXISTS cleared;
+
+CREATE INDEX IF NOT EXISTS expenses_status_idx ON expenses (status);
+
+-- voided is copied from the transaction so the split trigger does not
+-- have to look it up, like date.
+ALTER TABLE expense_splits ADD COLUMN IF NOT EXISTS voided BOOLEAN NOT NULL DEFAULT false;
+
+-- Every status change, the first status of a transaction included. Rows
+-- from before this migration have none.
+CREATE TABLE IF NOT EXISTS transaction_status_changes (
+    id SERIAL PRIMARY KEY,
+    transaction_id INTEGER NOT NULL REFERENCES expenses (id) ON DELETE CASCADE,
+    -- from_status is empty for the first status.
+    from_status TEXT NOT NULL DEFAULT '',
+    to_status TEXT NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS transaction_status_changes_transaction_idx
+    ON transaction_status_changes (transaction_id, changed_at);
+
+-- Voided transactions are left out of the lines, and every line carries
+-- the status of its transaction.
+CREATE OR REPLACE VIEW expense_lines AS
+SELECT id AS transaction_id, date, category, amount, status
+FROM expenses
+WHERE NOT split AND status <> 'voided'
+UNION ALL
+SELECT s.transaction_id, s.date, s.category, s.amount, e.status
+FROM expense_splits s
+JOIN expenses e ON e.id = s.transaction_id
+WHERE e.status <> 'voided';
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION expenses_daily_totals() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') AND NOT OLD.split AND OLD.status <> 'voided' THEN
+        PERFORM apply_daily_category_total(OLD.date, OLD.category, -OLD.amount, -1);
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') AND NOT NEW.split AND NEW.status <> 'voided' THEN
+        PERFORM apply_daily_category_total(NEW.date, NEW.category, NEW.amount, 1);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+DROP TRIGGER IF EXISTS expenses_daily_totals ON expenses;
+CREATE TRIGGER expenses_daily_totals
+AFTER INSERT OR DELETE OR UPDATE OF amount, category, date, split, status ON expenses
+FOR EACH ROW EXECUTE FUNCTION expenses_daily_totals();
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION expense_splits_daily_totals() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') AND NOT OLD.voided THEN
+        PERFORM apply_daily_category_total(OLD.date, OLD.category, -OLD.amount, -1);
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') AND NOT NEW.voided THEN
+        PERFORM apply_daily_category_total(NEW.date, NEW.category, NEW.amount, 1);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+DROP TRIGGER IF EXISTS expense_splits_daily_totals ON expense_splits;
+CREATE TRIGGER expense_splits_daily_totals
+AFTER INSERT OR DELETE OR UPDATE OF amount, category, date, voided ON expense_splits
+FOR EACH ROW EXECUTE FUNCTION expense_splits_daily_totals();
+
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION expense_splits_daily_totals() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        PERFORM apply_daily_category_total(OLD.date, OLD.category, -OLD.amount, -1);
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        PERFORM apply_daily_category_total(NEW.date, NEW.category, NEW.amount, 1);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+DROP TRIGGER IF EXISTS expense_splits_daily_totals ON expense_splits;
+CREATE TRIGGER expense_splits_daily_totals
+AFTER INSERT OR DELETE OR UPDATE OF amount, category, date ON expense_splits
+FOR EACH ROW EXECUTE FUNCTION expense_splits_daily_totals();
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION expenses_daily_totals() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') AND NOT OLD.split THEN
+        PERFORM apply_daily_category_total(OLD.date, OLD.category, -OLD.amount, -1);
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') AND NOT NEW.split THEN
+        PERFORM apply_daily_category_total(NEW.date, NEW.category, NEW.amount, 1);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+DROP TRIGGER IF EXISTS expenses_daily_totals ON expenses;
+CREATE TRIGGER expenses_daily_totals
+AFTER INSERT OR DELETE OR UPDATE OF amount, category, date, split ON expenses
+FOR EACH ROW EXECUTE FUNCTION expenses_daily_totals();
+
+DROP VIEW IF EXISTS expense_lines;
+CREATE VIEW expense_lines AS
+SELECT id AS transaction_id, date, category, amount
+FROM expenses
+WHERE NOT split
+UNION ALL
+SELECT transaction_id, date, category, amount
+FROM expense_splits;
+
+DROP TABLE IF EXISTS transaction_status_changes;
+ALTER TABLE expense_splits DROP COLUMN IF EXISTS voided;
+
+ALTER TABLE expenses ADD COLUMN IF NOT EXISTS cleared BOOLEAN NOT NULL DEFAULT false;
+UPDATE expenses SET cleared = status IN ('cleared', 'reconciled');
+
+-- Voided transactions count again from here on; rebuild the daily totals
+-- after migrating down.
+DROP INDEX IF EXISTS expenses_status_idx;
+ALTER TABLE expenses
+    DROP COLUMN IF EXISTS status_changed_at,
+    DROP COLUMN IF EXISTS status;
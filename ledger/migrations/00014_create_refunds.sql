@@ -0,0 +1,72 @@
+-- +goose Up
+-- A refund takes spend off its original transaction's category on the
+-- original's date. category, original_date and voided are copied from
+-- the transaction so the trigger below does not have to look it up.
+CREATE TABLE IF NOT EXISTS refunds (
+    id SERIAL PRIMARY KEY,
+    transaction_id INTEGER NOT NULL REFERENCES expenses (id) ON DELETE CASCADE,
+    category TEXT NOT NULL,
+    amount NUMERIC(14, 2) NOT NULL CHECK (amount > 0),
+    description TEXT NOT NULL DEFAULT '',
+    -- date is when the money came back.
+    date DATE NOT NULL,
+    original_date DATE NOT NULL,
+    voided BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS refunds_transaction_idx ON refunds (transaction_id);
+
+-- Refunds are negative lines of their original.
+CREATE OR REPLACE VIEW expense_lines AS
+SELECT id AS transaction_id, date, category, amount, status
+FROM expenses
+WHERE NOT split AND status <> 'voided'
+UNION ALL
+SELECT s.transaction_id, s.date, s.category, s.amount, e.status
+FROM expense_splits s
+JOIN expenses e ON e.id = s.transaction_id
+WHERE e.status <> 'voided'
+UNION ALL
+SELECT r.transaction_id, r.original_date, r.category, -r.amount, e.status
+FROM refunds r
+JOIN expenses e ON e.id = r.transaction_id
+WHERE e.status <> 'voided';
+
+-- A refund changes the total but not the number of transactions.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION refunds_daily_totals() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') AND NOT OLD.voided THEN
+        PERFORM apply_daily_category_total(OLD.original_date, OLD.category, OLD.amount, 0);
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') AND NOT NEW.voided THEN
+        PERFORM apply_daily_category_total(NEW.original_date, NEW.category, -NEW.amount, 0);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER refunds_daily_totals
+AFTER INSERT OR DELETE OR UPDATE OF amount, category, original_date, voided ON refunds
+FOR EACH ROW EXECUTE FUNCTION refunds_daily_totals();
+
+
+-- +goose Down
+DROP TRIGGER IF EXISTS refunds_daily_totals ON refunds;
+DROP FUNCTION IF EXISTS refunds_daily_totals();
+
+CREATE OR REPLACE VIEW expense_lines AS
+SELECT id AS transaction_id, date, category, amount, status
+FROM expenses
+WHERE NOT split AND status <> 'voided'
+UNION ALL
+SELECT s.transaction_id, s.date, s.category, s.amount, e.status
+FROM expense_splits s
+JOIN expenses e ON e.id = s.transaction_id
+WHERE e.status <> 'voided';
+
+-- Refunded spend counts again from here on; rebuild the daily totals
+-- after migrating down.
+DROP TABLE IF EXISTS refunds;
@@ -0,0 +1,40 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id SERIAL PRIMARY KEY,
+    url TEXT NOT NULL,
+    -- secret signs every delivery with HMAC-SHA256.
+    secret TEXT NOT NULL,
+    events TEXT[] NOT NULL,
+    -- min_amount leaves out transaction.created for smaller transactions.
+    min_amount NUMERIC(14, 2) NOT NULL DEFAULT 0,
+    -- threshold is the percentage of a budget whose crossing sends
+    -- budget.threshold_crossed.
+    threshold NUMERIC(5, 2) NOT NULL DEFAULT 80,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS webhook_subscriptions_events_idx
+    ON webhook_subscriptions USING GIN (events);
+
+-- Every delivery attempt, failed ones included.
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id SERIAL PRIMARY KEY,
+    subscription_id INTEGER NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    event_id TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    attempt INTEGER NOT NULL,
+    -- status_code is 0 when no response came back; error says why.
+    status_code INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    succeeded BOOLEAN NOT NULL,
+    duration_ms INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_subscription_idx
+    ON webhook_deliveries (subscription_id, created_at DESC);
+
+
+-- +goose Down
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_subscriptions;
@@ -0,0 +1,22 @@
+-- +goose Up
+-- Events written in the same transaction as the change they describe. A
+-- relay publishes them and sets published_at; published rows are purged
+-- after a while.
+CREATE TABLE IF NOT EXISTS outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_id TEXT NOT NULL UNIQUE,
+    event_type TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    published_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS outbox_unpublished_idx
+    ON outbox (id) WHERE published_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS outbox_published_idx
+    ON outbox (published_at) WHERE published_at IS NOT NULL;
+
+
+-- +goose Down
+DROP TABLE IF EXISTS outbox;
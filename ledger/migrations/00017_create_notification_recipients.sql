@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS notification_recipients (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL DEFAULT '',
+    email TEXT NOT NULL UNIQUE,
+    -- Every kind of email is opt-in.
+    budget_alerts BOOLEAN NOT NULL DEFAULT FALSE,
+    weekly_digest BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+
+-- +goose Down
+DROP TABLE IF EXISTS notification_recipients;